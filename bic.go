@@ -0,0 +1,75 @@
+package is
+
+// BICParts holds parts of a Business Identifier Code (SWIFT code).
+type BICParts struct {
+	// Institution is the four character bank code, e.g. "DEUT".
+	Institution string
+	// CountryCode is ISO 3166-1 alpha-2 code of the country, e.g. "DE", or "XK" for Kosovo.
+	CountryCode string
+	// Location is the two character location code, e.g. "FF".
+	Location string
+	// Branch is the three character branch code, or "XXX" for the primary office.
+	// It is "XXX" if the code has only 8 characters.
+	Branch string
+	// Test is true if the location code marks the BIC as a test BIC.
+	Test bool
+}
+
+// BIC check if the string is a valid 8 or 11 character Business Identifier Code (ISO 9362).
+func BIC(s string) bool {
+	_, err := ParseBIC(s)
+	return err == nil
+}
+
+// ParseBIC validates the string as BIC and returns its parts.
+// On failure the error is a *ValidationError.
+//
+// The institution code consists of four letters or digits,
+// the country code must be ISO 3166-1 alpha-2 code,
+// the location code consists of two letters or digits with the second one
+// not being the letter "O" ("0" marks a test BIC), and the optional branch code
+// consists of three letters or digits not starting with "X" unless it is "XXX".
+func ParseBIC(s string) (BICParts, error) {
+	if len(s) == 0 {
+		return BICParts{}, validationError("BIC", ReasonEmpty, -1)
+	}
+
+	if len(s) != 8 && len(s) != 11 {
+		return BICParts{}, validationError("BIC", ReasonLength, -1)
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		letter := 'A' <= c && c <= 'Z'
+		digit := '0' <= c && c <= '9'
+		if (4 <= i && i < 6 && !letter) || (!letter && !digit) {
+			return BICParts{}, validationError("BIC", ReasonCharacter, i)
+		}
+	}
+
+	// Kosovo uses the user-assigned code XK
+	if !ISO3166Alpha2Status(s[4:6], StatusUserAssigned) {
+		return BICParts{}, validationError("BIC", ReasonFormat, 4)
+	}
+
+	if s[7] == 'O' {
+		return BICParts{}, validationError("BIC", ReasonCharacter, 7)
+	}
+
+	p := BICParts{
+		Institution: s[:4],
+		CountryCode: s[4:6],
+		Location:    s[6:8],
+		Branch:      "XXX",
+		Test:        s[7] == '0',
+	}
+
+	if len(s) == 11 {
+		if s[8] == 'X' && s[8:] != "XXX" {
+			return BICParts{}, validationError("BIC", ReasonCharacter, 8)
+		}
+		p.Branch = s[8:]
+	}
+
+	return p, nil
+}
@@ -0,0 +1,69 @@
+package is
+
+import "testing"
+
+func TestBIC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo", false},
+		{"DEUTDEFF", true},
+		{"DEUTDEFF500", true},
+		{"NEDSZAJJXXX", true},
+		{"DABADKKK", true},
+		{"UNCRITMM", true},
+		{"BNORPHMM", true},
+		{"DSBACNBXSHA", true},
+		{"RBKOXKPR", true},
+		{"1234DEFF", true},
+		{"DEUTDE", false},
+		{"DEUTDEFF5", false},
+		{"deutdeff", false},
+		{"DEUTD1FF", false},
+		{"DEUTXXFF", false},
+		{"DEUTDEFO", false},
+		{"DEUTDEFFX12", false},
+		{"DEUTDEFF-00", false},
+	}
+	for _, test := range tests {
+		actual := BIC(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected BIC(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseBIC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected BICParts
+		reason   Reason
+	}{
+		{"DEUTDEFF", BICParts{"DEUT", "DE", "FF", "XXX", false}, ""},
+		{"DEUTDEFF500", BICParts{"DEUT", "DE", "FF", "500", false}, ""},
+		{"DEUTDEF0XXX", BICParts{"DEUT", "DE", "F0", "XXX", true}, ""},
+		{"", BICParts{}, ReasonEmpty},
+		{"DEUTDEFF5", BICParts{}, ReasonLength},
+		{"DEUTD1FF", BICParts{}, ReasonCharacter},
+		{"DEUTXXFF", BICParts{}, ReasonFormat},
+		{"DEUTDEFO", BICParts{}, ReasonCharacter},
+	}
+	for _, test := range tests {
+		actual, err := ParseBIC(test.param)
+		if test.reason == "" {
+			if err != nil || actual != test.expected {
+				t.Errorf("Expected ParseBIC(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+			}
+			continue
+		}
+		if verr, ok := err.(*ValidationError); !ok || verr.Reason != test.reason {
+			t.Errorf("Expected ParseBIC(%q) to fail with %s, got %v", test.param, test.reason, err)
+		}
+	}
+}
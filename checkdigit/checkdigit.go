@@ -0,0 +1,55 @@
+// Package checkdigit implements check digit algorithms used by identifiers
+// such as credit card numbers, ISBNs, IBANs and national identity numbers.
+//
+// Every algorithm can verify a string ending with its check characters
+// and generate check characters for a payload:
+//
+//	checkdigit.Luhn.Verify("79927398713")   // true
+//	checkdigit.Luhn.Generate("7992739871")  // "3", nil
+package checkdigit
+
+import "errors"
+
+// Errors returned by Generate
+var (
+	// ErrEmpty is returned for an empty payload.
+	ErrEmpty = errors.New("checkdigit: empty payload")
+	// ErrInvalidCharacter is returned when the payload contains a character
+	// the algorithm is not defined for.
+	ErrInvalidCharacter = errors.New("checkdigit: invalid character")
+)
+
+// Algorithm is a check digit scheme.
+type Algorithm interface {
+	// Verify checks if the string is a payload followed by its check characters.
+	Verify(s string) bool
+	// Generate computes check characters to be appended to the payload.
+	Generate(payload string) (string, error)
+}
+
+// verify splits n check characters off s and compares them with the generated ones.
+func verify(s string, n int, generate func(string) (string, error)) bool {
+	if len(s) <= n {
+		return false
+	}
+
+	c, err := generate(s[:len(s)-n])
+	return err == nil && c == s[len(s)-n:]
+}
+
+// digits converts the string of decimal digits to their values.
+func digits(s string) ([]int, error) {
+	if len(s) == 0 {
+		return nil, ErrEmpty
+	}
+
+	d := make([]int, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return nil, ErrInvalidCharacter
+		}
+		d[i] = int(s[i] - '0')
+	}
+
+	return d, nil
+}
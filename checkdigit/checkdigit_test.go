@@ -0,0 +1,95 @@
+package checkdigit
+
+import "testing"
+
+var algorithms = []struct {
+	name string
+	a    Algorithm
+	// valid is a payload followed by its check characters.
+	valid string
+	// checks is the number of check characters.
+	checks int
+}{
+	{"Luhn", Luhn, "79927398713", 1},
+	{"Verhoeff", Verhoeff, "2363", 1},
+	{"Damm", Damm, "5724", 1},
+	{"ISO7064Mod11Radix2", ISO7064Mod11Radix2, "079X", 1},
+	{"ISO7064Mod11Radix2", ISO7064Mod11Radix2, "0000000218250097", 1},
+	{"ISO7064Mod37Radix2", ISO7064Mod37Radix2, "G123498654321H", 1},
+	{"ISO7064Mod97Radix10", ISO7064Mod97Radix10, "370400440532013000131489", 2},
+	{"ISO7064Mod11Hybrid10", ISO7064Mod11Hybrid10, "36574261809", 1},
+	{"ISO7064Mod37Hybrid36", ISO7064Mod37Hybrid36, "A12425GABC1234002M", 1},
+	{"GTIN", GTIN, "4006381333931", 1},
+	{"GTIN", GTIN, "036000291452", 1},
+	{"ISBN10", ISBN10, "0306406152", 1},
+	{"ISBN10", ISBN10, "080442957X", 1},
+	{"WeightedMod11", WeightedMod11{Weights: []int{2, 3, 4, 5, 6, 7, 8}}, "12345679", 1},
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range algorithms {
+		n := len(test.valid) - test.checks
+		actual, err := test.a.Generate(test.valid[:n])
+		if err != nil || actual != test.valid[n:] {
+			t.Errorf("Expected %s.Generate(%q) to be %q, got %q (%v)", test.name, test.valid[:n], test.valid[n:], actual, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range algorithms {
+		if !test.a.Verify(test.valid) {
+			t.Errorf("Expected %s.Verify(%q) to be true, got false", test.name, test.valid)
+		}
+
+		// every single character substitution must be detected
+		for i := 0; i < len(test.valid); i++ {
+			b := []byte(test.valid)
+			switch {
+			case b[i] == '9':
+				b[i] = '0'
+			case '0' <= b[i] && b[i] < '9', 'A' <= b[i] && b[i] < 'Z':
+				b[i]++
+			default:
+				b[i] = '1'
+			}
+			if test.a.Verify(string(b)) {
+				t.Errorf("Expected %s.Verify(%q) to be false, got true", test.name, b)
+			}
+		}
+
+		if test.a.Verify(test.valid[len(test.valid)-test.checks:]) {
+			t.Errorf("Expected %s.Verify(%q) to be false, got true", test.name, test.valid[len(test.valid)-test.checks:])
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		a        Algorithm
+		param    string
+		expected error
+	}{
+		{"Luhn", Luhn, "", ErrEmpty},
+		{"Luhn", Luhn, "12a", ErrInvalidCharacter},
+		{"Damm", Damm, "-1", ErrInvalidCharacter},
+		{"ISO7064Mod11Radix2", ISO7064Mod11Radix2, "12X", ErrInvalidCharacter},
+		{"ISO7064Mod37Radix2", ISO7064Mod37Radix2, "g12", ErrInvalidCharacter},
+		{"ISO7064Mod37Hybrid36", ISO7064Mod37Hybrid36, "", ErrEmpty},
+		{"GTIN", GTIN, "12 3", ErrInvalidCharacter},
+		{"WeightedMod10", WeightedMod10{}, "123", ErrInvalidCharacter},
+	}
+	for _, test := range tests {
+		_, err := test.a.Generate(test.param)
+		if err != test.expected {
+			t.Errorf("Expected %s.Generate(%q) to fail with %v, got %v", test.name, test.param, test.expected, err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package checkdigit
+
+// Damm is the algorithm based on a totally anti-symmetric quasigroup of order 10.
+// It detects all single digit errors and all adjacent transpositions.
+// See: https://en.wikipedia.org/wiki/Damm_algorithm
+var Damm Algorithm = damm{}
+
+type damm struct{}
+
+var dammTable = [10][10]int{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+func (a damm) Verify(s string) bool {
+	return verify(s, 1, a.Generate)
+}
+
+func (damm) Generate(payload string) (string, error) {
+	d, err := digits(payload)
+	if err != nil {
+		return "", err
+	}
+
+	var c int
+	for _, v := range d {
+		c = dammTable[c][v]
+	}
+
+	return string(rune('0' + c)), nil
+}
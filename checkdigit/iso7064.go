@@ -0,0 +1,105 @@
+package checkdigit
+
+import "strings"
+
+// ISO 7064 alphabets.
+const (
+	alphabetDigits       = "0123456789"
+	alphabetAlphanumeric = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// ISO 7064 check character systems.
+// See: https://www.iso.org/standard/31531.html
+var (
+	// ISO7064Mod11Radix2 is MOD 11-2 for digits with a single check character which may be "X",
+	// used by ISNI, ORCID and Chinese resident identity card numbers.
+	ISO7064Mod11Radix2 Algorithm = iso7064Pure{11, 2, 1, alphabetDigits, alphabetDigits + "X"}
+	// ISO7064Mod37Radix2 is MOD 37-2 for upper case alphanumerics with a single check character
+	// which may be "*".
+	ISO7064Mod37Radix2 Algorithm = iso7064Pure{37, 2, 1, alphabetAlphanumeric, alphabetAlphanumeric + "*"}
+	// ISO7064Mod97Radix10 is MOD 97-10 for digits with two check digits, used by IBANs.
+	ISO7064Mod97Radix10 Algorithm = iso7064Pure{97, 10, 2, alphabetDigits, alphabetDigits}
+	// ISO7064Mod11Hybrid10 is MOD 11,10 for digits with a single check digit,
+	// used by German and Croatian tax numbers.
+	ISO7064Mod11Hybrid10 Algorithm = iso7064Hybrid{10, alphabetDigits}
+	// ISO7064Mod37Hybrid36 is MOD 37,36 for upper case alphanumerics with a single
+	// alphanumeric check character, used by GRid and ISAN.
+	ISO7064Mod37Hybrid36 Algorithm = iso7064Hybrid{36, alphabetAlphanumeric}
+)
+
+// iso7064Pure is a pure system: check characters are chosen so that the polynomial
+// of all character values in the radix is congruent to 1 modulo the modulus.
+type iso7064Pure struct {
+	modulus int
+	radix   int
+	// checks is the number of check characters.
+	checks int
+	// chars are payload characters in the order of their values.
+	chars string
+	// checkChars are check characters in the order of their values.
+	checkChars string
+}
+
+func (a iso7064Pure) Verify(s string) bool {
+	return verify(s, a.checks, a.Generate)
+}
+
+func (a iso7064Pure) Generate(payload string) (string, error) {
+	if len(payload) == 0 {
+		return "", ErrEmpty
+	}
+
+	var p int
+	for i := 0; i < len(payload); i++ {
+		v := strings.IndexByte(a.chars, payload[i])
+		if v < 0 {
+			return "", ErrInvalidCharacter
+		}
+		p = (p + v) * a.radix % a.modulus
+	}
+
+	// shift past the remaining check characters
+	for i := 1; i < a.checks; i++ {
+		p = p * a.radix % a.modulus
+	}
+	c := (a.modulus + 1 - p) % a.modulus
+
+	if a.checks == 2 {
+		return string([]byte{a.checkChars[c/a.radix], a.checkChars[c%a.radix]}), nil
+	}
+
+	return a.checkChars[c : c+1], nil
+}
+
+// iso7064Hybrid is a hybrid system MOD modulus+1,modulus with a single check character
+// taken from the same alphabet as the payload.
+type iso7064Hybrid struct {
+	modulus int
+	chars   string
+}
+
+func (a iso7064Hybrid) Verify(s string) bool {
+	return verify(s, 1, a.Generate)
+}
+
+func (a iso7064Hybrid) Generate(payload string) (string, error) {
+	if len(payload) == 0 {
+		return "", ErrEmpty
+	}
+
+	p := a.modulus
+	for i := 0; i < len(payload); i++ {
+		v := strings.IndexByte(a.chars, payload[i])
+		if v < 0 {
+			return "", ErrInvalidCharacter
+		}
+		s := (p + v) % a.modulus
+		if s == 0 {
+			s = a.modulus
+		}
+		p = s * 2 % (a.modulus + 1)
+	}
+	c := (a.modulus + 1 - p) % a.modulus
+
+	return a.chars[c : c+1], nil
+}
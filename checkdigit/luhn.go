@@ -0,0 +1,34 @@
+package checkdigit
+
+// Luhn is the mod 10 algorithm doubling every second digit, used by payment card numbers,
+// IMEIs and Canadian SINs. It detects all single digit errors and most transpositions.
+// See: https://en.wikipedia.org/wiki/Luhn_algorithm
+var Luhn Algorithm = luhn{}
+
+type luhn struct{}
+
+func (a luhn) Verify(s string) bool {
+	return verify(s, 1, a.Generate)
+}
+
+func (luhn) Generate(payload string) (string, error) {
+	d, err := digits(payload)
+	if err != nil {
+		return "", err
+	}
+
+	// the rightmost payload digit is doubled, since the check digit follows it
+	var sum int
+	for i := len(d) - 1; i >= 0; i -= 2 {
+		v := d[i] * 2
+		if v > 9 {
+			v -= 9
+		}
+		sum += v
+	}
+	for i := len(d) - 2; i >= 0; i -= 2 {
+		sum += d[i]
+	}
+
+	return string(rune('0' + (10-sum%10)%10)), nil
+}
@@ -0,0 +1,54 @@
+package checkdigit
+
+// Verhoeff is the algorithm based on the dihedral group D5, used by Indian Aadhaar numbers.
+// It detects all single digit errors and all adjacent transpositions.
+// See: https://en.wikipedia.org/wiki/Verhoeff_algorithm
+var Verhoeff Algorithm = verhoeff{}
+
+type verhoeff struct{}
+
+// Verhoeff multiplication, permutation and inverse tables.
+var (
+	verhoeffD = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffP = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 8, 7, 6, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+	verhoeffInv = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+)
+
+func (a verhoeff) Verify(s string) bool {
+	return verify(s, 1, a.Generate)
+}
+
+func (verhoeff) Generate(payload string) (string, error) {
+	d, err := digits(payload)
+	if err != nil {
+		return "", err
+	}
+
+	// positions are counted from the check digit, which is position 0
+	var c int
+	for i := range d {
+		c = verhoeffD[c][verhoeffP[(i+1)%8][d[len(d)-1-i]]]
+	}
+
+	return string(rune('0' + verhoeffInv[c])), nil
+}
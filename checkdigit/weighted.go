@@ -0,0 +1,82 @@
+package checkdigit
+
+// Weighted sum schemes used by GS1 identifiers and ISBNs.
+var (
+	// GTIN is the GS1 mod 10 algorithm weighting digits by 3 and 1 alternately
+	// starting from the rightmost one, used by EAN, UPC, GTIN, ISBN 13 and ISMN.
+	// See: https://www.gs1.org/services/how-calculate-check-digit-manually
+	GTIN Algorithm = WeightedMod10{Weights: []int{3, 1}}
+	// ISBN13 is the same as GTIN.
+	ISBN13 = GTIN
+	// ISBN10 is the mod 11 algorithm weighting digits from 2 up starting from the rightmost one,
+	// used by ISBN 10 and ISSN. Check digit 10 is "X".
+	ISBN10 Algorithm = WeightedMod11{Weights: []int{2, 3, 4, 5, 6, 7, 8, 9, 10}}
+)
+
+// WeightedMod10 multiplies payload digits by Weights, starting from the rightmost digit
+// and repeating the weights if the payload is longer. The check digit
+// brings the weighted sum to a multiple of 10.
+type WeightedMod10 struct {
+	Weights []int
+}
+
+// Verify checks if the string is a payload followed by its check digit.
+func (a WeightedMod10) Verify(s string) bool {
+	return verify(s, 1, a.Generate)
+}
+
+// Generate computes the check digit of the payload.
+func (a WeightedMod10) Generate(payload string) (string, error) {
+	sum, err := weightedSum(payload, a.Weights)
+	if err != nil {
+		return "", err
+	}
+
+	return string(rune('0' + (10-sum%10)%10)), nil
+}
+
+// WeightedMod11 multiplies payload digits by Weights, starting from the rightmost digit
+// and repeating the weights if the payload is longer. The check digit
+// brings the weighted sum to a multiple of 11, check digit 10 is "X".
+type WeightedMod11 struct {
+	Weights []int
+}
+
+// Verify checks if the string is a payload followed by its check digit.
+func (a WeightedMod11) Verify(s string) bool {
+	return verify(s, 1, a.Generate)
+}
+
+// Generate computes the check digit of the payload.
+func (a WeightedMod11) Generate(payload string) (string, error) {
+	sum, err := weightedSum(payload, a.Weights)
+	if err != nil {
+		return "", err
+	}
+
+	c := (11 - sum%11) % 11
+	if c == 10 {
+		return "X", nil
+	}
+
+	return string(rune('0' + c)), nil
+}
+
+// weightedSum multiplies digits by weights from right to left.
+func weightedSum(s string, weights []int) (int, error) {
+	d, err := digits(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(weights) == 0 {
+		return 0, ErrInvalidCharacter
+	}
+
+	var sum int
+	for i := range d {
+		sum += d[len(d)-1-i] * weights[i%len(weights)]
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,309 @@
+package is
+
+// CountryInfo stores country metadata beyond ISO 3166-1 codes and names.
+type CountryInfo struct {
+	// Alpha2Code is ISO 3166-1 alpha-2 code of the country.
+	Alpha2Code string
+	// CallingCodes are ITU-T E.164 country calling codes without "+".
+	CallingCodes []string
+	// Currencies are ISO 4217 codes of currencies in official use.
+	Currencies []string
+	// TLD is the IANA country code top-level domain with leading dot, or empty if none is delegated.
+	TLD string
+	// Region is UN M49 code of the continental region, e.g. "150" for Europe.
+	Region string
+	// SubRegion is UN M49 code of the sub-region, e.g. "155" for Western Europe.
+	SubRegion string
+	// EU is true for member states of the European Union.
+	EU bool
+	// EEA is true for members of the European Economic Area.
+	EEA bool
+}
+
+// CountryInfoList holds metadata for every country of ISO3166List.
+var CountryInfoList = []CountryInfo{
+	{"AD", []string{"376"}, []string{"EUR"}, ".ad", "150", "039", false, false},
+	{"AE", []string{"971"}, []string{"AED"}, ".ae", "142", "145", false, false},
+	{"AF", []string{"93"}, []string{"AFN"}, ".af", "142", "034", false, false},
+	{"AG", []string{"1"}, []string{"XCD"}, ".ag", "019", "029", false, false},
+	{"AI", []string{"1"}, []string{"XCD"}, ".ai", "019", "029", false, false},
+	{"AL", []string{"355"}, []string{"ALL"}, ".al", "150", "039", false, false},
+	{"AM", []string{"374"}, []string{"AMD"}, ".am", "142", "145", false, false},
+	{"AO", []string{"244"}, []string{"AOA"}, ".ao", "002", "017", false, false},
+	{"AQ", []string{"672"}, nil, ".aq", "", "", false, false},
+	{"AR", []string{"54"}, []string{"ARS"}, ".ar", "019", "005", false, false},
+	{"AS", []string{"1"}, []string{"USD"}, ".as", "009", "061", false, false},
+	{"AT", []string{"43"}, []string{"EUR"}, ".at", "150", "155", true, true},
+	{"AU", []string{"61"}, []string{"AUD"}, ".au", "009", "053", false, false},
+	{"AW", []string{"297"}, []string{"AWG"}, ".aw", "019", "029", false, false},
+	{"AX", []string{"358"}, []string{"EUR"}, ".ax", "150", "154", false, false},
+	{"AZ", []string{"994"}, []string{"AZN"}, ".az", "142", "145", false, false},
+	{"BA", []string{"387"}, []string{"BAM"}, ".ba", "150", "039", false, false},
+	{"BB", []string{"1"}, []string{"BBD"}, ".bb", "019", "029", false, false},
+	{"BD", []string{"880"}, []string{"BDT"}, ".bd", "142", "034", false, false},
+	{"BE", []string{"32"}, []string{"EUR"}, ".be", "150", "155", true, true},
+	{"BF", []string{"226"}, []string{"XOF"}, ".bf", "002", "011", false, false},
+	{"BG", []string{"359"}, []string{"EUR"}, ".bg", "150", "151", true, true},
+	{"BH", []string{"973"}, []string{"BHD"}, ".bh", "142", "145", false, false},
+	{"BI", []string{"257"}, []string{"BIF"}, ".bi", "002", "014", false, false},
+	{"BJ", []string{"229"}, []string{"XOF"}, ".bj", "002", "011", false, false},
+	{"BL", []string{"590"}, []string{"EUR"}, "", "019", "029", false, false},
+	{"BM", []string{"1"}, []string{"BMD"}, ".bm", "019", "021", false, false},
+	{"BN", []string{"673"}, []string{"BND"}, ".bn", "142", "035", false, false},
+	{"BO", []string{"591"}, []string{"BOB"}, ".bo", "019", "005", false, false},
+	{"BQ", []string{"599"}, []string{"USD"}, ".bq", "019", "029", false, false},
+	{"BR", []string{"55"}, []string{"BRL"}, ".br", "019", "005", false, false},
+	{"BS", []string{"1"}, []string{"BSD"}, ".bs", "019", "029", false, false},
+	{"BT", []string{"975"}, []string{"BTN", "INR"}, ".bt", "142", "034", false, false},
+	{"BV", []string{"47"}, []string{"NOK"}, ".bv", "019", "005", false, false},
+	{"BW", []string{"267"}, []string{"BWP"}, ".bw", "002", "018", false, false},
+	{"BY", []string{"375"}, []string{"BYN"}, ".by", "150", "151", false, false},
+	{"BZ", []string{"501"}, []string{"BZD"}, ".bz", "019", "013", false, false},
+	{"CA", []string{"1"}, []string{"CAD"}, ".ca", "019", "021", false, false},
+	{"CC", []string{"61"}, []string{"AUD"}, ".cc", "009", "053", false, false},
+	{"CD", []string{"243"}, []string{"CDF"}, ".cd", "002", "017", false, false},
+	{"CF", []string{"236"}, []string{"XAF"}, ".cf", "002", "017", false, false},
+	{"CG", []string{"242"}, []string{"XAF"}, ".cg", "002", "017", false, false},
+	{"CH", []string{"41"}, []string{"CHF"}, ".ch", "150", "155", false, false},
+	{"CI", []string{"225"}, []string{"XOF"}, ".ci", "002", "011", false, false},
+	{"CK", []string{"682"}, []string{"NZD"}, ".ck", "009", "061", false, false},
+	{"CL", []string{"56"}, []string{"CLP"}, ".cl", "019", "005", false, false},
+	{"CM", []string{"237"}, []string{"XAF"}, ".cm", "002", "017", false, false},
+	{"CN", []string{"86"}, []string{"CNY"}, ".cn", "142", "030", false, false},
+	{"CO", []string{"57"}, []string{"COP"}, ".co", "019", "005", false, false},
+	{"CR", []string{"506"}, []string{"CRC"}, ".cr", "019", "013", false, false},
+	{"CU", []string{"53"}, []string{"CUP"}, ".cu", "019", "029", false, false},
+	{"CV", []string{"238"}, []string{"CVE"}, ".cv", "002", "011", false, false},
+	{"CW", []string{"599"}, []string{"XCG"}, ".cw", "019", "029", false, false},
+	{"CX", []string{"61"}, []string{"AUD"}, ".cx", "009", "053", false, false},
+	{"CY", []string{"357"}, []string{"EUR"}, ".cy", "142", "145", true, true},
+	{"CZ", []string{"420"}, []string{"CZK"}, ".cz", "150", "151", true, true},
+	{"DE", []string{"49"}, []string{"EUR"}, ".de", "150", "155", true, true},
+	{"DJ", []string{"253"}, []string{"DJF"}, ".dj", "002", "014", false, false},
+	{"DK", []string{"45"}, []string{"DKK"}, ".dk", "150", "154", true, true},
+	{"DM", []string{"1"}, []string{"XCD"}, ".dm", "019", "029", false, false},
+	{"DO", []string{"1"}, []string{"DOP"}, ".do", "019", "029", false, false},
+	{"DZ", []string{"213"}, []string{"DZD"}, ".dz", "002", "015", false, false},
+	{"EC", []string{"593"}, []string{"USD"}, ".ec", "019", "005", false, false},
+	{"EE", []string{"372"}, []string{"EUR"}, ".ee", "150", "154", true, true},
+	{"EG", []string{"20"}, []string{"EGP"}, ".eg", "002", "015", false, false},
+	{"EH", []string{"212"}, []string{"MAD"}, "", "002", "015", false, false},
+	{"ER", []string{"291"}, []string{"ERN"}, ".er", "002", "014", false, false},
+	{"ES", []string{"34"}, []string{"EUR"}, ".es", "150", "039", true, true},
+	{"ET", []string{"251"}, []string{"ETB"}, ".et", "002", "014", false, false},
+	{"FI", []string{"358"}, []string{"EUR"}, ".fi", "150", "154", true, true},
+	{"FJ", []string{"679"}, []string{"FJD"}, ".fj", "009", "054", false, false},
+	{"FK", []string{"500"}, []string{"FKP"}, ".fk", "019", "005", false, false},
+	{"FM", []string{"691"}, []string{"USD"}, ".fm", "009", "057", false, false},
+	{"FO", []string{"298"}, []string{"DKK"}, ".fo", "150", "154", false, false},
+	{"FR", []string{"33"}, []string{"EUR"}, ".fr", "150", "155", true, true},
+	{"GA", []string{"241"}, []string{"XAF"}, ".ga", "002", "017", false, false},
+	{"GB", []string{"44"}, []string{"GBP"}, ".uk", "150", "154", false, false},
+	{"GD", []string{"1"}, []string{"XCD"}, ".gd", "019", "029", false, false},
+	{"GE", []string{"995"}, []string{"GEL"}, ".ge", "142", "145", false, false},
+	{"GF", []string{"594"}, []string{"EUR"}, ".gf", "019", "005", false, false},
+	{"GG", []string{"44"}, []string{"GBP"}, ".gg", "150", "154", false, false},
+	{"GH", []string{"233"}, []string{"GHS"}, ".gh", "002", "011", false, false},
+	{"GI", []string{"350"}, []string{"GIP"}, ".gi", "150", "039", false, false},
+	{"GL", []string{"299"}, []string{"DKK"}, ".gl", "019", "021", false, false},
+	{"GM", []string{"220"}, []string{"GMD"}, ".gm", "002", "011", false, false},
+	{"GN", []string{"224"}, []string{"GNF"}, ".gn", "002", "011", false, false},
+	{"GP", []string{"590"}, []string{"EUR"}, ".gp", "019", "029", false, false},
+	{"GQ", []string{"240"}, []string{"XAF"}, ".gq", "002", "017", false, false},
+	{"GR", []string{"30"}, []string{"EUR"}, ".gr", "150", "039", true, true},
+	{"GS", []string{"500"}, []string{"GBP"}, ".gs", "019", "005", false, false},
+	{"GT", []string{"502"}, []string{"GTQ"}, ".gt", "019", "013", false, false},
+	{"GU", []string{"1"}, []string{"USD"}, ".gu", "009", "057", false, false},
+	{"GW", []string{"245"}, []string{"XOF"}, ".gw", "002", "011", false, false},
+	{"GY", []string{"592"}, []string{"GYD"}, ".gy", "019", "005", false, false},
+	{"HK", []string{"852"}, []string{"HKD"}, ".hk", "142", "030", false, false},
+	{"HM", []string{"672"}, []string{"AUD"}, ".hm", "009", "053", false, false},
+	{"HN", []string{"504"}, []string{"HNL"}, ".hn", "019", "013", false, false},
+	{"HR", []string{"385"}, []string{"EUR"}, ".hr", "150", "039", true, true},
+	{"HT", []string{"509"}, []string{"HTG", "USD"}, ".ht", "019", "029", false, false},
+	{"HU", []string{"36"}, []string{"HUF"}, ".hu", "150", "151", true, true},
+	{"ID", []string{"62"}, []string{"IDR"}, ".id", "142", "035", false, false},
+	{"IE", []string{"353"}, []string{"EUR"}, ".ie", "150", "154", true, true},
+	{"IL", []string{"972"}, []string{"ILS"}, ".il", "142", "145", false, false},
+	{"IM", []string{"44"}, []string{"GBP"}, ".im", "150", "154", false, false},
+	{"IN", []string{"91"}, []string{"INR"}, ".in", "142", "034", false, false},
+	{"IO", []string{"246"}, []string{"USD"}, ".io", "002", "014", false, false},
+	{"IQ", []string{"964"}, []string{"IQD"}, ".iq", "142", "145", false, false},
+	{"IR", []string{"98"}, []string{"IRR"}, ".ir", "142", "034", false, false},
+	{"IS", []string{"354"}, []string{"ISK"}, ".is", "150", "154", false, true},
+	{"IT", []string{"39"}, []string{"EUR"}, ".it", "150", "039", true, true},
+	{"JE", []string{"44"}, []string{"GBP"}, ".je", "150", "154", false, false},
+	{"JM", []string{"1"}, []string{"JMD"}, ".jm", "019", "029", false, false},
+	{"JO", []string{"962"}, []string{"JOD"}, ".jo", "142", "145", false, false},
+	{"JP", []string{"81"}, []string{"JPY"}, ".jp", "142", "030", false, false},
+	{"KE", []string{"254"}, []string{"KES"}, ".ke", "002", "014", false, false},
+	{"KG", []string{"996"}, []string{"KGS"}, ".kg", "142", "143", false, false},
+	{"KH", []string{"855"}, []string{"KHR"}, ".kh", "142", "035", false, false},
+	{"KI", []string{"686"}, []string{"AUD"}, ".ki", "009", "057", false, false},
+	{"KM", []string{"269"}, []string{"KMF"}, ".km", "002", "014", false, false},
+	{"KN", []string{"1"}, []string{"XCD"}, ".kn", "019", "029", false, false},
+	{"KP", []string{"850"}, []string{"KPW"}, ".kp", "142", "030", false, false},
+	{"KR", []string{"82"}, []string{"KRW"}, ".kr", "142", "030", false, false},
+	{"KW", []string{"965"}, []string{"KWD"}, ".kw", "142", "145", false, false},
+	{"KY", []string{"1"}, []string{"KYD"}, ".ky", "019", "029", false, false},
+	{"KZ", []string{"7"}, []string{"KZT"}, ".kz", "142", "143", false, false},
+	{"LA", []string{"856"}, []string{"LAK"}, ".la", "142", "035", false, false},
+	{"LB", []string{"961"}, []string{"LBP"}, ".lb", "142", "145", false, false},
+	{"LC", []string{"1"}, []string{"XCD"}, ".lc", "019", "029", false, false},
+	{"LI", []string{"423"}, []string{"CHF"}, ".li", "150", "155", false, true},
+	{"LK", []string{"94"}, []string{"LKR"}, ".lk", "142", "034", false, false},
+	{"LR", []string{"231"}, []string{"LRD"}, ".lr", "002", "011", false, false},
+	{"LS", []string{"266"}, []string{"LSL", "ZAR"}, ".ls", "002", "018", false, false},
+	{"LT", []string{"370"}, []string{"EUR"}, ".lt", "150", "154", true, true},
+	{"LU", []string{"352"}, []string{"EUR"}, ".lu", "150", "155", true, true},
+	{"LV", []string{"371"}, []string{"EUR"}, ".lv", "150", "154", true, true},
+	{"LY", []string{"218"}, []string{"LYD"}, ".ly", "002", "015", false, false},
+	{"MA", []string{"212"}, []string{"MAD"}, ".ma", "002", "015", false, false},
+	{"MC", []string{"377"}, []string{"EUR"}, ".mc", "150", "155", false, false},
+	{"MD", []string{"373"}, []string{"MDL"}, ".md", "150", "151", false, false},
+	{"ME", []string{"382"}, []string{"EUR"}, ".me", "150", "039", false, false},
+	{"MF", []string{"590"}, []string{"EUR"}, "", "019", "029", false, false},
+	{"MG", []string{"261"}, []string{"MGA"}, ".mg", "002", "014", false, false},
+	{"MH", []string{"692"}, []string{"USD"}, ".mh", "009", "057", false, false},
+	{"MK", []string{"389"}, []string{"MKD"}, ".mk", "150", "039", false, false},
+	{"ML", []string{"223"}, []string{"XOF"}, ".ml", "002", "011", false, false},
+	{"MM", []string{"95"}, []string{"MMK"}, ".mm", "142", "035", false, false},
+	{"MN", []string{"976"}, []string{"MNT"}, ".mn", "142", "030", false, false},
+	{"MO", []string{"853"}, []string{"MOP"}, ".mo", "142", "030", false, false},
+	{"MP", []string{"1"}, []string{"USD"}, ".mp", "009", "057", false, false},
+	{"MQ", []string{"596"}, []string{"EUR"}, ".mq", "019", "029", false, false},
+	{"MR", []string{"222"}, []string{"MRU"}, ".mr", "002", "011", false, false},
+	{"MS", []string{"1"}, []string{"XCD"}, ".ms", "019", "029", false, false},
+	{"MT", []string{"356"}, []string{"EUR"}, ".mt", "150", "039", true, true},
+	{"MU", []string{"230"}, []string{"MUR"}, ".mu", "002", "014", false, false},
+	{"MV", []string{"960"}, []string{"MVR"}, ".mv", "142", "034", false, false},
+	{"MW", []string{"265"}, []string{"MWK"}, ".mw", "002", "014", false, false},
+	{"MX", []string{"52"}, []string{"MXN"}, ".mx", "019", "013", false, false},
+	{"MY", []string{"60"}, []string{"MYR"}, ".my", "142", "035", false, false},
+	{"MZ", []string{"258"}, []string{"MZN"}, ".mz", "002", "014", false, false},
+	{"NA", []string{"264"}, []string{"NAD", "ZAR"}, ".na", "002", "018", false, false},
+	{"NC", []string{"687"}, []string{"XPF"}, ".nc", "009", "054", false, false},
+	{"NE", []string{"227"}, []string{"XOF"}, ".ne", "002", "011", false, false},
+	{"NF", []string{"672"}, []string{"AUD"}, ".nf", "009", "053", false, false},
+	{"NG", []string{"234"}, []string{"NGN"}, ".ng", "002", "011", false, false},
+	{"NI", []string{"505"}, []string{"NIO"}, ".ni", "019", "013", false, false},
+	{"NL", []string{"31"}, []string{"EUR"}, ".nl", "150", "155", true, true},
+	{"NO", []string{"47"}, []string{"NOK"}, ".no", "150", "154", false, true},
+	{"NP", []string{"977"}, []string{"NPR"}, ".np", "142", "034", false, false},
+	{"NR", []string{"674"}, []string{"AUD"}, ".nr", "009", "057", false, false},
+	{"NU", []string{"683"}, []string{"NZD"}, ".nu", "009", "061", false, false},
+	{"NZ", []string{"64"}, []string{"NZD"}, ".nz", "009", "053", false, false},
+	{"OM", []string{"968"}, []string{"OMR"}, ".om", "142", "145", false, false},
+	{"PA", []string{"507"}, []string{"PAB", "USD"}, ".pa", "019", "013", false, false},
+	{"PE", []string{"51"}, []string{"PEN"}, ".pe", "019", "005", false, false},
+	{"PF", []string{"689"}, []string{"XPF"}, ".pf", "009", "061", false, false},
+	{"PG", []string{"675"}, []string{"PGK"}, ".pg", "009", "054", false, false},
+	{"PH", []string{"63"}, []string{"PHP"}, ".ph", "142", "035", false, false},
+	{"PK", []string{"92"}, []string{"PKR"}, ".pk", "142", "034", false, false},
+	{"PL", []string{"48"}, []string{"PLN"}, ".pl", "150", "151", true, true},
+	{"PM", []string{"508"}, []string{"EUR"}, ".pm", "019", "021", false, false},
+	{"PN", []string{"64"}, []string{"NZD"}, ".pn", "009", "061", false, false},
+	{"PR", []string{"1"}, []string{"USD"}, ".pr", "019", "029", false, false},
+	{"PS", []string{"970"}, []string{"ILS", "JOD"}, ".ps", "142", "145", false, false},
+	{"PT", []string{"351"}, []string{"EUR"}, ".pt", "150", "039", true, true},
+	{"PW", []string{"680"}, []string{"USD"}, ".pw", "009", "057", false, false},
+	{"PY", []string{"595"}, []string{"PYG"}, ".py", "019", "005", false, false},
+	{"QA", []string{"974"}, []string{"QAR"}, ".qa", "142", "145", false, false},
+	{"RE", []string{"262"}, []string{"EUR"}, ".re", "002", "014", false, false},
+	{"RO", []string{"40"}, []string{"RON"}, ".ro", "150", "151", true, true},
+	{"RS", []string{"381"}, []string{"RSD"}, ".rs", "150", "039", false, false},
+	{"RU", []string{"7"}, []string{"RUB"}, ".ru", "150", "151", false, false},
+	{"RW", []string{"250"}, []string{"RWF"}, ".rw", "002", "014", false, false},
+	{"SA", []string{"966"}, []string{"SAR"}, ".sa", "142", "145", false, false},
+	{"SB", []string{"677"}, []string{"SBD"}, ".sb", "009", "054", false, false},
+	{"SC", []string{"248"}, []string{"SCR"}, ".sc", "002", "014", false, false},
+	{"SD", []string{"249"}, []string{"SDG"}, ".sd", "002", "015", false, false},
+	{"SE", []string{"46"}, []string{"SEK"}, ".se", "150", "154", true, true},
+	{"SG", []string{"65"}, []string{"SGD"}, ".sg", "142", "035", false, false},
+	{"SH", []string{"290", "247"}, []string{"SHP"}, ".sh", "002", "011", false, false},
+	{"SI", []string{"386"}, []string{"EUR"}, ".si", "150", "039", true, true},
+	{"SJ", []string{"47"}, []string{"NOK"}, ".sj", "150", "154", false, false},
+	{"SK", []string{"421"}, []string{"EUR"}, ".sk", "150", "151", true, true},
+	{"SL", []string{"232"}, []string{"SLE"}, ".sl", "002", "011", false, false},
+	{"SM", []string{"378"}, []string{"EUR"}, ".sm", "150", "039", false, false},
+	{"SN", []string{"221"}, []string{"XOF"}, ".sn", "002", "011", false, false},
+	{"SO", []string{"252"}, []string{"SOS"}, ".so", "002", "014", false, false},
+	{"SR", []string{"597"}, []string{"SRD"}, ".sr", "019", "005", false, false},
+	{"SS", []string{"211"}, []string{"SSP"}, ".ss", "002", "014", false, false},
+	{"ST", []string{"239"}, []string{"STN"}, ".st", "002", "017", false, false},
+	{"SV", []string{"503"}, []string{"USD"}, ".sv", "019", "013", false, false},
+	{"SX", []string{"1"}, []string{"XCG"}, ".sx", "019", "029", false, false},
+	{"SY", []string{"963"}, []string{"SYP"}, ".sy", "142", "145", false, false},
+	{"SZ", []string{"268"}, []string{"SZL", "ZAR"}, ".sz", "002", "018", false, false},
+	{"TC", []string{"1"}, []string{"USD"}, ".tc", "019", "029", false, false},
+	{"TD", []string{"235"}, []string{"XAF"}, ".td", "002", "017", false, false},
+	{"TF", []string{"262"}, []string{"EUR"}, ".tf", "002", "014", false, false},
+	{"TG", []string{"228"}, []string{"XOF"}, ".tg", "002", "011", false, false},
+	{"TH", []string{"66"}, []string{"THB"}, ".th", "142", "035", false, false},
+	{"TJ", []string{"992"}, []string{"TJS"}, ".tj", "142", "143", false, false},
+	{"TK", []string{"690"}, []string{"NZD"}, ".tk", "009", "061", false, false},
+	{"TL", []string{"670"}, []string{"USD"}, ".tl", "142", "035", false, false},
+	{"TM", []string{"993"}, []string{"TMT"}, ".tm", "142", "143", false, false},
+	{"TN", []string{"216"}, []string{"TND"}, ".tn", "002", "015", false, false},
+	{"TO", []string{"676"}, []string{"TOP"}, ".to", "009", "061", false, false},
+	{"TR", []string{"90"}, []string{"TRY"}, ".tr", "142", "145", false, false},
+	{"TT", []string{"1"}, []string{"TTD"}, ".tt", "019", "029", false, false},
+	{"TV", []string{"688"}, []string{"AUD"}, ".tv", "009", "061", false, false},
+	{"TW", []string{"886"}, []string{"TWD"}, ".tw", "142", "030", false, false},
+	{"TZ", []string{"255"}, []string{"TZS"}, ".tz", "002", "014", false, false},
+	{"UA", []string{"380"}, []string{"UAH"}, ".ua", "150", "151", false, false},
+	{"UG", []string{"256"}, []string{"UGX"}, ".ug", "002", "014", false, false},
+	{"UM", []string{"1"}, []string{"USD"}, "", "009", "057", false, false},
+	{"US", []string{"1"}, []string{"USD"}, ".us", "019", "021", false, false},
+	{"UY", []string{"598"}, []string{"UYU"}, ".uy", "019", "005", false, false},
+	{"UZ", []string{"998"}, []string{"UZS"}, ".uz", "142", "143", false, false},
+	{"VA", []string{"39", "379"}, []string{"EUR"}, ".va", "150", "039", false, false},
+	{"VC", []string{"1"}, []string{"XCD"}, ".vc", "019", "029", false, false},
+	{"VE", []string{"58"}, []string{"VES"}, ".ve", "019", "005", false, false},
+	{"VG", []string{"1"}, []string{"USD"}, ".vg", "019", "029", false, false},
+	{"VI", []string{"1"}, []string{"USD"}, ".vi", "019", "029", false, false},
+	{"VN", []string{"84"}, []string{"VND"}, ".vn", "142", "035", false, false},
+	{"VU", []string{"678"}, []string{"VUV"}, ".vu", "009", "054", false, false},
+	{"WF", []string{"681"}, []string{"XPF"}, ".wf", "009", "061", false, false},
+	{"WS", []string{"685"}, []string{"WST"}, ".ws", "009", "061", false, false},
+	{"YE", []string{"967"}, []string{"YER"}, ".ye", "142", "145", false, false},
+	{"YT", []string{"262"}, []string{"EUR"}, ".yt", "002", "014", false, false},
+	{"ZA", []string{"27"}, []string{"ZAR"}, ".za", "002", "018", false, false},
+	{"ZM", []string{"260"}, []string{"ZMW"}, ".zm", "002", "014", false, false},
+	{"ZW", []string{"263"}, []string{"ZWG"}, ".zw", "002", "014", false, false},
+}
+
+// M49Names maps UN M49 codes of world regions used in CountryInfoList to their English names.
+// See: https://unstats.un.org/unsd/methodology/m49/
+var M49Names = map[string]string{
+	"001": "World",
+	"002": "Africa",
+	"005": "South America",
+	"009": "Oceania",
+	"011": "Western Africa",
+	"013": "Central America",
+	"014": "Eastern Africa",
+	"015": "Northern Africa",
+	"017": "Middle Africa",
+	"018": "Southern Africa",
+	"019": "Americas",
+	"021": "Northern America",
+	"029": "Caribbean",
+	"030": "Eastern Asia",
+	"034": "Southern Asia",
+	"035": "South-eastern Asia",
+	"039": "Southern Europe",
+	"053": "Australia and New Zealand",
+	"054": "Melanesia",
+	"057": "Micronesia",
+	"061": "Polynesia",
+	"142": "Asia",
+	"143": "Central Asia",
+	"145": "Western Asia",
+	"150": "Europe",
+	"151": "Eastern Europe",
+	"154": "Northern Europe",
+	"155": "Western Europe",
+	"202": "Sub-Saharan Africa",
+	"419": "Latin America and the Caribbean",
+}
@@ -1,151 +1,495 @@
 package is
 
 import (
+	"bytes"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bbrodriges/is/checkdigit"
 )
 
-// CreditCard check if the string is a credit card number.
-// For all special cases see: http://www.regular-expressions.info/creditcard.html
-func CreditCard(s string) bool {
-	return luhn(stripNonNumeric(s))
+// Brand is a payment card network.
+type Brand string
+
+// Card brands recognized by CardBrand
+const (
+	BrandVisa            Brand = "visa"
+	BrandMasterCard      Brand = "mastercard"
+	BrandAmericanExpress Brand = "amex"
+	BrandDinersClub      Brand = "diners"
+	BrandDiscover        Brand = "discover"
+	BrandJCB             Brand = "jcb"
+	BrandUnionPay        Brand = "unionpay"
+	BrandMaestro         Brand = "maestro"
+	BrandMir             Brand = "mir"
+	BrandRuPay           Brand = "rupay"
+	BrandElo             Brand = "elo"
+	BrandHipercard       Brand = "hipercard"
+	BrandTroy            Brand = "troy"
+	BrandVerve           Brand = "verve"
+)
+
+// iinRange is a range of Issuer Identification Numbers assigned to a card brand.
+// from and to are inclusive prefixes of the same length.
+type iinRange struct {
+	brand   Brand
+	from    string
+	to      string
+	lengths []int
 }
 
-// VisaCard verifies Visa credit card number.
-// All Visa card numbers start with a 4.
-// New cards have 16 digits. Old cards have 13.
-func VisaCard(s string) bool {
-	if len(s) == 0 {
+// iinTable lists card number prefixes and allowed lengths per brand.
+// When several ranges match a number, the one with the longest prefix wins.
+// See: https://en.wikipedia.org/wiki/Payment_card_number#Issuer_identification_number_(IIN)
+var iinTable = []iinRange{
+	{BrandVisa, "4", "4", []int{13, 16}},
+
+	// Diners Club and MasterCard co-branded cards begin with 5
+	{BrandMasterCard, "5", "5", []int{16}},
+	{BrandMasterCard, "51", "55", []int{16}},
+	{BrandMasterCard, "2221", "2720", []int{16}},
+
+	{BrandAmericanExpress, "34", "34", []int{15}},
+	{BrandAmericanExpress, "37", "37", []int{15}},
+
+	{BrandDinersClub, "300", "305", []int{14}},
+	{BrandDinersClub, "36", "36", []int{14}},
+	{BrandDinersClub, "38", "38", []int{14}},
+
+	{BrandDiscover, "6011", "6011", []int{16}},
+	{BrandDiscover, "65", "65", []int{16}},
+
+	{BrandJCB, "35", "35", []int{16}},
+	{BrandJCB, "2131", "2131", []int{15}},
+	{BrandJCB, "1800", "1800", []int{15}},
+
+	{BrandUnionPay, "62", "62", []int{16, 17, 18, 19}},
+	{BrandUnionPay, "8100", "8171", []int{16, 17, 18, 19}},
+
+	{BrandMaestro, "5018", "5018", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "5020", "5020", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "5038", "5038", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "56", "58", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "5893", "5893", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "6304", "6304", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "6759", "6759", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{BrandMaestro, "6761", "6763", []int{12, 13, 14, 15, 16, 17, 18, 19}},
+
+	{BrandMir, "2200", "2204", []int{16, 17, 18, 19}},
+
+	{BrandRuPay, "508500", "508999", []int{16}},
+	{BrandRuPay, "606985", "607984", []int{16}},
+	{BrandRuPay, "608001", "608500", []int{16}},
+	{BrandRuPay, "652150", "653149", []int{16}},
+
+	{BrandElo, "401178", "401179", []int{16}},
+	{BrandElo, "431274", "431274", []int{16}},
+	{BrandElo, "438935", "438935", []int{16}},
+	{BrandElo, "451416", "451416", []int{16}},
+	{BrandElo, "457393", "457393", []int{16}},
+	{BrandElo, "457631", "457632", []int{16}},
+	{BrandElo, "504175", "504175", []int{16}},
+	{BrandElo, "506699", "506778", []int{16}},
+	{BrandElo, "509000", "509999", []int{16}},
+	{BrandElo, "627780", "627780", []int{16}},
+	{BrandElo, "636297", "636297", []int{16}},
+	{BrandElo, "636368", "636368", []int{16}},
+	{BrandElo, "650031", "650033", []int{16}},
+	{BrandElo, "650035", "650051", []int{16}},
+	{BrandElo, "650405", "650439", []int{16}},
+	{BrandElo, "650485", "650538", []int{16}},
+	{BrandElo, "650541", "650598", []int{16}},
+	{BrandElo, "650700", "650718", []int{16}},
+	{BrandElo, "650720", "650727", []int{16}},
+	{BrandElo, "650901", "650978", []int{16}},
+	{BrandElo, "651652", "651679", []int{16}},
+	{BrandElo, "655000", "655019", []int{16}},
+	{BrandElo, "655021", "655058", []int{16}},
+
+	{BrandHipercard, "384100", "384100", []int{16, 19}},
+	{BrandHipercard, "384140", "384140", []int{16, 19}},
+	{BrandHipercard, "384160", "384160", []int{16, 19}},
+	{BrandHipercard, "606282", "606282", []int{16, 19}},
+	{BrandHipercard, "637095", "637095", []int{16, 19}},
+	{BrandHipercard, "637568", "637568", []int{16, 19}},
+	{BrandHipercard, "637599", "637599", []int{16, 19}},
+	{BrandHipercard, "637609", "637609", []int{16, 19}},
+	{BrandHipercard, "637612", "637612", []int{16, 19}},
+
+	{BrandTroy, "9792", "9792", []int{16}},
+
+	{BrandVerve, "506099", "506198", []int{16, 18, 19}},
+	{BrandVerve, "507865", "507964", []int{16, 18, 19}},
+	{BrandVerve, "650002", "650027", []int{16, 18, 19}},
+}
+
+// match reports whether the digits-only card number s belongs to the range.
+func (r *iinRange) match(s string) bool {
+	if len(s) < len(r.from) {
 		return false
 	}
 
-	s = stripNonNumeric(s)
-
-	if len(s) != 13 && len(s) != 16 {
+	p := s[:len(r.from)]
+	if p < r.from || r.to < p {
 		return false
 	}
 
-	if s[0] != '4' {
-		return false
+	for _, l := range r.lengths {
+		if len(s) == l {
+			return true
+		}
 	}
 
-	return luhn(s)
+	return false
 }
 
-// MasterCard verifies Mastercard credit card number.
-// MasterCard numbers either start with the numbers 51 through 55
-// or with the numbers 2221 through 2720. All have 16 digits.
-// There are Diners Club cards that begin with 5 and have 16 digits.
-// These are a joint venture between Diners Club and MasterCard,
-// and should be processed like a MasterCard.
-func MasterCard(s string) bool {
-	if len(s) == 0 {
-		return false
+// CardBrand returns the brand of the card number.
+// Non-numeric characters are ignored. The second value is false if the number
+// does not belong to any known brand or fails the Luhn check.
+func CardBrand(s string) (Brand, bool) {
+	s = stripNonNumeric(s)
+	r := cardRange(s)
+	if r == nil || !checkdigit.Luhn.Verify(s) {
+		return "", false
 	}
 
-	s = stripNonNumeric(s)
+	return r.brand, true
+}
 
-	if len(s) != 16 {
-		return false
+// cardRange returns the most specific range the digits-only card number s belongs to,
+// regardless of its check digit. When several ranges match, the one with the longest prefix wins.
+func cardRange(s string) *iinRange {
+	var best *iinRange
+	for i := range iinTable {
+		r := &iinTable[i]
+		if r.match(s) && (best == nil || len(r.from) > len(best.from)) {
+			best = r
+		}
 	}
 
-	ft, _ := strconv.Atoi(s[0:2])
-	ff, _ := strconv.Atoi(s[0:4])
+	return best
+}
 
-	if s[0:1] != "5" && (55 < ft || ft < 51) && (2720 < ff || ft < 2221) {
-		return false
+// CardRange is a range of Issuer Identification Numbers assigned to a card brand.
+type CardRange struct {
+	// From and To are inclusive prefixes of the same length, e.g. "51" and "55".
+	From string
+	To   string
+	// Lengths are the allowed numbers of digits of cards in the range.
+	Lengths []int
+}
+
+// CardRanges returns the prefix ranges of the brand which CardBrand recognizes.
+// Ranges of other brands may be nested in them, e.g. Elo prefixes starting with 4
+// are within the Visa range, and the most specific range wins.
+func CardRanges(brand Brand) []CardRange {
+	var ranges []CardRange
+	for _, r := range iinTable {
+		if r.brand == brand {
+			ranges = append(ranges, CardRange{From: r.from, To: r.to, Lengths: append([]int(nil), r.lengths...)})
+		}
 	}
 
-	return luhn(s)
+	return ranges
 }
 
-// AmericanExpressCard verifies AmericanExpress credit card number.
-// American Express card numbers start with 34 or 37 and have 15 digits.
-func AmericanExpressCard(s string) bool {
-	if len(s) == 0 {
-		return false
+// cardOfBrand reports whether s is a valid number within any range of the brand.
+// Unlike CardBrand, ranges of more specific brands nested in them are not excluded.
+func cardOfBrand(s string, brand Brand) bool {
+	s = stripNonNumeric(s)
+	for i := range iinTable {
+		if r := &iinTable[i]; r.brand == brand && r.match(s) {
+			return checkdigit.Luhn.Verify(s)
+		}
 	}
 
-	s = stripNonNumeric(s)
+	return false
+}
 
-	if len(s) != 15 {
-		return false
+// CreditCard check if the string is a credit card number.
+// For all special cases see: http://www.regular-expressions.info/creditcard.html
+func CreditCard(s string) bool {
+	return CheckCreditCard(s) == nil
+}
+
+// CheckCreditCard is like CreditCard but returns a *ValidationError explaining the failure.
+// Like CreditCard, it ignores non-numeric characters.
+func CheckCreditCard(s string) error {
+	if len(s) == 0 {
+		return validationError("CreditCard", ReasonEmpty, -1)
 	}
 
-	if s[0:2] != "34" && s[0:2] != "37" {
-		return false
+	d := stripNonNumeric(s)
+	if len(d) < 2 {
+		return validationError("CreditCard", ReasonLength, -1)
 	}
 
-	return luhn(s)
+	if !checkdigit.Luhn.Verify(d) {
+		return validationError("CreditCard", ReasonChecksum, strings.LastIndexAny(s, "0123456789"))
+	}
+
+	return nil
+}
+
+// VisaCard verifies Visa credit card number.
+// All Visa card numbers start with a 4.
+// New cards have 16 digits. Old cards have 13.
+func VisaCard(s string) bool {
+	return cardOfBrand(s, BrandVisa)
+}
+
+// MasterCard verifies Mastercard credit card number.
+// MasterCard numbers either start with the numbers 51 through 55
+// or with the numbers 2221 through 2720. All have 16 digits.
+// There are Diners Club cards that begin with 5 and have 16 digits.
+// These are a joint venture between Diners Club and MasterCard,
+// and should be processed like a MasterCard.
+func MasterCard(s string) bool {
+	return cardOfBrand(s, BrandMasterCard)
+}
+
+// AmericanExpressCard verifies AmericanExpress credit card number.
+// American Express card numbers start with 34 or 37 and have 15 digits.
+func AmericanExpressCard(s string) bool {
+	return cardOfBrand(s, BrandAmericanExpress)
 }
 
 // DinersClubCard verifies DinersClub credit card number.
 // Diners Club card numbers begin with 300 through 305, 36 or 38.
 // All have 14 digits.
 func DinersClubCard(s string) bool {
-	if len(s) == 0 {
-		return false
-	}
+	return cardOfBrand(s, BrandDinersClub)
+}
 
-	s = stripNonNumeric(s)
+// DiscoverCard verifies Discover credit card number.
+// Discover card numbers begin with 6011 or 65. All have 16 digits.
+func DiscoverCard(s string) bool {
+	return cardOfBrand(s, BrandDiscover)
+}
 
-	if len(s) != 14 {
-		return false
+// JCBCard verifies JCB credit card number.
+// JCB cards beginning with 2131 or 1800 have 15 digits.
+// JCB cards beginning with 35 have 16 digits.
+func JCBCard(s string) bool {
+	return cardOfBrand(s, BrandJCB)
+}
+
+// UnionPayCard verifies China UnionPay card number.
+// UnionPay card numbers begin with 62 or 8100 through 8171 and have 16 to 19 digits.
+func UnionPayCard(s string) bool {
+	return cardOfBrand(s, BrandUnionPay)
+}
+
+// MaestroCard verifies Maestro card number.
+// Maestro card numbers begin with 5018, 5020, 5038, 56 through 58, 5893,
+// 6304, 6759 or 6761 through 6763 and have 12 to 19 digits.
+func MaestroCard(s string) bool {
+	return cardOfBrand(s, BrandMaestro)
+}
+
+// MirCard verifies Mir card number.
+// Mir card numbers begin with 2200 through 2204 and have 16 to 19 digits.
+func MirCard(s string) bool {
+	return cardOfBrand(s, BrandMir)
+}
+
+// RuPayCard verifies RuPay card number.
+// RuPay card numbers begin with 508500 through 508999, 606985 through 607984,
+// 608001 through 608500 or 652150 through 653149. All have 16 digits.
+func RuPayCard(s string) bool {
+	return cardOfBrand(s, BrandRuPay)
+}
+
+// EloCard verifies Elo card number.
+// Elo card numbers belong to a list of six digit ranges and have 16 digits.
+func EloCard(s string) bool {
+	return cardOfBrand(s, BrandElo)
+}
+
+// HipercardCard verifies Hipercard card number.
+// Hipercard card numbers begin with 384100, 384140, 384160, 606282, 637095,
+// 637568, 637599, 637609 or 637612 and have 16 or 19 digits.
+func HipercardCard(s string) bool {
+	return cardOfBrand(s, BrandHipercard)
+}
+
+// TroyCard verifies Troy card number.
+// Troy card numbers begin with 9792 and have 16 digits.
+func TroyCard(s string) bool {
+	return cardOfBrand(s, BrandTroy)
+}
+
+// VerveCard verifies Verve card number.
+// Verve card numbers begin with 506099 through 506198, 507865 through 507964
+// or 650002 through 650027 and have 16, 18 or 19 digits.
+func VerveCard(s string) bool {
+	return cardOfBrand(s, BrandVerve)
+}
+
+// cardExpiryYears is how many years after now a card may expire at most.
+// Issuers print expiry dates up to a few years ahead, so later dates are typos.
+const cardExpiryYears = 20
+
+// CardExpiry checks if the card expiry date has not passed at the moment now.
+// Year may have two or four digits. If year is empty, month is parsed as
+// a combined date in the "MM/YY", "MM/YYYY" or "MM / YY" form.
+// Two-digit years are taken from the hundred years starting with the year of now,
+// e.g. "01/00" is January 2100 in 2099.
+// A card is valid through the last day of its expiry month
+// and is rejected if it expires more than 20 years after now.
+func CardExpiry(month, year string, now time.Time) bool {
+	if year == "" {
+		p := strings.SplitN(month, "/", 2)
+		if len(p) != 2 {
+			return false
+		}
+		month, year = strings.TrimSpace(p[0]), strings.TrimSpace(p[1])
 	}
 
-	ft, _ := strconv.Atoi(s[0:3])
+	if len(month) != 1 && len(month) != 2 || !Numeric(month) {
+		return false
+	}
 
-	if s[0:2] != "36" && s[0:2] != "38" && (305 < ft || ft < 300) {
+	if len(year) != 2 && len(year) != 4 || !Numeric(year) {
 		return false
 	}
 
-	return luhn(s)
-}
+	m, _ := strconv.Atoi(month)
+	y, _ := strconv.Atoi(year)
 
-// DiscoverCard verifies Discover credit card number.
-// Discover card numbers begin with 6011 or 65. All have 16 digits.
-func DiscoverCard(s string) bool {
-	if len(s) == 0 {
+	if m < 1 || m > 12 {
 		return false
 	}
 
-	s = stripNonNumeric(s)
+	if len(year) == 2 {
+		y = now.Year() + (y-now.Year()%100+100)%100
+	}
 
-	if len(s) != 16 {
-		return false
+	exp := y*12 + m - 1
+	cur := now.Year()*12 + int(now.Month()) - 1
+
+	return cur <= exp && exp <= cur+cardExpiryYears*12
+}
+
+// cvvLengths lists security code lengths which differ from the common 3 digits.
+var cvvLengths = map[Brand]int{
+	BrandAmericanExpress: 4,
+}
+
+// CardCVV checks if the string is a valid card security code (CVV, CVC or CID) for the brand.
+// American Express uses 4 digit CIDs, other networks use 3 digit codes.
+// brand is either a Brand value (e.g. "amex") or a card number,
+// in which case the brand is detected with CardBrand.
+func CardCVV(brand, cvv string) bool {
+	b := Brand(strings.ToLower(brand))
+	if !knownBrand(b) {
+		var ok bool
+		if b, ok = CardBrand(brand); !ok {
+			return false
+		}
 	}
 
-	if s[0:2] != "65" && s[0:4] != "6011" {
-		return false
+	l, ok := cvvLengths[b]
+	if !ok {
+		l = 3
 	}
 
-	return luhn(s)
+	return len(cvv) == l && Numeric(cvv)
 }
 
-// JCBCard verifies JCB credit card number.
-// JCB cards beginning with 2131 or 1800 have 15 digits.
-// JCB cards beginning with 35 have 16 digits.
-func JCBCard(s string) bool {
-	if len(s) == 0 {
-		return false
+func knownBrand(b Brand) bool {
+	for i := range iinTable {
+		if iinTable[i].brand == b {
+			return true
+		}
 	}
 
-	s = stripNonNumeric(s)
+	return false
+}
 
-	if len(s) != 15 && len(s) != 16 {
-		return false
+// cardGroups lists digit grouping of brands which differ from groups of four digits.
+var cardGroups = map[Brand][]int{
+	BrandAmericanExpress: {4, 6, 5},
+	BrandDinersClub:      {4, 6, 4},
+}
+
+// groupCard splits the digits-only card number into groups separated by spaces.
+// Grouping is looked up by the brand range the number falls into, regardless of its check digit,
+// defaulting to groups of four digits.
+func groupCard(s string) string {
+	groups := []int{4}
+	if r := cardRange(s); r != nil {
+		if g, ok := cardGroups[r.brand]; ok {
+			groups = g
+		}
 	}
 
-	if s[0:4] != "2131" && s[0:4] != "1800" && s[0:2] != "35" {
-		return false
+	b := bytes.NewBuffer(nil)
+	for i, gi := 0, 0; i < len(s); gi++ {
+		n := groups[len(groups)-1]
+		if gi < len(groups) {
+			n = groups[gi]
+		}
+		if n > len(s)-i {
+			n = len(s) - i
+		}
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(s[i : i+n])
+		i += n
 	}
 
-	if (s[0:4] == "2131" || s[0:4] == "1800") && len(s) != 15 {
-		return false
+	return b.String()
+}
+
+// FormatCard returns the card number with non-numeric characters removed
+// and digits grouped as printed on cards of its brand,
+// e.g. 4-6-5 for American Express and 4-4-4-4 for Visa.
+// The number itself is not validated.
+func FormatCard(s string) string {
+	return groupCard(stripNonNumeric(s))
+}
+
+// MaskCard returns the card number formatted like FormatCard with all digits
+// except keepFirst leading and keepLast trailing ones replaced by "*".
+// Following PCI DSS, at most the first 6 and the last 4 digits are kept,
+// and at least one digit is always masked.
+func MaskCard(s string, keepFirst, keepLast int) string {
+	s = stripNonNumeric(s)
+
+	if keepFirst > 6 {
+		keepFirst = 6
+	}
+	if keepLast > 4 {
+		keepLast = 4
+	}
+	if keepFirst < 0 {
+		keepFirst = 0
+	}
+	if keepLast < 0 {
+		keepLast = 0
+	}
+	for keepFirst+keepLast >= len(s) && keepFirst+keepLast > 0 {
+		if keepFirst > 0 {
+			keepFirst--
+		} else {
+			keepLast--
+		}
 	}
 
-	if s[0:2] == "35" && len(s) != 16 {
-		return false
+	// group before masking, since grouping depends on the brand
+	g := []byte(groupCard(s))
+	for i, d := len(g)-1, 0; i >= 0; i-- {
+		if g[i] == ' ' {
+			continue
+		}
+		if d >= keepLast && d < len(s)-keepFirst {
+			g[i] = '*'
+		}
+		d++
 	}
 
-	return luhn(s)
+	return string(g)
 }
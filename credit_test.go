@@ -1,6 +1,10 @@
 package is
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+	"time"
+)
 
 func TestCreditCard(t *testing.T) {
 	t.Parallel()
@@ -11,6 +15,7 @@ func TestCreditCard(t *testing.T) {
 	}{
 		{"", false},
 		{"foo", false},
+		{"0", false},
 		{"5398228707871528", false},
 		{"375556917985515", true},
 		{"36050234196908", true},
@@ -178,3 +183,248 @@ func TestJCBCard(t *testing.T) {
 		}
 	}
 }
+
+func TestCardBrand(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected Brand
+		ok       bool
+	}{
+		{"", "", false},
+		{"foo", "", false},
+		{"4111111111111112", "", false},
+		{"4111 1111 1111 1004", BrandVisa, true},
+		{"5555555555000000", BrandMasterCard, true},
+		{"2221000000000009", BrandMasterCard, true},
+		{"375556917985515", BrandAmericanExpress, true},
+		{"30569309025904", BrandDinersClub, true},
+		{"6011111111111000", BrandDiscover, true},
+		{"6441111111111000", "", false},
+		{"3530111333300000", BrandJCB, true},
+		{"180036877154241", BrandJCB, true},
+		{"6212345678900002", BrandUnionPay, true},
+		{"6212345678900003", "", false},
+		{"6277801111111105", "", false},
+		{"5018111111111104", BrandMaestro, true},
+		{"6304000000000000", BrandMaestro, true},
+		{"2200111111111109", BrandMir, true},
+		{"5085001111111105", BrandRuPay, true},
+		{"6069851111111101", BrandRuPay, true},
+		{"4011781111111104", BrandElo, true},
+		{"3841001111111105", BrandHipercard, true},
+		{"9792111111111108", BrandTroy, true},
+		{"5060991111111106", BrandVerve, true},
+		{"6500021111111108", BrandVerve, true},
+	}
+	for _, test := range tests {
+		actual, ok := CardBrand(test.param)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("Expected CardBrand(%q) to be %q, %v, got %q, %v", test.param, test.expected, test.ok, actual, ok)
+		}
+	}
+}
+
+func TestCardRanges(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		brand    Brand
+		expected []CardRange
+	}{
+		{"foo", nil},
+		{BrandAmericanExpress, []CardRange{{"34", "34", []int{15}}, {"37", "37", []int{15}}}},
+		{BrandJCB, []CardRange{{"35", "35", []int{16}}, {"2131", "2131", []int{15}}, {"1800", "1800", []int{15}}}},
+	}
+	for _, test := range tests {
+		actual := CardRanges(test.brand)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected CardRanges(%q) to be %v, got %v", test.brand, test.expected, actual)
+		}
+	}
+
+	CardRanges(BrandAmericanExpress)[0].Lengths[0] = 16
+	if !AmericanExpressCard("375556917985515") {
+		t.Error("Expected CardRanges to return a copy")
+	}
+}
+
+func TestCardBrandFuncs(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		function func(string) bool
+		param    string
+		expected bool
+	}{
+		{"UnionPayCard", UnionPayCard, "6212345678900002", true},
+		{"UnionPayCard", UnionPayCard, "4111111111111004", false},
+		{"MaestroCard", MaestroCard, "5018111111111104", true},
+		{"MaestroCard", MaestroCard, "5018111111111105", false},
+		{"MirCard", MirCard, "2200111111111109", true},
+		{"MirCard", MirCard, "2205111111111109", false},
+		{"RuPayCard", RuPayCard, "5085001111111105", true},
+		{"RuPayCard", RuPayCard, "508500111111110", false},
+		{"EloCard", EloCard, "4011781111111104", true},
+		{"EloCard", EloCard, "4111111111111004", false},
+		{"HipercardCard", HipercardCard, "3841001111111105", true},
+		{"HipercardCard", HipercardCard, "3841011111111105", false},
+		{"TroyCard", TroyCard, "9792111111111108", true},
+		{"TroyCard", TroyCard, "9792111111111109", false},
+		{"VerveCard", VerveCard, "5060991111111106", true},
+		{"VerveCard", VerveCard, "5060981111111106", false},
+		// ranges of more specific brands still belong to the legacy brands
+		{"VisaCard", VisaCard, "4011781111111104", true},
+		{"MasterCard", MasterCard, "5018111111111104", true},
+		{"MasterCard", MasterCard, "5000000000000009", true},
+		{"DiscoverCard", DiscoverCard, "6500021111111108", true},
+		{"VisaCard", VisaCard, "4111111111111111110", false},
+		{"JCBCard", JCBCard, "3500000000000009", true},
+	}
+	for _, test := range tests {
+		actual := test.function(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestCardExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2017, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		month    string
+		year     string
+		expected bool
+	}{
+		{"", "", false},
+		{"06", "17", true},
+		{"6", "2017", true},
+		{"05", "17", false},
+		{"12", "2016", false},
+		{"01", "18", true},
+		{"13", "18", false},
+		{"00", "18", false},
+		{"06", "2037", true},
+		{"07", "2037", false},
+		{"06", "017", false},
+		{"aa", "17", false},
+		{"06/17", "", true},
+		{"06/2020", "", true},
+		{"06 / 20", "", true},
+		{"05/17", "", false},
+		{"0617", "", false},
+		{"06/", "", false},
+	}
+	for _, test := range tests {
+		actual := CardExpiry(test.month, test.year, now)
+		if actual != test.expected {
+			t.Errorf("Expected CardExpiry(%q, %q) to be %v, got %v", test.month, test.year, test.expected, actual)
+		}
+	}
+
+	// two-digit years roll over into the next century
+	rollover := []struct {
+		date     string
+		now      time.Time
+		expected bool
+	}{
+		{"01/00", time.Date(2099, time.December, 1, 0, 0, 0, 0, time.UTC), true},
+		{"12/99", time.Date(2099, time.December, 1, 0, 0, 0, 0, time.UTC), true},
+		{"11/99", time.Date(2099, time.December, 1, 0, 0, 0, 0, time.UTC), false},
+		{"12/19", time.Date(2099, time.December, 1, 0, 0, 0, 0, time.UTC), true},
+		{"01/20", time.Date(2099, time.December, 1, 0, 0, 0, 0, time.UTC), false},
+		{"12/99", time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+	for _, test := range rollover {
+		actual := CardExpiry(test.date, "", test.now)
+		if actual != test.expected {
+			t.Errorf("Expected CardExpiry(%q) at %v to be %v, got %v", test.date, test.now, test.expected, actual)
+		}
+	}
+}
+
+func TestCardCVV(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		brand    string
+		cvv      string
+		expected bool
+	}{
+		{"", "123", false},
+		{"visa", "123", true},
+		{"VISA", "123", true},
+		{"visa", "1234", false},
+		{"amex", "1234", true},
+		{"amex", "123", false},
+		{"mastercard", "12a", false},
+		{"foo", "123", false},
+		{"375556917985515", "1234", true},
+		{"375556917985515", "123", false},
+		{"4716-2210-5188-5662", "123", true},
+		{"4716-2210-5188-5663", "123", false},
+	}
+	for _, test := range tests {
+		actual := CardCVV(test.brand, test.cvv)
+		if actual != test.expected {
+			t.Errorf("Expected CardCVV(%q, %q) to be %v, got %v", test.brand, test.cvv, test.expected, actual)
+		}
+	}
+}
+
+func TestFormatCard(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"", ""},
+		{"foo", ""},
+		{"4716221051885662", "4716 2210 5188 5662"},
+		{"4716-2210-5188-5662", "4716 2210 5188 5662"},
+		{"375556917985515", "3755 569179 85515"},
+		{"375556917985516", "3755 569179 85516"},
+		{"3032 5156 3490 24", "3032 515634 9024"},
+		{"4111111111111", "4111 1111 1111 1"},
+		{"4111111111111004123", "4111 1111 1111 1004 123"},
+	}
+	for _, test := range tests {
+		actual := FormatCard(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected FormatCard(%q) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestMaskCard(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param     string
+		keepFirst int
+		keepLast  int
+		expected  string
+	}{
+		{"", 6, 4, ""},
+		{"4716-2210-5188-5662", 6, 4, "4716 22** **** 5662"},
+		{"4716-2210-5188-5662", 0, 4, "**** **** **** 5662"},
+		{"4716-2210-5188-5662", 10, 10, "4716 22** **** 5662"},
+		{"4716-2210-5188-5662", -1, -1, "**** **** **** ****"},
+		{"375556917985515", 6, 4, "3755 56**** *5515"},
+		{"3755-569179-85516", 6, 4, "3755 56**** *5516"},
+		{"1234", 6, 4, "*234"},
+		{"1", 6, 4, "*"},
+	}
+	for _, test := range tests {
+		actual := MaskCard(test.param, test.keepFirst, test.keepLast)
+		if actual != test.expected {
+			t.Errorf("Expected MaskCard(%q, %d, %d) to be %q, got %q", test.param, test.keepFirst, test.keepLast, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package is
+
+import "testing"
+
+func TestISO4217(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"EUR", true},
+		{"USD", true},
+		{"XAU", true},
+		{"eur", false},
+		{"EU", false},
+		{"EURO", false},
+		{"DEM", false},
+		{"HRK", false},
+		{"BGN", false},
+	}
+	for _, test := range tests {
+		actual := ISO4217(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected ISO4217(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	if !ISO4217Numeric("978") || ISO4217Numeric("EUR") || ISO4217Numeric("000") {
+		t.Errorf("Expected ISO4217Numeric to accept only three-digit codes")
+	}
+
+	if c, ok := CurrencyByCode("jpy"); !ok || c.Code != "JPY" || c.Numeric != "392" || c.MinorUnits != 0 {
+		t.Errorf("Expected CurrencyByCode(%q) to find JPY, got %+v, %v", "jpy", c, ok)
+	}
+
+	for _, info := range CountryInfoList {
+		for _, code := range info.Currencies {
+			if !ISO4217(code) {
+				t.Errorf("Expected currency %q of %q to be ISO 4217 code", code, info.Alpha2Code)
+			}
+		}
+	}
+}
+
+func TestMoneyAmount(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		amount   string
+		currency string
+		expected bool
+	}{
+		{"", "EUR", false},
+		{"10", "", false},
+		{"10", "FOO", false},
+		{"10", "EUR", true},
+		{"10.9", "EUR", true},
+		{"10.99", "eur", true},
+		{"-10.99", "EUR", true},
+		{"10.999", "EUR", false},
+		{"10.", "EUR", false},
+		{".99", "EUR", false},
+		{"1e3", "EUR", false},
+		{"1,000.00", "EUR", false},
+		{"10", "JPY", true},
+		{"10.5", "JPY", false},
+		{"10.125", "KWD", true},
+		{"10.1255", "KWD", false},
+		{"1.123456", "XAU", true},
+		{"--10", "EUR", false},
+	}
+	for _, test := range tests {
+		actual := MoneyAmount(test.amount, test.currency)
+		if actual != test.expected {
+			t.Errorf("Expected MoneyAmount(%q, %q) to be %v, got %v", test.amount, test.currency, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package is
+
+import "strconv"
+
+// Reason is a machine-readable code telling why a value failed a check.
+type Reason string
+
+// Reasons reported by ValidationError
+const (
+	// ReasonEmpty means that the value is empty.
+	ReasonEmpty Reason = "empty"
+	// ReasonLength means that the value is too short or too long.
+	ReasonLength Reason = "length"
+	// ReasonCharacter means that the value contains a character not allowed at its position.
+	ReasonCharacter Reason = "character"
+	// ReasonLeadingZero means that a numeric part of the value has a leading zero.
+	ReasonLeadingZero Reason = "leading_zero"
+	// ReasonChecksum means that the check digit does not match the rest of the value.
+	ReasonChecksum Reason = "checksum"
+	// ReasonReserved means that the value is well-formed but falls into a range never assigned.
+	ReasonReserved Reason = "reserved"
+	// ReasonFormat means that the overall structure of the value is wrong.
+	ReasonFormat Reason = "format"
+	// ReasonEncoding means that an encoded payload can not be decoded.
+	ReasonEncoding Reason = "encoding"
+	// ReasonUnsupported means that the value may be valid but is not covered by the embedded data.
+	ReasonUnsupported Reason = "unsupported"
+)
+
+// ValidationError is returned by Check* functions and explains why the value failed.
+// Check* counterparts exist for validators of structured values, e.g. identifiers
+// with check digits, which can fail for several reasons. Predicates of a character class
+// or a range, e.g. Alpha or Latitude, have none. Parse* and Normalize* functions,
+// e.g. ParseIBAN, report failures with ValidationError too.
+type ValidationError struct {
+	// Validator is the name of the check, e.g. "Semver".
+	Validator string
+	// Reason is the cause of the failure.
+	Reason Reason
+	// Offset is the byte offset of the first offending character in the checked value,
+	// or -1 if the failure is not caused by a single character.
+	Offset int
+}
+
+func (e *ValidationError) Error() string {
+	msg := "is: " + e.Validator + ": " + string(e.Reason)
+	if e.Offset >= 0 {
+		msg += " at offset " + strconv.Itoa(e.Offset)
+	}
+	return msg
+}
+
+func validationError(validator string, reason Reason, offset int) error {
+	return &ValidationError{Validator: validator, Reason: reason, Offset: offset}
+}
@@ -0,0 +1,84 @@
+package is
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name   string
+		fn     func(string) error
+		param  string
+		reason Reason
+		offset int
+	}{
+		{"Semver", CheckSemver, "v1.2.3-beta.1+exp.sha.5114f85", "", 0},
+		{"Semver", CheckSemver, "", ReasonEmpty, -1},
+		{"Semver", CheckSemver, "1.2", ReasonFormat, -1},
+		{"Semver", CheckSemver, "1.02.3", ReasonLeadingZero, 2},
+		{"Semver", CheckSemver, "v1.2.3-0.03.7", ReasonLeadingZero, 9},
+		{"Semver", CheckSemver, "1.2.x", ReasonCharacter, 4},
+		{"Semver", CheckSemver, "1.0.0-+beta", ReasonCharacter, 6},
+		{"Semver", CheckSemver, "1.0.0-b+-9+eta", ReasonCharacter, 10},
+		{"Semver", CheckSemver, "1.0.0-alpha.", ReasonFormat, -1},
+		{"ISBN10", CheckISBN10, "3 401 01319 X", "", 0},
+		{"ISBN10", CheckISBN10, "", ReasonEmpty, -1},
+		{"ISBN10", CheckISBN10, "340101319", ReasonLength, -1},
+		{"ISBN10", CheckISBN10, "3 423 21412 1", ReasonChecksum, 12},
+		{"ISBN13", CheckISBN13, "978-4-87311-368-5", "", 0},
+		{"ISBN13", CheckISBN13, "978-4-87311-368", ReasonLength, -1},
+		{"ISBN13", CheckISBN13, "978 3 8362 2119 0", ReasonChecksum, 16},
+		{"SSN", CheckSSN, "191-60-2869", "", 0},
+		{"SSN", CheckSSN, "", ReasonEmpty, -1},
+		{"SSN", CheckSSN, "191-60-28", ReasonCharacter, 3},
+		{"SSN", CheckSSN, "19160286", ReasonLength, -1},
+		{"SSN", CheckSSN, "666-60-2869", ReasonReserved, 0},
+		{"SSN", CheckSSN, "191-00-2869", ReasonReserved, 4},
+		{"SSN", CheckSSN, "191 60 0000", ReasonReserved, 7},
+		{"GTIN", CheckGTIN, "4006381333931", "", 0},
+		{"GTIN", CheckGTIN, "400638133393", ReasonChecksum, 11},
+		{"GTIN", CheckGTIN, "40063813339", ReasonLength, -1},
+		{"EAN8", CheckEAN8, "9638-507", ReasonCharacter, 4},
+		{"UPCA", CheckUPCA, "", ReasonEmpty, -1},
+		{"UPCE", CheckUPCE, "20123457", ReasonFormat, 0},
+		{"ISSN", CheckISSN, "0378-5955", "", 0},
+		{"ISSN", CheckISSN, "0378 5955", ReasonCharacter, 4},
+		{"ISSN", CheckISSN, "0378-5954", ReasonChecksum, 8},
+		{"ISMN", CheckISMN, "M-2306-7118-7", "", 0},
+		{"ISMN", CheckISMN, "979-0-2306-7118-8", ReasonChecksum, 16},
+		{"ISMN", CheckISMN, "978-0-2306-7118-7", ReasonFormat, 0},
+		{"UUID", CheckUUID, "A987fbc9-4bed-3078-cf07-9141ba07c9f3", ReasonCharacter, 0},
+		{"UUIDv4", CheckUUIDv4, "57b73598-8764-3ebc-8d7e-b6e3f1b8e4d9", ReasonFormat, 14},
+		{"UUIDv5", CheckUUIDv5, "987fbc97-4bed-5078-af07-9141ba07c9f3", "", 0},
+		{"UUIDv5", CheckUUIDv5, "987fbc97-4bed-5078-cf07-9141ba07c9f3", ReasonFormat, 19},
+		{"MongoID", CheckMongoID, "507f1f77bcf86cd79943901", ReasonLength, -1},
+		{"MongoID", CheckMongoID, "507f1f77bcf86cd79943901g", ReasonCharacter, 23},
+		{"CreditCard", CheckCreditCard, "4716-2210-5188-5662", "", 0},
+		{"CreditCard", CheckCreditCard, "4716-2210-5188-5663", ReasonChecksum, 18},
+		{"CreditCard", CheckCreditCard, "foo", ReasonLength, -1},
+		{"DataURI", CheckDataURI, "data:text/plain;base64,Vml2YW11cyBmZXJtZW50dW0gc2VtcGVyIHBvcnRhLg==", "", 0},
+		{"DataURI", CheckDataURI, "", ReasonEmpty, -1},
+		{"DataURI", CheckDataURI, "abc", ReasonFormat, 0},
+		{"DataURI", CheckDataURI, "data:text/plain;base64", ReasonFormat, -1},
+		{"DataURI", CheckDataURI, "data:text,:;base85,U3VzcGVuZGlzc2UgbGVjdHVzIGxlbw==", ReasonFormat, 9},
+		{"DataURI", CheckDataURI, "data:text/plain;base64,Vml2!", ReasonEncoding, 27},
+	}
+	for _, test := range tests {
+		err := test.fn(test.param)
+		if test.reason == "" {
+			if err != nil {
+				t.Errorf("Expected Check%s(%q) to be nil, got %v", test.name, test.param, err)
+			}
+			continue
+		}
+
+		verr, ok := err.(*ValidationError)
+		if !ok {
+			t.Errorf("Expected Check%s(%q) to return *ValidationError, got %v", test.name, test.param, err)
+			continue
+		}
+		if verr.Validator != test.name || verr.Reason != test.reason || verr.Offset != test.offset {
+			t.Errorf("Expected Check%s(%q) to fail with %s at %d, got %v", test.name, test.param, test.reason, test.offset, err)
+		}
+	}
+}
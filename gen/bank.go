@@ -0,0 +1,149 @@
+package gen
+
+import (
+	"bytes"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/bbrodriges/is"
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// ibanFormats holds BBAN structures of countries using IBAN.
+var ibanFormats = is.IBANFormats()
+
+// ibanCountries lists keys of ibanFormats in a fixed order.
+var ibanCountries = sortedKeys(ibanFormats)
+
+// bban returns a random Basic Bank Account Number of the format.
+func bban(r *rand.Rand, format string) string {
+	var b bytes.Buffer
+	for _, part := range strings.Split(format, ",") {
+		n, _ := strconv.Atoi(part[:len(part)-1])
+		alphabet := alnumChars
+		switch part[len(part)-1] {
+		case 'n':
+			alphabet = digitChars
+		case 'a':
+			alphabet = letterChars
+		}
+		b.WriteString(randomString(r, alphabet, n))
+	}
+
+	return b.String()
+}
+
+// ibanCheckDigits returns ISO 7064 MOD 97-10 check digits of the IBAN with the country code and BBAN.
+func ibanCheckDigits(cc, bban string) string {
+	// letters count as two digits, 10 for "A" through 35 for "Z"
+	var digits bytes.Buffer
+	for _, c := range bban + cc {
+		n, _ := strconv.ParseInt(string(c), 36, 0)
+		digits.WriteString(strconv.FormatInt(n, 10))
+	}
+	c, _ := checkdigit.ISO7064Mod97Radix10.Generate(digits.String())
+
+	// IBANs use 97 and 98 instead of the equivalent 00 and 01
+	if n, _ := strconv.Atoi(c); n < 2 {
+		return strconv.Itoa(n + 97)
+	}
+
+	return c
+}
+
+// IBAN returns an International Bank Account Number of a random country passing is.IBAN.
+// The number is in the electronic format without spaces.
+func IBAN(r *rand.Rand) string {
+	cc := ibanCountries[r.Intn(len(ibanCountries))]
+	b := bban(r, ibanFormats[cc])
+
+	return cc + ibanCheckDigits(cc, b) + b
+}
+
+// IBANMutant returns an International Bank Account Number failing is.IBAN.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength, is.ReasonCharacter and
+// is.ReasonFormat, the latter has the country code of a country not using IBAN.
+// It panics on other reasons.
+func IBANMutant(r *rand.Rand, reason is.Reason) string {
+	s := IBAN(r)
+	switch reason {
+	case is.ReasonChecksum:
+		for {
+			if c := between(r, 2, 98, 2); c != s[2:4] {
+				return s[:2] + c + s[4:]
+			}
+		}
+	case is.ReasonLength:
+		return s[:len(s)-1]
+	case is.ReasonCharacter:
+		return replaceAt(s, 4+r.Intn(len(s)-4), '-')
+	case is.ReasonFormat:
+		for {
+			if cc := string([]byte{letter(r), letter(r)}); ibanFormats[cc] == "" {
+				return cc + s[2:]
+			}
+		}
+	}
+
+	unsupported("IBANMutant", reason)
+	return ""
+}
+
+// BIC returns an 8 or 11 character Business Identifier Code passing is.BIC.
+// The country code is a random ISO 3166-1 alpha-2 one.
+func BIC(r *rand.Rand) string {
+	s := randomString(r, letterChars, 4) + bicCountry(r) + randomString(r, alnumChars, 1)
+	for {
+		if c := alnumChars[r.Intn(len(alnumChars))]; c != 'O' {
+			s += string(c)
+			break
+		}
+	}
+
+	switch r.Intn(3) {
+	case 0:
+		return s
+	case 1:
+		return s + "XXX"
+	}
+
+	// branch codes starting with "X" other than "XXX" are not allowed
+	for {
+		if b := randomString(r, alnumChars, 3); b[0] != 'X' {
+			return s + b
+		}
+	}
+}
+
+// bicCountry returns a random officially assigned ISO 3166-1 alpha-2 code.
+func bicCountry(r *rand.Rand) string {
+	for {
+		if cc := string([]byte{letter(r), letter(r)}); is.ISO3166Alpha2(cc) {
+			return cc
+		}
+	}
+}
+
+// BICMutant returns a Business Identifier Code failing is.BIC.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and is.ReasonFormat,
+// the latter has a country code not assigned in ISO 3166-1.
+// It panics on other reasons.
+func BICMutant(r *rand.Rand, reason is.Reason) string {
+	s := BIC(r)
+	switch reason {
+	case is.ReasonLength:
+		return s[:len(s)-1]
+	case is.ReasonCharacter:
+		return replaceAt(s, r.Intn(len(s)), '-')
+	case is.ReasonFormat:
+		for {
+			if cc := string([]byte{letter(r), letter(r)}); !is.ISO3166Alpha2(cc) {
+				return s[:4] + cc + s[6:]
+			}
+		}
+	}
+
+	unsupported("BICMutant", reason)
+	return ""
+}
@@ -0,0 +1,266 @@
+package gen
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/bbrodriges/is"
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// cardBrands lists brands in a fixed order, so CreditCard is deterministic.
+var cardBrands = []is.Brand{
+	is.BrandVisa, is.BrandMasterCard, is.BrandAmericanExpress, is.BrandDinersClub, is.BrandDiscover,
+	is.BrandJCB, is.BrandUnionPay, is.BrandMaestro, is.BrandMir, is.BrandRuPay,
+	is.BrandElo, is.BrandHipercard, is.BrandTroy, is.BrandVerve,
+}
+
+// card returns a number of the brand of a random valid length.
+// The number is completed with a correct Luhn check digit unless bad is true.
+func card(r *rand.Rand, brand is.Brand, bad bool) string {
+	ranges := is.CardRanges(brand)
+
+	// retry numbers falling into ranges of more specific brands, e.g. Elo ones inside Visa
+	for {
+		c := ranges[r.Intn(len(ranges))]
+		payload := cardPayload(r, c, c.Lengths[r.Intn(len(c.Lengths))])
+		s := withCheck(checkdigit.Luhn, payload)
+		if b, _ := is.CardBrand(s); b != brand {
+			continue
+		}
+		if bad {
+			return withBadCheck(r, checkdigit.Luhn, payload)
+		}
+		return s
+	}
+}
+
+// cardPayload returns the first n-1 digits of a number in the range.
+func cardPayload(r *rand.Rand, c is.CardRange, n int) string {
+	from, _ := strconv.Atoi(c.From)
+	to, _ := strconv.Atoi(c.To)
+	payload := between(r, from, to, len(c.From))
+
+	return payload + digits(r, n-1-len(payload))
+}
+
+// cardLength reports whether n is a valid length of numbers in the range.
+func cardLength(c is.CardRange, n int) bool {
+	for _, l := range c.Lengths {
+		if l == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cardMutant returns a number of the brand failing its validator for the reason.
+// It panics on reasons other than is.ReasonChecksum and is.ReasonLength.
+func cardMutant(r *rand.Rand, brand is.Brand, generator string, reason is.Reason) string {
+	switch reason {
+	case is.ReasonChecksum:
+		return card(r, brand, true)
+	case is.ReasonLength:
+		ranges := is.CardRanges(brand)
+		for {
+			c := ranges[r.Intn(len(ranges))]
+			n := 11 + r.Intn(10)
+			if cardLength(c, n) {
+				continue
+			}
+			// the prefix may allow the length in another range, e.g. of a more specific brand
+			s := withCheck(checkdigit.Luhn, cardPayload(r, c, n))
+			if _, ok := is.CardBrand(s); !ok {
+				return s
+			}
+		}
+	}
+
+	unsupported(generator, reason)
+	return ""
+}
+
+// CreditCard returns a card number of a random brand passing is.CreditCard.
+func CreditCard(r *rand.Rand) string {
+	return card(r, cardBrands[r.Intn(len(cardBrands))], false)
+}
+
+// CreditCardMutant returns a card number of a random brand failing is.CreditCard.
+// Only is.ReasonChecksum is supported, since is.CreditCard does not check lengths.
+// It panics on other reasons.
+func CreditCardMutant(r *rand.Rand, reason is.Reason) string {
+	if reason != is.ReasonChecksum {
+		unsupported("CreditCardMutant", reason)
+	}
+
+	return card(r, cardBrands[r.Intn(len(cardBrands))], true)
+}
+
+// VisaCard returns a card number passing is.VisaCard.
+func VisaCard(r *rand.Rand) string {
+	return card(r, is.BrandVisa, false)
+}
+
+// VisaCardMutant returns a card number failing is.VisaCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func VisaCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandVisa, "VisaCardMutant", reason)
+}
+
+// MasterCard returns a card number passing is.MasterCard.
+func MasterCard(r *rand.Rand) string {
+	return card(r, is.BrandMasterCard, false)
+}
+
+// MasterCardMutant returns a card number failing is.MasterCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func MasterCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandMasterCard, "MasterCardMutant", reason)
+}
+
+// AmericanExpressCard returns a card number passing is.AmericanExpressCard.
+func AmericanExpressCard(r *rand.Rand) string {
+	return card(r, is.BrandAmericanExpress, false)
+}
+
+// AmericanExpressCardMutant returns a card number failing is.AmericanExpressCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func AmericanExpressCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandAmericanExpress, "AmericanExpressCardMutant", reason)
+}
+
+// DinersClubCard returns a card number passing is.DinersClubCard.
+func DinersClubCard(r *rand.Rand) string {
+	return card(r, is.BrandDinersClub, false)
+}
+
+// DinersClubCardMutant returns a card number failing is.DinersClubCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func DinersClubCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandDinersClub, "DinersClubCardMutant", reason)
+}
+
+// DiscoverCard returns a card number passing is.DiscoverCard.
+func DiscoverCard(r *rand.Rand) string {
+	return card(r, is.BrandDiscover, false)
+}
+
+// DiscoverCardMutant returns a card number failing is.DiscoverCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func DiscoverCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandDiscover, "DiscoverCardMutant", reason)
+}
+
+// JCBCard returns a card number passing is.JCBCard.
+func JCBCard(r *rand.Rand) string {
+	return card(r, is.BrandJCB, false)
+}
+
+// JCBCardMutant returns a card number failing is.JCBCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func JCBCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandJCB, "JCBCardMutant", reason)
+}
+
+// UnionPayCard returns a card number passing is.UnionPayCard.
+func UnionPayCard(r *rand.Rand) string {
+	return card(r, is.BrandUnionPay, false)
+}
+
+// UnionPayCardMutant returns a card number failing is.UnionPayCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func UnionPayCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandUnionPay, "UnionPayCardMutant", reason)
+}
+
+// MaestroCard returns a card number passing is.MaestroCard.
+func MaestroCard(r *rand.Rand) string {
+	return card(r, is.BrandMaestro, false)
+}
+
+// MaestroCardMutant returns a card number failing is.MaestroCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func MaestroCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandMaestro, "MaestroCardMutant", reason)
+}
+
+// MirCard returns a card number passing is.MirCard.
+func MirCard(r *rand.Rand) string {
+	return card(r, is.BrandMir, false)
+}
+
+// MirCardMutant returns a card number failing is.MirCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func MirCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandMir, "MirCardMutant", reason)
+}
+
+// RuPayCard returns a card number passing is.RuPayCard.
+func RuPayCard(r *rand.Rand) string {
+	return card(r, is.BrandRuPay, false)
+}
+
+// RuPayCardMutant returns a card number failing is.RuPayCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func RuPayCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandRuPay, "RuPayCardMutant", reason)
+}
+
+// EloCard returns a card number passing is.EloCard.
+func EloCard(r *rand.Rand) string {
+	return card(r, is.BrandElo, false)
+}
+
+// EloCardMutant returns a card number failing is.EloCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func EloCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandElo, "EloCardMutant", reason)
+}
+
+// HipercardCard returns a card number passing is.HipercardCard.
+func HipercardCard(r *rand.Rand) string {
+	return card(r, is.BrandHipercard, false)
+}
+
+// HipercardCardMutant returns a card number failing is.HipercardCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func HipercardCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandHipercard, "HipercardCardMutant", reason)
+}
+
+// TroyCard returns a card number passing is.TroyCard.
+func TroyCard(r *rand.Rand) string {
+	return card(r, is.BrandTroy, false)
+}
+
+// TroyCardMutant returns a card number failing is.TroyCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func TroyCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandTroy, "TroyCardMutant", reason)
+}
+
+// VerveCard returns a card number passing is.VerveCard.
+func VerveCard(r *rand.Rand) string {
+	return card(r, is.BrandVerve, false)
+}
+
+// VerveCardMutant returns a card number failing is.VerveCard.
+// Supported reasons are is.ReasonChecksum and is.ReasonLength.
+// It panics on other reasons.
+func VerveCardMutant(r *rand.Rand, reason is.Reason) string {
+	return cardMutant(r, is.BrandVerve, "VerveCardMutant", reason)
+}
@@ -0,0 +1,202 @@
+// Package gen generates test data for validators of package is.
+//
+// Generators cover validators of identifiers with a fixed structure: payment cards,
+// bank codes (IBAN, BIC), product and publication codes (GTIN, EAN, UPC, ISBN, ISSN,
+// ISMN), UUIDs, MongoDB IDs, SSNs, semantic versions, VAT, tax and national
+// identification numbers. Free-form values such as e-mail addresses, URLs,
+// IP and MAC addresses have no generators.
+//
+// Every generator returns a random value which passes the validator of the same name,
+// e.g. is.VisaCard(gen.VisaCard(r)) always holds. Its Mutant counterpart returns
+// a near miss which fails the validator for the requested reason only,
+// e.g. gen.ISBN13Mutant(r, is.ReasonChecksum) is a well-formed ISBN with a wrong check digit.
+// Mutant generators panic on reasons they do not support, see their documentation.
+//
+// Generators are deterministic for a given source, so fixtures can be reproduced:
+//
+//	r := rand.New(rand.NewSource(42))
+//	card := gen.VisaCard(r)
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bbrodriges/is"
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+const (
+	digitChars  = "0123456789"
+	hexChars    = "0123456789abcdef"
+	letterChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	alnumChars  = digitChars + letterChars
+)
+
+// unsupported panics on a mutation the generator can not produce.
+func unsupported(generator string, reason is.Reason) {
+	panic("gen: " + generator + " does not support " + string(reason))
+}
+
+// randomString returns n random characters of the alphabet.
+func randomString(r *rand.Rand, alphabet string, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[r.Intn(len(alphabet))]
+	}
+
+	return string(b)
+}
+
+// digits returns n random digits.
+func digits(r *rand.Rand, n int) string {
+	return randomString(r, digitChars, n)
+}
+
+// between returns a random number in the inclusive range, zero-padded to width digits.
+func between(r *rand.Rand, from, to, width int) string {
+	return fmt.Sprintf("%0*d", width, from+r.Intn(to-from+1))
+}
+
+// withCheck appends check characters of the algorithm to the payload.
+func withCheck(a checkdigit.Algorithm, payload string) string {
+	c, err := a.Generate(payload)
+	if err != nil {
+		panic("gen: " + err.Error())
+	}
+
+	return payload + c
+}
+
+// withBadCheck appends a check digit different from the correct one to the payload.
+func withBadCheck(r *rand.Rand, a checkdigit.Algorithm, payload string) string {
+	c, err := a.Generate(payload)
+	if err != nil {
+		panic("gen: " + err.Error())
+	}
+
+	for {
+		if d := digits(r, 1); d != c {
+			return payload + d
+		}
+	}
+}
+
+// replaceAt replaces the character of s at offset i.
+func replaceAt(s string, i int, c byte) string {
+	b := []byte(s)
+	b[i] = c
+
+	return string(b)
+}
+
+// letter returns a random upper case letter.
+func letter(r *rand.Rand) byte {
+	return byte('A' + r.Intn(26))
+}
+
+// fromMask returns a value of the mask with "#" replaced by a random digit, "@" by a random
+// upper case letter and "*" by either. Tokens "yyyy", "yy", "mm" and "dd" are replaced
+// by parts of a random date in 1930-2019, other characters are kept.
+func fromMask(r *rand.Rand, mask string) string {
+	if strings.ContainsAny(mask, "ymd") {
+		t := time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, r.Intn(90*365))
+		mask = strings.NewReplacer(
+			"yyyy", strconv.Itoa(t.Year()),
+			"yy", strconv.Itoa(t.Year())[2:],
+			"mm", strconv.Itoa(100 + int(t.Month()))[1:],
+			"dd", strconv.Itoa(100 + t.Day())[1:],
+		).Replace(mask)
+	}
+
+	b := []byte(mask)
+	for i, c := range b {
+		switch c {
+		case '#':
+			b[i] = digitChars[r.Intn(len(digitChars))]
+		case '@':
+			b[i] = letter(r)
+		case '*':
+			b[i] = alnumChars[r.Intn(len(alnumChars))]
+		}
+	}
+
+	return string(b)
+}
+
+// masked returns a value of a random mask which the parser accepts.
+// Masks describe the structure only, values failing check digits are retried.
+func masked(r *rand.Rand, masks []string, parse func(string) error) string {
+	for {
+		if s := fromMask(r, masks[r.Intn(len(masks))]); parse(s) == nil {
+			return s
+		}
+	}
+}
+
+// maskedMutant returns a value of a random mask which the parser rejects for the reason.
+// A valid value is changed until the parser reports the reason: a character is dropped
+// or repeated for is.ReasonLength, replaced by "!" for is.ReasonCharacter and the last
+// character is replaced by another one of its class for is.ReasonChecksum.
+// It panics on other reasons.
+func maskedMutant(r *rand.Rand, masks []string, parse func(string) error, generator string, reason is.Reason) string {
+	switch reason {
+	case is.ReasonLength, is.ReasonCharacter, is.ReasonChecksum:
+	default:
+		unsupported(generator, reason)
+	}
+
+	for {
+		valid := masked(r, masks, parse)
+		// some mutations of a value may be valid or fail for another reason, try a few
+		for attempt := 0; attempt < 10; attempt++ {
+			s := valid
+			i := r.Intn(len(s))
+			switch reason {
+			case is.ReasonLength:
+				if r.Intn(2) == 0 {
+					s = s[:i] + s[i+1:]
+				} else {
+					s = s[:i] + s[i:i+1] + s[i:]
+				}
+			case is.ReasonCharacter:
+				s = replaceAt(s, i, '!')
+			case is.ReasonChecksum:
+				class := alnumChars
+				if c := s[len(s)-1]; '0' <= c && c <= '9' {
+					class = digitChars
+				}
+				s = replaceAt(s, len(s)-1, class[r.Intn(len(class))])
+			}
+			if reasonOf(parse(s)) == reason {
+				return s
+			}
+		}
+	}
+}
+
+// reasonOf returns the reason of the *is.ValidationError, or an empty reason for other errors.
+func reasonOf(err error) is.Reason {
+	if e, ok := err.(*is.ValidationError); ok {
+		return e.Reason
+	}
+
+	return ""
+}
+
+// sortedKeys returns keys of the map with string keys in a fixed order,
+// so generators picking one are deterministic.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	return keys
+}
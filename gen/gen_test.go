@@ -0,0 +1,186 @@
+package gen
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/bbrodriges/is"
+)
+
+var (
+	cardReasons = []is.Reason{is.ReasonChecksum, is.ReasonLength}
+	codeReasons = []is.Reason{is.ReasonChecksum, is.ReasonLength, is.ReasonCharacter}
+	upceReasons = []is.Reason{is.ReasonChecksum, is.ReasonLength, is.ReasonCharacter, is.ReasonFormat}
+	uuidReasons = []is.Reason{is.ReasonLength, is.ReasonCharacter, is.ReasonFormat}
+)
+
+type generatorTest struct {
+	name      string
+	generate  func(*rand.Rand) string
+	mutate    func(*rand.Rand, is.Reason) string
+	reasons   []is.Reason
+	validator func(string) bool
+	// check reports the reason of the failure, if the validator has a Check* counterpart
+	check func(string) error
+}
+
+var generators = append([]generatorTest{
+	{"CreditCard", CreditCard, CreditCardMutant, []is.Reason{is.ReasonChecksum}, is.CreditCard, is.CheckCreditCard},
+	{"VisaCard", VisaCard, VisaCardMutant, cardReasons, is.VisaCard, nil},
+	{"MasterCard", MasterCard, MasterCardMutant, cardReasons, is.MasterCard, nil},
+	{"AmericanExpressCard", AmericanExpressCard, AmericanExpressCardMutant, cardReasons, is.AmericanExpressCard, nil},
+	{"DinersClubCard", DinersClubCard, DinersClubCardMutant, cardReasons, is.DinersClubCard, nil},
+	{"DiscoverCard", DiscoverCard, DiscoverCardMutant, cardReasons, is.DiscoverCard, nil},
+	{"JCBCard", JCBCard, JCBCardMutant, cardReasons, is.JCBCard, nil},
+	{"UnionPayCard", UnionPayCard, UnionPayCardMutant, cardReasons, is.UnionPayCard, nil},
+	{"MaestroCard", MaestroCard, MaestroCardMutant, cardReasons, is.MaestroCard, nil},
+	{"MirCard", MirCard, MirCardMutant, cardReasons, is.MirCard, nil},
+	{"RuPayCard", RuPayCard, RuPayCardMutant, cardReasons, is.RuPayCard, nil},
+	{"EloCard", EloCard, EloCardMutant, cardReasons, is.EloCard, nil},
+	{"HipercardCard", HipercardCard, HipercardCardMutant, cardReasons, is.HipercardCard, nil},
+	{"TroyCard", TroyCard, TroyCardMutant, cardReasons, is.TroyCard, nil},
+	{"VerveCard", VerveCard, VerveCardMutant, cardReasons, is.VerveCard, nil},
+	{"GTIN", GTIN, GTINMutant, codeReasons, is.GTIN, is.CheckGTIN},
+	{"EAN8", EAN8, EAN8Mutant, codeReasons, is.EAN8, is.CheckEAN8},
+	{"EAN13", EAN13, EAN13Mutant, codeReasons, is.EAN13, is.CheckEAN13},
+	{"UPCA", UPCA, UPCAMutant, codeReasons, is.UPCA, is.CheckUPCA},
+	{"UPCE", UPCE, UPCEMutant, upceReasons, is.UPCE, is.CheckUPCE},
+	{"GTIN14", GTIN14, GTIN14Mutant, codeReasons, is.GTIN14, is.CheckGTIN14},
+	{"ISMN", ISMN, ISMNMutant, codeReasons, is.ISMN, is.CheckISMN},
+	{"ISSN", ISSN, ISSNMutant, codeReasons, is.ISSN, is.CheckISSN},
+	{"ISBN10", ISBN10, ISBN10Mutant, codeReasons, is.ISBN10, is.CheckISBN10},
+	{"ISBN13", ISBN13, ISBN13Mutant, codeReasons, is.ISBN13, is.CheckISBN13},
+	{"UUID", UUID, UUIDMutant, uuidReasons, is.UUID, is.CheckUUID},
+	{"UUIDv3", UUIDv3, UUIDv3Mutant, uuidReasons, is.UUIDv3, is.CheckUUIDv3},
+	{"UUIDv4", UUIDv4, UUIDv4Mutant, uuidReasons, is.UUIDv4, is.CheckUUIDv4},
+	{"UUIDv5", UUIDv5, UUIDv5Mutant, uuidReasons, is.UUIDv5, is.CheckUUIDv5},
+	{"MongoID", MongoID, MongoIDMutant, []is.Reason{is.ReasonLength, is.ReasonCharacter}, is.MongoID, is.CheckMongoID},
+	{"SSN", SSN, SSNMutant, []is.Reason{is.ReasonLength, is.ReasonCharacter, is.ReasonReserved}, is.SSN, is.CheckSSN},
+	{"Semver", Semver, SemverMutant, []is.Reason{is.ReasonLeadingZero, is.ReasonFormat, is.ReasonCharacter}, is.Semver, is.CheckSemver},
+	{"IBAN", IBAN, IBANMutant, []is.Reason{is.ReasonChecksum, is.ReasonLength, is.ReasonCharacter, is.ReasonFormat}, is.IBAN, func(s string) error {
+		_, err := is.ParseIBAN(s)
+		return err
+	}},
+	{"BIC", BIC, BICMutant, []is.Reason{is.ReasonLength, is.ReasonCharacter, is.ReasonFormat}, is.BIC, func(s string) error {
+		_, err := is.ParseBIC(s)
+		return err
+	}},
+	{"VATID", VATID, VATIDMutant, []is.Reason{is.ReasonLength, is.ReasonCharacter, is.ReasonChecksum, is.ReasonFormat}, is.VATID, parseVATID},
+}, countryGenerators()...)
+
+// countryGenerators returns tests of TaxID and NationalID generators for every supported country.
+func countryGenerators() []generatorTest {
+	var tests []generatorTest
+	for _, country := range TaxIDCountries {
+		country := country
+		reasons := []is.Reason{is.ReasonLength, is.ReasonCharacter}
+		if !taxIDUnchecked[country] {
+			reasons = append(reasons, is.ReasonChecksum)
+		}
+		tests = append(tests, generatorTest{
+			"TaxID/" + country,
+			func(r *rand.Rand) string { return TaxID(r, country) },
+			func(r *rand.Rand, reason is.Reason) string { return TaxIDMutant(r, country, reason) },
+			reasons,
+			func(s string) bool { return is.TaxID(country, s) },
+			parseTaxID(country),
+		})
+	}
+
+	for _, country := range NationalIDCountries {
+		country := country
+		reasons := []is.Reason{is.ReasonLength, is.ReasonCharacter}
+		if !nationalIDUnchecked[country] {
+			reasons = append(reasons, is.ReasonChecksum)
+		}
+		tests = append(tests, generatorTest{
+			"NationalID/" + country,
+			func(r *rand.Rand) string { return NationalID(r, country) },
+			func(r *rand.Rand, reason is.Reason) string { return NationalIDMutant(r, country, reason) },
+			reasons,
+			func(s string) bool { return is.NationalID(country, s) },
+			parseNationalID(country),
+		})
+	}
+
+	return tests
+}
+
+// iterations is the number of values checked per generator and reason.
+const iterations = 1000
+
+func TestGenerators(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for _, test := range generators {
+		for i := 0; i < iterations; i++ {
+			if s := test.generate(r); !test.validator(s) {
+				t.Errorf("Expected %s(%q) to be true, got false", test.name, s)
+				break
+			}
+		}
+	}
+}
+
+func TestMutants(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for _, test := range generators {
+		for _, reason := range test.reasons {
+			for i := 0; i < iterations; i++ {
+				s := test.mutate(r, reason)
+				if test.validator(s) {
+					t.Errorf("Expected %s(%q) to be false, got true (mutant %s)", test.name, s, reason)
+					break
+				}
+
+				if test.check == nil {
+					continue
+				}
+				if err := test.check(s); reasonOf(err) != reason {
+					t.Errorf("Expected Check%s(%q) to fail with %s, got %v", test.name, s, reason, err)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range generators {
+		a, b := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42))
+		if x, y := test.generate(a), test.generate(b); x != y {
+			t.Errorf("Expected %s to return the same value for the same seed, got %q and %q", test.name, x, y)
+		}
+	}
+}
+
+func TestMutantUnsupported(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"CreditCardMutant", func() { CreditCardMutant(r, is.ReasonLength) }},
+		{"IBANMutant", func() { IBANMutant(r, is.ReasonReserved) }},
+		{"TaxIDMutant", func() { TaxIDMutant(r, "US", is.ReasonChecksum) }},
+		{"NationalIDMutant", func() { NationalIDMutant(r, "GB", is.ReasonChecksum) }},
+		{"NationalID", func() { NationalID(r, "US") }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected %s to panic on unsupported input", test.name)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
@@ -0,0 +1,171 @@
+package gen
+
+import (
+	"math/rand"
+
+	"github.com/bbrodriges/is"
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// code returns n characters starting with the prefix and ending with the check character.
+func code(r *rand.Rand, a checkdigit.Algorithm, prefix string, n int) string {
+	return withCheck(a, prefix+digits(r, n-1-len(prefix)))
+}
+
+// codeMutant returns a code of n characters failing its validator for the reason.
+// wrongLengths are lengths the validator rejects.
+func codeMutant(r *rand.Rand, a checkdigit.Algorithm, prefix string, n int, wrongLengths []int, generator string, reason is.Reason) string {
+	switch reason {
+	case is.ReasonChecksum:
+		return withBadCheck(r, a, prefix+digits(r, n-1-len(prefix)))
+	case is.ReasonLength:
+		return code(r, a, prefix, wrongLengths[r.Intn(len(wrongLengths))])
+	case is.ReasonCharacter:
+		// "X" is the only letter ISBN validators do not skip
+		return replaceAt(code(r, a, prefix, n), r.Intn(n-1), 'X')
+	}
+
+	unsupported(generator, reason)
+	return ""
+}
+
+// gtinLengths lists lengths accepted by is.GTIN.
+var gtinLengths = []int{8, 12, 13, 14}
+
+// GTIN returns a Global Trade Item Number of a random length passing is.GTIN.
+func GTIN(r *rand.Rand) string {
+	return code(r, checkdigit.GTIN, "", gtinLengths[r.Intn(len(gtinLengths))])
+}
+
+// GTINMutant returns a Global Trade Item Number failing is.GTIN.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func GTINMutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.GTIN, "", gtinLengths[r.Intn(len(gtinLengths))], []int{7, 9, 10, 11, 15}, "GTINMutant", reason)
+}
+
+// EAN8 returns an 8 digit European Article Number passing is.EAN8.
+func EAN8(r *rand.Rand) string {
+	return code(r, checkdigit.GTIN, "", 8)
+}
+
+// EAN8Mutant returns an European Article Number failing is.EAN8.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func EAN8Mutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.GTIN, "", 8, []int{7, 9}, "EAN8Mutant", reason)
+}
+
+// EAN13 returns a 13 digit European Article Number passing is.EAN13.
+func EAN13(r *rand.Rand) string {
+	return code(r, checkdigit.GTIN, "", 13)
+}
+
+// EAN13Mutant returns an European Article Number failing is.EAN13.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func EAN13Mutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.GTIN, "", 13, []int{12, 14}, "EAN13Mutant", reason)
+}
+
+// UPCA returns a 12 digit Universal Product Code passing is.UPCA.
+func UPCA(r *rand.Rand) string {
+	return code(r, checkdigit.GTIN, "", 12)
+}
+
+// UPCAMutant returns a Universal Product Code failing is.UPCA.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func UPCAMutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.GTIN, "", 12, []int{11, 13}, "UPCAMutant", reason)
+}
+
+// upceMasks lists masks of UPC-E codes of number systems 0 and 1, see fromMask.
+var upceMasks = []string{"0#######", "1#######"}
+
+// UPCE returns an 8 digit zero-suppressed Universal Product Code passing is.UPCE.
+func UPCE(r *rand.Rand) string {
+	return masked(r, upceMasks, is.CheckUPCE)
+}
+
+// UPCEMutant returns a zero-suppressed Universal Product Code failing is.UPCE.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength, is.ReasonCharacter and
+// is.ReasonFormat, the latter has a number system other than 0 and 1.
+// It panics on other reasons.
+func UPCEMutant(r *rand.Rand, reason is.Reason) string {
+	if reason != is.ReasonFormat {
+		return maskedMutant(r, upceMasks, is.CheckUPCE, "UPCEMutant", reason)
+	}
+
+	return replaceAt(UPCE(r), 0, digitChars[2+r.Intn(8)])
+}
+
+// GTIN14 returns a 14 digit Global Trade Item Number passing is.GTIN14.
+func GTIN14(r *rand.Rand) string {
+	return code(r, checkdigit.GTIN, "", 14)
+}
+
+// GTIN14Mutant returns a Global Trade Item Number failing is.GTIN14.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func GTIN14Mutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.GTIN, "", 14, []int{13, 15}, "GTIN14Mutant", reason)
+}
+
+// ISMN returns a 13 digit International Standard Music Number passing is.ISMN.
+func ISMN(r *rand.Rand) string {
+	return code(r, checkdigit.GTIN, "9790", 13)
+}
+
+// ISMNMutant returns an International Standard Music Number failing is.ISMN.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func ISMNMutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.GTIN, "9790", 13, []int{12, 14}, "ISMNMutant", reason)
+}
+
+// ISSN returns an International Standard Serial Number in the "NNNN-NNNC" form passing is.ISSN.
+func ISSN(r *rand.Rand) string {
+	s := code(r, checkdigit.ISBN10, "", 8)
+	return s[:4] + "-" + s[4:]
+}
+
+// ISSNMutant returns an International Standard Serial Number failing is.ISSN.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// Mutants of the wrong length have no hyphen.
+// It panics on other reasons.
+func ISSNMutant(r *rand.Rand, reason is.Reason) string {
+	s := codeMutant(r, checkdigit.ISBN10, "", 8, []int{7, 9}, "ISSNMutant", reason)
+	if reason == is.ReasonLength {
+		return s
+	}
+
+	return s[:4] + "-" + s[4:]
+}
+
+// ISBN10 returns an ISBN version 10 passing is.ISBN10. The check digit may be "X".
+func ISBN10(r *rand.Rand) string {
+	return code(r, checkdigit.ISBN10, "", 10)
+}
+
+// ISBN10Mutant returns an ISBN version 10 failing is.ISBN10 and is.CheckISBN10 with the reason.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func ISBN10Mutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.ISBN10, "", 10, []int{9, 11}, "ISBN10Mutant", reason)
+}
+
+// isbn13Prefixes are GS1 prefixes allocated to books.
+var isbn13Prefixes = []string{"978", "979"}
+
+// ISBN13 returns an ISBN version 13 passing is.ISBN13.
+func ISBN13(r *rand.Rand) string {
+	return code(r, checkdigit.ISBN13, isbn13Prefixes[r.Intn(len(isbn13Prefixes))], 13)
+}
+
+// ISBN13Mutant returns an ISBN version 13 failing is.ISBN13 and is.CheckISBN13 with the reason.
+// Supported reasons are is.ReasonChecksum, is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func ISBN13Mutant(r *rand.Rand, reason is.Reason) string {
+	return codeMutant(r, checkdigit.ISBN13, isbn13Prefixes[r.Intn(len(isbn13Prefixes))], 13, []int{12, 14}, "ISBN13Mutant", reason)
+}
@@ -0,0 +1,229 @@
+package gen
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/bbrodriges/is"
+)
+
+// uuid returns a lower case hyphenated UUID of the version with RFC 4122 variant bits.
+func uuid(r *rand.Rand, version byte) string {
+	s := randomString(r, hexChars, 32)
+	s = replaceAt(s, 12, version)
+	s = replaceAt(s, 16, "89ab"[r.Intn(4)])
+
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// uuidMutant returns a UUID of the version failing its validator for the reason.
+// For is.ReasonFormat the version digit is changed, or a hyphen is moved if version is 0.
+func uuidMutant(r *rand.Rand, version byte, generator string, reason is.Reason) string {
+	v := version
+	if v == 0 {
+		v = "345"[r.Intn(3)]
+	}
+	s := uuid(r, v)
+
+	switch reason {
+	case is.ReasonLength:
+		i := r.Intn(len(s))
+		return s[:i] + s[i+1:]
+	case is.ReasonCharacter:
+		i := r.Intn(len(s))
+		for s[i] == '-' {
+			i = r.Intn(len(s))
+		}
+		return replaceAt(s, i, "ghijklmnopqrstuvwxyz"[r.Intn(20)])
+	case is.ReasonFormat:
+		if version == 0 {
+			return s[:7] + "-" + s[7:8] + s[9:]
+		}
+		for {
+			if v := hexChars[r.Intn(len(hexChars))]; v != version {
+				return replaceAt(s, 14, v)
+			}
+		}
+	}
+
+	unsupported(generator, reason)
+	return ""
+}
+
+// UUID returns a version 3, 4 or 5 UUID passing is.UUID.
+func UUID(r *rand.Rand) string {
+	return uuid(r, "345"[r.Intn(3)])
+}
+
+// UUIDMutant returns a UUID failing is.UUID.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and is.ReasonFormat,
+// the latter moves a hyphen.
+// It panics on other reasons.
+func UUIDMutant(r *rand.Rand, reason is.Reason) string {
+	return uuidMutant(r, 0, "UUIDMutant", reason)
+}
+
+// UUIDv3 returns a version 3 UUID passing is.UUIDv3.
+func UUIDv3(r *rand.Rand) string {
+	return uuid(r, '3')
+}
+
+// UUIDv3Mutant returns a UUID failing is.UUIDv3.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and is.ReasonFormat,
+// the latter changes the version digit.
+// It panics on other reasons.
+func UUIDv3Mutant(r *rand.Rand, reason is.Reason) string {
+	return uuidMutant(r, '3', "UUIDv3Mutant", reason)
+}
+
+// UUIDv4 returns a version 4 UUID passing is.UUIDv4.
+func UUIDv4(r *rand.Rand) string {
+	return uuid(r, '4')
+}
+
+// UUIDv4Mutant returns a UUID failing is.UUIDv4.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and is.ReasonFormat,
+// the latter changes the version digit.
+// It panics on other reasons.
+func UUIDv4Mutant(r *rand.Rand, reason is.Reason) string {
+	return uuidMutant(r, '4', "UUIDv4Mutant", reason)
+}
+
+// UUIDv5 returns a version 5 UUID passing is.UUIDv5.
+func UUIDv5(r *rand.Rand) string {
+	return uuid(r, '5')
+}
+
+// UUIDv5Mutant returns a UUID failing is.UUIDv5.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and is.ReasonFormat,
+// the latter changes the version digit.
+// It panics on other reasons.
+func UUIDv5Mutant(r *rand.Rand, reason is.Reason) string {
+	return uuidMutant(r, '5', "UUIDv5Mutant", reason)
+}
+
+// MongoID returns a 24 character hexadecimal ObjectId passing is.MongoID.
+func MongoID(r *rand.Rand) string {
+	return randomString(r, hexChars, 24)
+}
+
+// MongoIDMutant returns an ObjectId failing is.MongoID.
+// Supported reasons are is.ReasonLength and is.ReasonCharacter.
+// It panics on other reasons.
+func MongoIDMutant(r *rand.Rand, reason is.Reason) string {
+	switch reason {
+	case is.ReasonLength:
+		n := 23
+		if r.Intn(2) == 0 {
+			n = 25
+		}
+		return randomString(r, hexChars, n)
+	case is.ReasonCharacter:
+		return replaceAt(MongoID(r), r.Intn(24), "ghijklmnopqrstuvwxyz"[r.Intn(20)])
+	}
+
+	unsupported("MongoIDMutant", reason)
+	return ""
+}
+
+// SSN returns a U.S. Social Security Number in the "AAA-GG-SSSS" form passing is.SSN.
+func SSN(r *rand.Rand) string {
+	area := between(r, 1, 898, 3)
+	if area == "666" {
+		area = "899"
+	}
+
+	return area + "-" + between(r, 1, 99, 2) + "-" + between(r, 1, 9999, 4)
+}
+
+// SSNMutant returns a U.S. Social Security Number failing is.SSN and is.CheckSSN with the reason.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and is.ReasonReserved,
+// the latter uses an area, group or serial number never assigned.
+// It panics on other reasons.
+func SSNMutant(r *rand.Rand, reason is.Reason) string {
+	s := SSN(r)
+	switch reason {
+	case is.ReasonLength:
+		i := r.Intn(len(s))
+		for s[i] == '-' {
+			i = r.Intn(len(s))
+		}
+		return s[:i] + s[i+1:]
+	case is.ReasonCharacter:
+		i := r.Intn(len(s))
+		for s[i] == '-' {
+			i = r.Intn(len(s))
+		}
+		return replaceAt(s, i, letter(r))
+	case is.ReasonReserved:
+		switch r.Intn(3) {
+		case 0:
+			return []string{"000", "666", between(r, 900, 999, 3)}[r.Intn(3)] + s[3:]
+		case 1:
+			return s[:4] + "00" + s[6:]
+		default:
+			return s[:7] + "0000"
+		}
+	}
+
+	unsupported("SSNMutant", reason)
+	return ""
+}
+
+// semverIdentChars are characters allowed in pre-release and build identifiers.
+const semverIdentChars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-"
+
+// semverIdent returns a pre-release or build identifier, numeric ones have no leading zeros.
+func semverIdent(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return strconv.Itoa(r.Intn(100))
+	}
+
+	// a letter keeps the identifier alphanumeric
+	s := randomString(r, semverIdentChars, r.Intn(6))
+	return s + string(rune('a'+r.Intn(26)))
+}
+
+// Semver returns a semantic version passing is.Semver, optionally
+// with pre-release and build identifiers, e.g. "1.12.0-rc.1+build.5".
+func Semver(r *rand.Rand) string {
+	s := strconv.Itoa(r.Intn(20)) + "." + strconv.Itoa(r.Intn(50)) + "." + strconv.Itoa(r.Intn(100))
+
+	if r.Intn(2) == 0 {
+		s += "-" + semverIdent(r)
+		for r.Intn(2) == 0 {
+			s += "." + semverIdent(r)
+		}
+	}
+
+	if r.Intn(2) == 0 {
+		s += "+" + semverIdent(r)
+		for r.Intn(2) == 0 {
+			s += "." + semverIdent(r)
+		}
+	}
+
+	return s
+}
+
+// SemverMutant returns a semantic version failing is.Semver and is.CheckSemver with the reason.
+// Supported reasons are is.ReasonLeadingZero (leading zero in the major version),
+// is.ReasonFormat (missing patch version) and is.ReasonCharacter (a comma instead of a dot).
+// It panics on other reasons.
+func SemverMutant(r *rand.Rand, reason is.Reason) string {
+	major, minor, patch := strconv.Itoa(r.Intn(20)), strconv.Itoa(r.Intn(50)), strconv.Itoa(r.Intn(100))
+	switch reason {
+	case is.ReasonLeadingZero:
+		return "0" + major + "." + minor + "." + patch
+	case is.ReasonFormat:
+		return major + "." + minor
+	case is.ReasonCharacter:
+		if r.Intn(2) == 0 {
+			return major + "," + minor + "." + patch
+		}
+		return major + "." + minor + "," + patch
+	}
+
+	unsupported("SemverMutant", reason)
+	return ""
+}
@@ -0,0 +1,186 @@
+package gen
+
+import (
+	"math/rand"
+
+	"github.com/bbrodriges/is"
+)
+
+// vatMasks lists masks of VAT identification numbers per prefix, see fromMask.
+var vatMasks = map[string][]string{
+	"AT": {"U########"},
+	"BE": {"0#########", "1#########"},
+	"BG": {"#########", "##########"},
+	"CY": {"0#######@", "1#######@", "3#######@", "4#######@", "5#######@", "9#######@"},
+	"CZ": {"########"},
+	"DE": {"#########"},
+	"DK": {"########"},
+	"EE": {"10#######"},
+	"EL": {"#########"},
+	"ES": {"########@", "@#######*", "X#######@"},
+	"FI": {"########"},
+	"FR": {"###########"},
+	"HR": {"###########"},
+	"HU": {"########"},
+	"IE": {"#######@", "#######@W"},
+	"IT": {"###########"},
+	"LT": {"#######1#", "##########1#"},
+	"LU": {"########"},
+	"LV": {"4##########", "9##########"},
+	"MT": {"########"},
+	"NL": {"#########B##"},
+	"PL": {"##########"},
+	"PT": {"#########"},
+	"RO": {"##", "#####", "##########"},
+	"SE": {"##########01"},
+	"SI": {"########"},
+	"SK": {"##########"},
+	"XI": {"#########", "############"},
+}
+
+// vatPrefixes lists prefixes of vatMasks in a fixed order.
+var vatPrefixes = sortedKeys(vatMasks)
+
+// vatIDMasks returns masks of VAT identification numbers of a random prefix.
+func vatIDMasks(r *rand.Rand) []string {
+	prefix := vatPrefixes[r.Intn(len(vatPrefixes))]
+	masks := make([]string, len(vatMasks[prefix]))
+	for i, m := range vatMasks[prefix] {
+		masks[i] = prefix + m
+	}
+
+	return masks
+}
+
+// parseVATID returns the error of is.ParseVATID.
+func parseVATID(s string) error {
+	_, err := is.ParseVATID(s)
+	return err
+}
+
+// VATID returns a VAT identification number of a random EU member state or Northern Ireland
+// passing is.VATID.
+func VATID(r *rand.Rand) string {
+	return masked(r, vatIDMasks(r), parseVATID)
+}
+
+// VATIDMutant returns a VAT identification number failing is.VATID.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter, is.ReasonChecksum and
+// is.ReasonFormat, the latter has a prefix of no member state.
+// It panics on other reasons.
+func VATIDMutant(r *rand.Rand, reason is.Reason) string {
+	if reason != is.ReasonFormat {
+		return maskedMutant(r, vatIDMasks(r), parseVATID, "VATIDMutant", reason)
+	}
+
+	s := VATID(r)
+	for {
+		if prefix := string([]byte{letter(r), letter(r)}); vatMasks[prefix] == nil {
+			return prefix + s[2:]
+		}
+	}
+}
+
+// taxIDMasks lists masks of tax identification numbers per country, see fromMask.
+var taxIDMasks = map[string][]string{
+	"AU": {"###########", "########", "#########"},
+	"BR": {"##############", "************##"},
+	"IN": {"##@@@@@####@*Z*", "@@@@@####@"},
+	"US": {"#########"},
+}
+
+// taxIDUnchecked lists countries whose tax identification numbers have no check digits.
+var taxIDUnchecked = map[string]bool{"US": true}
+
+// TaxIDCountries lists ISO 3166-1 alpha-2 codes of countries supported by TaxID.
+var TaxIDCountries = sortedKeys(taxIDMasks)
+
+// TaxID returns a tax identification number of the country passing is.TaxID.
+// It panics if the country is not one of TaxIDCountries.
+func TaxID(r *rand.Rand, country string) string {
+	return masked(r, countryMasks(taxIDMasks, country, "TaxID"), parseTaxID(country))
+}
+
+// TaxIDMutant returns a tax identification number of the country failing is.TaxID.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and, except for US numbers
+// which have no check digits, is.ReasonChecksum.
+// It panics on other reasons and if the country is not one of TaxIDCountries.
+func TaxIDMutant(r *rand.Rand, country string, reason is.Reason) string {
+	if reason == is.ReasonChecksum && taxIDUnchecked[country] {
+		unsupported("TaxIDMutant", reason)
+	}
+
+	return maskedMutant(r, countryMasks(taxIDMasks, country, "TaxID"), parseTaxID(country), "TaxIDMutant", reason)
+}
+
+// parseTaxID returns a function returning the error of is.ParseTaxID for the country.
+func parseTaxID(country string) func(string) error {
+	return func(s string) error {
+		_, err := is.ParseTaxID(country, s)
+		return err
+	}
+}
+
+// nationalIDMasks lists masks of national identification numbers per country, see fromMask.
+var nationalIDMasks = map[string][]string{
+	"AR": {"20#########", "27#########", "23#########"},
+	"BE": {"yymmdd#####"},
+	"BR": {"###########"},
+	"CA": {"#########"},
+	"CL": {"#######*", "########*"},
+	"CN": {"1#####yyyymmdd###*", "3#####yyyymmdd###*", "4#####yyyymmdd###*"},
+	"DE": {"###########"},
+	"ES": {"########@", "X#######@", "Y#######@"},
+	"FR": {"1yymm##########", "2yymm##########"},
+	"GB": {"@@######", "@@######A", "@@######D"},
+	"IN": {"############"},
+	"IT": {"@@@@@@yy@dd@###@"},
+	"KR": {"yymmdd1######", "yymmdd2######"},
+	"MX": {"@@@@yymmddHDF@@@##", "@@@@yymmddMJC@@@##", "@@@@yymmddHNE@@@##"},
+	"NL": {"#########"},
+	"PL": {"yymmdd#####"},
+	"SE": {"yymmdd####", "yyyymmdd####"},
+}
+
+// nationalIDUnchecked lists countries whose national identification numbers have no check digits.
+var nationalIDUnchecked = map[string]bool{"GB": true, "KR": true}
+
+// NationalIDCountries lists ISO 3166-1 alpha-2 codes of countries supported by NationalID.
+var NationalIDCountries = sortedKeys(nationalIDMasks)
+
+// NationalID returns a national identification number of the country passing is.NationalID.
+// Numbers encoding the birth date have one in 1930-2019.
+// It panics if the country is not one of NationalIDCountries.
+func NationalID(r *rand.Rand, country string) string {
+	return masked(r, countryMasks(nationalIDMasks, country, "NationalID"), parseNationalID(country))
+}
+
+// NationalIDMutant returns a national identification number of the country failing is.NationalID.
+// Supported reasons are is.ReasonLength, is.ReasonCharacter and, except for UK and
+// Korean numbers whose check digits are not verified, is.ReasonChecksum.
+// It panics on other reasons and if the country is not one of NationalIDCountries.
+func NationalIDMutant(r *rand.Rand, country string, reason is.Reason) string {
+	if reason == is.ReasonChecksum && nationalIDUnchecked[country] {
+		unsupported("NationalIDMutant", reason)
+	}
+
+	return maskedMutant(r, countryMasks(nationalIDMasks, country, "NationalID"), parseNationalID(country), "NationalIDMutant", reason)
+}
+
+// parseNationalID returns a function returning the error of is.ParseNationalID for the country.
+func parseNationalID(country string) func(string) error {
+	return func(s string) error {
+		_, err := is.ParseNationalID(country, s)
+		return err
+	}
+}
+
+// countryMasks returns masks of the country, panicking if the generator does not support it.
+func countryMasks(masks map[string][]string, country, generator string) []string {
+	m, ok := masks[country]
+	if !ok {
+		panic("gen: " + generator + " does not support country " + country)
+	}
+
+	return m
+}
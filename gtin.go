@@ -0,0 +1,225 @@
+package is
+
+import (
+	"strings"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// GTIN check if the string is a Global Trade Item Number of any length:
+// GTIN-8 (EAN-8), GTIN-12 (UPC-A), GTIN-13 (EAN-13) or GTIN-14.
+func GTIN(s string) bool {
+	return CheckGTIN(s) == nil
+}
+
+// CheckGTIN is like GTIN but returns a *ValidationError explaining the failure.
+func CheckGTIN(s string) error {
+	switch len(s) {
+	case 8, 12, 13, 14:
+		return checkGTIN("GTIN", s, len(s))
+	}
+
+	return checkGTIN("GTIN", s, -1)
+}
+
+// EAN8 check if the string is an 8 digit European Article Number.
+func EAN8(s string) bool {
+	return CheckEAN8(s) == nil
+}
+
+// CheckEAN8 is like EAN8 but returns a *ValidationError explaining the failure.
+func CheckEAN8(s string) error {
+	return checkGTIN("EAN8", s, 8)
+}
+
+// EAN13 check if the string is a 13 digit European Article Number.
+func EAN13(s string) bool {
+	return CheckEAN13(s) == nil
+}
+
+// CheckEAN13 is like EAN13 but returns a *ValidationError explaining the failure.
+func CheckEAN13(s string) error {
+	return checkGTIN("EAN13", s, 13)
+}
+
+// UPCA check if the string is a 12 digit Universal Product Code.
+func UPCA(s string) bool {
+	return CheckUPCA(s) == nil
+}
+
+// CheckUPCA is like UPCA but returns a *ValidationError explaining the failure.
+func CheckUPCA(s string) error {
+	return checkGTIN("UPCA", s, 12)
+}
+
+// GTIN14 check if the string is a 14 digit Global Trade Item Number.
+func GTIN14(s string) bool {
+	return CheckGTIN14(s) == nil
+}
+
+// CheckGTIN14 is like GTIN14 but returns a *ValidationError explaining the failure.
+func CheckGTIN14(s string) error {
+	return checkGTIN("GTIN14", s, 14)
+}
+
+// UPCE check if the string is an 8 digit zero-suppressed Universal Product Code.
+// The first digit is the number system (0 or 1), the last one is the check digit
+// of the UPC-A the code expands to.
+func UPCE(s string) bool {
+	return CheckUPCE(s) == nil
+}
+
+// CheckUPCE is like UPCE but returns a *ValidationError explaining the failure.
+func CheckUPCE(s string) error {
+	_, err := ExpandUPCE(s)
+	return err
+}
+
+// ExpandUPCE converts 8 digit UPC-E to 12 digit UPC-A.
+// On failure the error is a *ValidationError.
+// See: https://en.wikipedia.org/wiki/Universal_Product_Code#UPC-E
+func ExpandUPCE(s string) (string, error) {
+	if len(s) == 0 {
+		return "", validationError("UPCE", ReasonEmpty, -1)
+	}
+
+	if len(s) != 8 {
+		return "", validationError("UPCE", ReasonLength, -1)
+	}
+
+	for i := 0; i < len(s); i++ {
+		if '9' < s[i] || s[i] < '0' {
+			return "", validationError("UPCE", ReasonCharacter, i)
+		}
+	}
+
+	if s[0] != '0' && s[0] != '1' {
+		return "", validationError("UPCE", ReasonFormat, 0)
+	}
+
+	d := s[1:7]
+	var body string
+	switch d[5] {
+	case '0', '1', '2':
+		body = d[:2] + d[5:6] + "0000" + d[2:5]
+	case '3':
+		body = d[:3] + "00000" + d[3:5]
+	case '4':
+		body = d[:4] + "00000" + d[4:5]
+	default:
+		body = d[:5] + "0000" + d[5:6]
+	}
+
+	upca := s[:1] + body
+	if !checkdigit.GTIN.Verify(upca + s[7:8]) {
+		return "", validationError("UPCE", ReasonChecksum, 7)
+	}
+
+	return upca + s[7:], nil
+}
+
+// ISSN check if the string is an International Standard Serial Number
+// in the "NNNN-NNNC" form, where the check digit C may be "X".
+// The hyphen is optional.
+func ISSN(s string) bool {
+	return CheckISSN(s) == nil
+}
+
+// CheckISSN is like ISSN but returns a *ValidationError explaining the failure.
+func CheckISSN(s string) error {
+	if len(s) == 0 {
+		return validationError("ISSN", ReasonEmpty, -1)
+	}
+
+	d := make([]byte, 0, 8)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			d = append(d, c)
+		case c == '-' && i == 4:
+		case (c == 'X' || c == 'x') && i == len(s)-1:
+			d = append(d, 'X')
+		default:
+			return validationError("ISSN", ReasonCharacter, i)
+		}
+	}
+
+	if len(d) != 8 {
+		return validationError("ISSN", ReasonLength, -1)
+	}
+
+	if !checkdigit.ISBN10.Verify(string(d)) {
+		return validationError("ISSN", ReasonChecksum, len(s)-1)
+	}
+
+	return nil
+}
+
+// ISMN check if the string is an International Standard Music Number,
+// either 13 digit one starting with "979-0" or the older 10 character one
+// starting with "M". Spaces and hyphens are ignored.
+func ISMN(s string) bool {
+	return CheckISMN(s) == nil
+}
+
+// CheckISMN is like ISMN but returns a *ValidationError explaining the failure.
+func CheckISMN(s string) error {
+	if len(s) == 0 {
+		return validationError("ISMN", ReasonEmpty, -1)
+	}
+
+	b := make([]byte, 0, 13)
+	start := 0
+	if s[0] == 'M' {
+		b = append(b, "9790"...)
+		start = 1
+	}
+
+	for i := start; i < len(s); i++ {
+		switch {
+		case '0' <= s[i] && s[i] <= '9':
+			b = append(b, s[i])
+		case s[i] == ' ' || s[i] == '-':
+		default:
+			return validationError("ISMN", ReasonCharacter, i)
+		}
+	}
+
+	if len(b) != 13 {
+		return validationError("ISMN", ReasonLength, -1)
+	}
+
+	if string(b[:4]) != "9790" {
+		return validationError("ISMN", ReasonFormat, 0)
+	}
+
+	if !checkdigit.GTIN.Verify(string(b)) {
+		return validationError("ISMN", ReasonChecksum, strings.LastIndexAny(s, "0123456789"))
+	}
+
+	return nil
+}
+
+// checkGTIN checks if s consists of n digits with the GS1 check digit at the end.
+// Negative n means that the length is never valid.
+func checkGTIN(validator, s string, n int) error {
+	if len(s) == 0 {
+		return validationError(validator, ReasonEmpty, -1)
+	}
+
+	if len(s) != n {
+		return validationError(validator, ReasonLength, -1)
+	}
+
+	for i := 0; i < len(s); i++ {
+		if '9' < s[i] || s[i] < '0' {
+			return validationError(validator, ReasonCharacter, i)
+		}
+	}
+
+	if !checkdigit.GTIN.Verify(s) {
+		return validationError(validator, ReasonChecksum, len(s)-1)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package is
+
+import "testing"
+
+func TestGTIN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		function func(string) bool
+		param    string
+		expected bool
+	}{
+		{"GTIN", GTIN, "", false},
+		{"GTIN", GTIN, "96385074", true},
+		{"GTIN", GTIN, "036000291452", true},
+		{"GTIN", GTIN, "4006381333931", true},
+		{"GTIN", GTIN, "10012345678902", true},
+		{"GTIN", GTIN, "4006381333932", false},
+		{"GTIN", GTIN, "400638133393", false},
+		{"GTIN", GTIN, "400638133393a", false},
+		{"EAN8", EAN8, "96385074", true},
+		{"EAN8", EAN8, "96385075", false},
+		{"EAN8", EAN8, "4006381333931", false},
+		{"EAN13", EAN13, "4006381333931", true},
+		{"EAN13", EAN13, "9783836221191", true},
+		{"EAN13", EAN13, "036000291452", false},
+		{"UPCA", UPCA, "036000291452", true},
+		{"UPCA", UPCA, "036000291453", false},
+		{"GTIN14", GTIN14, "10012345678902", true},
+		{"GTIN14", GTIN14, "10012345678903", false},
+		{"UPCE", UPCE, "01234565", true},
+		{"UPCE", UPCE, "01234566", false},
+		{"UPCE", UPCE, "21234565", false},
+		{"UPCE", UPCE, "0123456", false},
+		{"ISSN", ISSN, "0317-8471", true},
+		{"ISSN", ISSN, "03178471", true},
+		{"ISSN", ISSN, "2434-561X", true},
+		{"ISSN", ISSN, "2434-561x", true},
+		{"ISSN", ISSN, "0317-8472", false},
+		{"ISSN", ISSN, "0317 8471", false},
+		{"ISSN", ISSN, "031-78471", false},
+		{"ISMN", ISMN, "979-0-2600-0043-8", true},
+		{"ISMN", ISMN, "9790260000438", true},
+		{"ISMN", ISMN, "M-2306-7118-7", true},
+		{"ISMN", ISMN, "M 2306 7118 7", true},
+		{"ISMN", ISMN, "M-2306-7118-8", false},
+		{"ISMN", ISMN, "9783836221191", false},
+		{"ISMN", ISMN, "", false},
+	}
+	for _, test := range tests {
+		actual := test.function(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestExpandUPCE(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"", ""},
+		{"04252614", "042100005264"},
+		{"01234531", "012300000451"},
+		{"01234543", "012340000053"},
+		{"01234565", "012345000065"},
+		{"0123456a", ""},
+		{"01234566", ""},
+	}
+	for _, test := range tests {
+		actual, err := ExpandUPCE(test.param)
+		if actual != test.expected || (err == nil) != (test.expected != "") {
+			t.Errorf("Expected ExpandUPCE(%q) to be %q, got %q, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
@@ -1,7 +1,5 @@
 package is
 
-import "strconv"
-
 func stripNonNumeric(s string) string {
 	r := []byte(s)
 	for i := len(r) - 1; i >= 0; i-- {
@@ -13,31 +11,13 @@ func stripNonNumeric(s string) string {
 	return string(r)
 }
 
-// See: https://en.wikipedia.org/wiki/Luhn_algorithm
-func luhn(s string) bool {
-	if len(s) == 0 {
-		return false
-	}
-
+// weightedSum sums digits of s multiplied by corresponding weights.
+// Extra digits or weights are ignored.
+func weightedSum(s string, weights ...int) int {
 	var sum int
-	var alter bool
-
-	for i := len(s) - 1; i >= 0; i-- {
-		mod, err := strconv.Atoi(s[i:(i + 1)])
-		if err != nil {
-			return false
-		}
-
-		if alter {
-			mod *= 2
-			if mod > 9 {
-				mod = (mod % 10) + 1
-			}
-		}
-
-		alter = !alter
-		sum += mod
+	for i := 0; i < len(s) && i < len(weights); i++ {
+		sum += int(s[i]-'0') * weights[i]
 	}
 
-	return sum%10 == 0
+	return sum
 }
@@ -0,0 +1,229 @@
+package is
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// IBANParts holds parts of an International Bank Account Number.
+type IBANParts struct {
+	// CountryCode is ISO 3166-1 alpha-2 code of the country, e.g. "DE".
+	CountryCode string
+	// CheckDigits are two digits of ISO 7064 MOD 97-10 checksum.
+	CheckDigits string
+	// BBAN is the Basic Bank Account Number in the country specific format.
+	BBAN string
+}
+
+// ibanFormats maps country codes to their BBAN structure as published in the SWIFT IBAN Registry.
+// Each part is a length followed by a character class:
+// "n" - digits, "a" - upper case letters, "c" - upper case letters and digits.
+// See: https://www.swift.com/standards/data-standards/iban
+var ibanFormats = map[string]string{
+	"AD": "4n,4n,12c",
+	"AE": "3n,16n",
+	"AL": "8n,16c",
+	"AT": "5n,11n",
+	"AZ": "4a,20c",
+	"BA": "3n,3n,8n,2n",
+	"BE": "3n,7n,2n",
+	"BG": "4a,4n,2n,8c",
+	"BH": "4a,14c",
+	"BI": "5n,5n,11n,2n",
+	"BR": "8n,5n,10n,1a,1c",
+	"BY": "4c,4n,16c",
+	"CH": "5n,12c",
+	"CR": "4n,14n",
+	"CY": "3n,5n,16c",
+	"CZ": "4n,6n,10n",
+	"DE": "8n,10n",
+	"DJ": "5n,5n,11n,2n",
+	"DK": "4n,9n,1n",
+	"DO": "4c,20n",
+	"EE": "2n,2n,11n,1n",
+	"EG": "4n,4n,17n",
+	"ES": "4n,4n,1n,1n,10n",
+	"FI": "3n,11n",
+	"FK": "2a,12n",
+	"FO": "4n,9n,1n",
+	"FR": "5n,5n,11c,2n",
+	"GB": "4a,6n,8n",
+	"GE": "2a,16n",
+	"GI": "4a,15c",
+	"GL": "4n,9n,1n",
+	"GR": "3n,4n,16c",
+	"GT": "4c,20c",
+	"HR": "7n,10n",
+	"HU": "3n,4n,1n,15n,1n",
+	"IE": "4a,6n,8n",
+	"IL": "3n,3n,13n",
+	"IQ": "4a,3n,12n",
+	"IS": "4n,2n,6n,10n",
+	"IT": "1a,5n,5n,12c",
+	"JO": "4a,4n,18c",
+	"KW": "4a,22c",
+	"KZ": "3n,13c",
+	"LB": "4n,20c",
+	"LC": "4a,24c",
+	"LI": "5n,12c",
+	"LT": "5n,11n",
+	"LU": "3n,13c",
+	"LV": "4a,13c",
+	"LY": "3n,3n,15n",
+	"MC": "5n,5n,11c,2n",
+	"MD": "2c,18c",
+	"ME": "3n,13n,2n",
+	"MK": "3n,10c,2n",
+	"MN": "4n,12n",
+	"MR": "5n,5n,11n,2n",
+	"MT": "4a,5n,18c",
+	"MU": "4a,2n,2n,12n,3n,3a",
+	"NI": "4a,20n",
+	"NL": "4a,10n",
+	"NO": "4n,6n,1n",
+	"OM": "3n,16c",
+	"PK": "4a,16c",
+	"PL": "8n,16n",
+	"PS": "4a,21c",
+	"PT": "4n,4n,11n,2n",
+	"QA": "4a,21c",
+	"RO": "4a,16c",
+	"RS": "3n,13n,2n",
+	"RU": "9n,5n,15c",
+	"SA": "2n,18c",
+	"SC": "4a,2n,2n,16n,3a",
+	"SD": "2n,12n",
+	"SE": "3n,16n,1n",
+	"SI": "5n,8n,2n",
+	"SK": "4n,6n,10n",
+	"SM": "1a,5n,5n,12c",
+	"SO": "4n,3n,12n",
+	"ST": "4n,4n,11n,2n",
+	"SV": "4a,20n",
+	"TL": "3n,14n,2n",
+	"TN": "2n,3n,13n,2n",
+	"TR": "5n,1n,16c",
+	"UA": "6n,19c",
+	"VA": "3n,15n",
+	"VG": "4a,16n",
+	"XK": "4n,10n,2n",
+	"YE": "4a,4n,18c",
+}
+
+// IBANFormats returns BBAN structures keyed by ISO 3166-1 alpha-2 codes of countries using IBAN,
+// e.g. "8n,10n" for "DE". Each comma separated part is a length followed by a character class:
+// "n" - digits, "a" - upper case letters, "c" - upper case letters and digits.
+func IBANFormats() map[string]string {
+	formats := make(map[string]string, len(ibanFormats))
+	for cc, f := range ibanFormats {
+		formats[cc] = f
+	}
+
+	return formats
+}
+
+// IBAN check if the string is a valid International Bank Account Number.
+// Spaces are ignored, so both electronic and print formats are accepted.
+// Checks country code, country specific length and BBAN structure and ISO 13616 MOD 97-10 checksum.
+func IBAN(s string) bool {
+	_, err := ParseIBAN(s)
+	return err == nil
+}
+
+// ParseIBAN validates the string as IBAN and returns its parts.
+// Country code must be officially assigned, except for the user-assigned "XK" of Kosovo.
+// On failure the error is a *ValidationError.
+// Offsets in the error are relative to s with spaces removed.
+func ParseIBAN(s string) (IBANParts, error) {
+	s = strings.Replace(s, " ", "", -1)
+
+	if len(s) == 0 {
+		return IBANParts{}, validationError("IBAN", ReasonEmpty, -1)
+	}
+
+	if len(s) < 4 {
+		return IBANParts{}, validationError("IBAN", ReasonLength, -1)
+	}
+
+	for i := 0; i < 2; i++ {
+		if 'Z' < s[i] || s[i] < 'A' {
+			return IBANParts{}, validationError("IBAN", ReasonCharacter, i)
+		}
+	}
+
+	for i := 2; i < 4; i++ {
+		if '9' < s[i] || s[i] < '0' {
+			return IBANParts{}, validationError("IBAN", ReasonCharacter, i)
+		}
+	}
+
+	cc := s[:2]
+	format, ok := ibanFormats[cc]
+	if !ok || !ISO3166Alpha2Status(cc, StatusUserAssigned) {
+		return IBANParts{}, validationError("IBAN", ReasonFormat, 0)
+	}
+
+	if err := checkBBAN(s, 4, format); err != nil {
+		return IBANParts{}, err
+	}
+
+	if ibanCheckDigits(s[4:]+s[:2]) != s[2:4] {
+		return IBANParts{}, validationError("IBAN", ReasonChecksum, 2)
+	}
+
+	return IBANParts{CountryCode: cc, CheckDigits: s[2:4], BBAN: s[4:]}, nil
+}
+
+// checkBBAN checks s starting at offset against the BBAN format.
+func checkBBAN(s string, offset int, format string) error {
+	i := offset
+	for _, part := range strings.Split(format, ",") {
+		n, _ := strconv.Atoi(part[:len(part)-1])
+		class := part[len(part)-1]
+		for end := i + n; i < end; i++ {
+			if i >= len(s) {
+				return validationError("IBAN", ReasonLength, -1)
+			}
+
+			c := s[i]
+			digit := '0' <= c && c <= '9'
+			letter := 'A' <= c && c <= 'Z'
+			if (class == 'n' && !digit) || (class == 'a' && !letter) || (class == 'c' && !digit && !letter) {
+				return validationError("IBAN", ReasonCharacter, i)
+			}
+		}
+	}
+
+	if i != len(s) {
+		return validationError("IBAN", ReasonLength, -1)
+	}
+
+	return nil
+}
+
+// ibanCheckDigits returns ISO 7064 MOD 97-10 check digits of the string of digits and upper case letters
+// as computed for IBANs: letters are replaced by numbers 10 through 35 and check digits
+// 00 and 01 are written as the equivalent 97 and 98.
+func ibanCheckDigits(s string) string {
+	var b bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; 'A' <= c && c <= 'Z' {
+			b.WriteString(strconv.Itoa(int(c-'A') + 10))
+		} else {
+			b.WriteByte(c)
+		}
+	}
+
+	c, _ := checkdigit.ISO7064Mod97Radix10.Generate(b.String())
+	switch c {
+	case "00":
+		return "97"
+	case "01":
+		return "98"
+	}
+
+	return c
+}
@@ -0,0 +1,95 @@
+package is
+
+import "testing"
+
+func TestIBAN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo", false},
+		{"DE89370400440532013000", true},
+		{"DE89 3704 0044 0532 0130 00", true},
+		{"GB29NWBK60161331926819", true},
+		{"FR1420041010050500013M02606", true},
+		{"NL91ABNA0417164300", true},
+		{"BE68539007547034", true},
+		{"CH9300762011623852957", true},
+		{"NO9386011117947", true},
+		{"MU17BOMM0101101030300200000MUR", true},
+		{"XK051212012345678906", true},
+		{"de89370400440532013000", false},
+		{"DE88370400440532013000", false},
+		{"DE8937040044053201300", false},
+		{"DE893704004405320130000", false},
+		{"GB29NWBK6016133192681A", false},
+		{"NL91ABN10417164300", false},
+		{"US64SVBKUS6S3300958879", false},
+		{"XX89370400440532013000", false},
+		{"DE97370400440532000052", true},
+		{"DE00370400440532000052", false},
+		{"DE98370400440532000034", true},
+		{"DE01370400440532000034", false},
+	}
+	for _, test := range tests {
+		actual := IBAN(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IBAN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseIBAN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected IBANParts
+		reason   Reason
+	}{
+		{"GB29 NWBK 6016 1331 9268 19", IBANParts{"GB", "29", "NWBK60161331926819"}, ""},
+		{"", IBANParts{}, ReasonEmpty},
+		{"G", IBANParts{}, ReasonLength},
+		{"G129NWBK60161331926819", IBANParts{}, ReasonCharacter},
+		{"GB2XNWBK60161331926819", IBANParts{}, ReasonCharacter},
+		{"QQ29NWBK60161331926819", IBANParts{}, ReasonFormat},
+		{"GB29NWBK6016133192681", IBANParts{}, ReasonLength},
+		{"GB29NWBK601613319268190", IBANParts{}, ReasonLength},
+		{"GB29NWB160161331926819", IBANParts{}, ReasonCharacter},
+		{"GB28NWBK60161331926819", IBANParts{}, ReasonChecksum},
+	}
+	for _, test := range tests {
+		actual, err := ParseIBAN(test.param)
+		if test.reason == "" {
+			if err != nil || actual != test.expected {
+				t.Errorf("Expected ParseIBAN(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+			}
+			continue
+		}
+		if verr, ok := err.(*ValidationError); !ok || verr.Reason != test.reason {
+			t.Errorf("Expected ParseIBAN(%q) to fail with %s, got %v", test.param, test.reason, err)
+		}
+	}
+}
+
+func TestIBANFormats(t *testing.T) {
+	t.Parallel()
+
+	for cc := range ibanFormats {
+		if !ISO3166Alpha2Status(cc, StatusUserAssigned) {
+			t.Errorf("Expected IBAN country %q to be ISO 3166-1 alpha-2 code", cc)
+		}
+	}
+
+	formats := IBANFormats()
+	if len(formats) != len(ibanFormats) || formats["DE"] != "8n,10n" {
+		t.Errorf("Expected IBANFormats to return all formats, got %v", formats)
+	}
+	formats["DE"] = "1n"
+	if !IBAN("DE89370400440532013000") {
+		t.Error("Expected IBANFormats to return a copy")
+	}
+}
@@ -0,0 +1,133 @@
+package is
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// Punycode parameters.
+// See: https://tools.ietf.org/html/rfc3492#section-5
+const (
+	punycodeBase        = 36
+	punycodeTMin        = 1
+	punycodeTMax        = 26
+	punycodeSkew        = 38
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+)
+
+// idnaToASCII converts the domain name to its ASCII form: labels are lower-cased
+// and labels with non-ASCII characters are Punycode encoded with "xn--" prefix.
+// Ideographic full stops are treated as dots. Unicode normalization is not applied.
+// The second value is false if the name is not valid UTF-8.
+// See: https://tools.ietf.org/html/rfc5891#section-4
+func idnaToASCII(s string) (string, bool) {
+	if !utf8.ValidString(s) {
+		return "", false
+	}
+
+	s = strings.NewReplacer("。", ".", "．", ".", "｡", ".").Replace(s)
+	labels := strings.Split(strings.ToLower(s), ".")
+	for i, l := range labels {
+		if !ASCII(l) && l != "" {
+			labels[i] = "xn--" + punycode(l)
+		}
+	}
+
+	return strings.Join(labels, "."), true
+}
+
+// punycode encodes the string with Punycode.
+// See: https://tools.ietf.org/html/rfc3492#section-6.3
+func punycode(s string) string {
+	var b bytes.Buffer
+	runes := []rune(s)
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+		}
+	}
+
+	basic := b.Len()
+	h := basic
+	if basic > 0 {
+		b.WriteByte('-')
+	}
+
+	n, delta, bias := rune(punycodeInitialN), 0, punycodeInitialBias
+	for h < len(runes) {
+		// the smallest code point not handled yet
+		m := rune(utf8.MaxRune)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+
+		delta += int(m-n) * (h + 1)
+		n = m
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := k - bias
+				if t < punycodeTMin {
+					t = punycodeTMin
+				} else if t > punycodeTMax {
+					t = punycodeTMax
+				}
+				if q < t {
+					break
+				}
+				b.WriteByte(punycodeDigit(t + (q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			b.WriteByte(punycodeDigit(q))
+
+			bias = punycodeAdapt(delta, h+1, h == basic)
+			delta = 0
+			h++
+		}
+
+		delta++
+		n++
+	}
+
+	return b.String()
+}
+
+// punycodeAdapt is the bias adaptation function.
+func punycodeAdapt(delta, points int, first bool) int {
+	if first {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / points
+
+	k := 0
+	for delta > (punycodeBase-punycodeTMin)*punycodeTMax/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+// punycodeDigit returns the basic code point of the digit value.
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+
+	return byte('0' + d - 26)
+}
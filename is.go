@@ -289,43 +289,78 @@ func ByteLength(s string, min, max int) bool {
 
 // UUIDv3 check if the string is a UUID version 3.
 func UUIDv3(s string) bool {
-	return UUID(s) && s[14] == '3'
+	return CheckUUIDv3(s) == nil
+}
+
+// CheckUUIDv3 is like UUIDv3 but returns a *ValidationError explaining the failure.
+func CheckUUIDv3(s string) error {
+	return checkUUID("UUIDv3", s, '3', false)
 }
 
 // UUIDv4 check if the string is a UUID version 4.
 func UUIDv4(s string) bool {
-	return UUID(s) &&
-		s[14] == '4' &&
-		(s[19] == '8' || s[19] == '9' || s[19] == 'a' || s[19] == 'b')
+	return CheckUUIDv4(s) == nil
+}
+
+// CheckUUIDv4 is like UUIDv4 but returns a *ValidationError explaining the failure.
+func CheckUUIDv4(s string) error {
+	return checkUUID("UUIDv4", s, '4', true)
 }
 
 // UUIDv5 check if the string is a UUID version 5.
 func UUIDv5(s string) bool {
-	return UUID(s) &&
-		s[14] == '5' &&
-		(s[19] == '8' || s[19] == '9' || s[19] == 'a' || s[19] == 'b')
+	return CheckUUIDv5(s) == nil
+}
+
+// CheckUUIDv5 is like UUIDv5 but returns a *ValidationError explaining the failure.
+func CheckUUIDv5(s string) error {
+	return checkUUID("UUIDv5", s, '5', true)
 }
 
 // UUID check if the string is a UUID (version 3, 4 or 5).
 func UUID(s string) bool {
+	return CheckUUID(s) == nil
+}
+
+// CheckUUID is like UUID but returns a *ValidationError explaining the failure.
+func CheckUUID(s string) error {
+	return checkUUID("UUID", s, 0, false)
+}
+
+// checkUUID checks the lower case hyphenated UUID form. Unless version is 0, the version digit
+// must be equal to it, and if variant is set, the variant must be RFC 4122 one.
+// Misplaced hyphens are reported as ReasonFormat.
+func checkUUID(validator, s string, version byte, variant bool) error {
+	if len(s) == 0 {
+		return validationError(validator, ReasonEmpty, -1)
+	}
+
 	if len(s) != 36 {
-		return false
+		return validationError(validator, ReasonLength, -1)
 	}
 
-	for i, c := range s {
-		if i == 8 || i == 13 || i == 18 || i == 23 {
-			if c != '-' {
-				return false
-			}
-			continue
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; ('f' < c || c < 'a') && ('9' < c || c < '0') && c != '-' {
+			return validationError(validator, ReasonCharacter, i)
 		}
+	}
 
-		if ('f' < c || c < 'a') && ('9' < c || c < '0') {
-			return false
+	// hyphens separate groups of 8, 4, 4, 4 and 12 digits
+	for i := 0; i < len(s); i++ {
+		if (s[i] == '-') != (i == 8 || i == 13 || i == 18 || i == 23) {
+			return validationError(validator, ReasonFormat, i)
 		}
 	}
 
-	return true
+	if version != 0 && s[14] != version {
+		return validationError(validator, ReasonFormat, 14)
+	}
+
+	if variant && s[19] != '8' && s[19] != '9' && s[19] != 'a' && s[19] != 'b' {
+		return validationError(validator, ReasonFormat, 19)
+	}
+
+	return nil
 }
 
 // JSON check if the string is valid JSON (note: uses json.Unmarshal).
@@ -445,47 +480,74 @@ func FilePath(str string) (bool, int) {
 
 // DataURI checks if a string is base64 encoded data URI such as an image
 func DataURI(s string) bool {
+	return CheckDataURI(s) == nil
+}
+
+// CheckDataURI is like DataURI but returns a *ValidationError explaining the failure.
+func CheckDataURI(s string) error {
 	if len(s) == 0 {
-		return false
+		return validationError("DataURI", ReasonEmpty, -1)
 	}
 
-	if s[:5] != "data:" {
-		return false
+	if !strings.HasPrefix(s, "data:") {
+		return validationError("DataURI", ReasonFormat, 0)
 	}
 
-	var ci int
-	for i := range s {
-		if s[i] == ',' {
-			ci = i
-			break
-		}
+	ci := strings.IndexByte(s, ',')
+	if ci < 0 {
+		return validationError("DataURI", ReasonFormat, -1)
 	}
 
-	if s[ci-7:ci] != ";base64" {
-		return false
+	if !strings.HasSuffix(s[:ci], ";base64") {
+		return validationError("DataURI", ReasonFormat, ci)
 	}
 
-	return Base64(s[ci+1:])
-}
+	if ci+1 == len(s) {
+		return validationError("DataURI", ReasonEmpty, -1)
+	}
 
-// ISO3166Alpha2 checks if a string is valid two-letter country code
-func ISO3166Alpha2(str string) bool {
-	for _, entry := range ISO3166List {
-		if str == entry.Alpha2Code {
-			return true
+	if _, err := base64.StdEncoding.DecodeString(s[ci+1:]); err != nil {
+		offset := ci + 1
+		if cie, ok := err.(base64.CorruptInputError); ok {
+			offset += int(cie)
 		}
+		if offset >= len(s) {
+			offset = len(s) - 1
+		}
+		return validationError("DataURI", ReasonEncoding, offset)
 	}
-	return false
+
+	return nil
 }
 
-// ISO3166Alpha3 checks if a string is valid three-letter country code
+// ISO3166Alpha2 checks if a string is valid two-letter country code.
+func ISO3166Alpha2(str string) bool {
+	_, ok := iso3166ByAlpha2[str]
+	return ok
+}
+
+// ISO3166Alpha2Status is like ISO3166Alpha2 but also accepts codes of the given statuses,
+// e.g. StatusUserAssigned for "XK". Officially assigned codes are always accepted.
+func ISO3166Alpha2Status(str string, accept ...Status) bool {
+	return ISO3166Alpha2(str) || len(str) == 2 && acceptCountryCode(str, accept)
+}
+
+// ISO3166Alpha3 checks if a string is valid three-letter country code.
 func ISO3166Alpha3(str string) bool {
-	for _, entry := range ISO3166List {
-		if str == entry.Alpha3Code {
-			return true
-		}
-	}
-	return false
+	_, ok := iso3166ByAlpha3[str]
+	return ok
+}
+
+// ISO3166Alpha3Status is like ISO3166Alpha3 but also accepts codes of the given statuses,
+// e.g. StatusTransitionallyReserved for "YUG". Officially assigned codes are always accepted.
+func ISO3166Alpha3Status(str string, accept ...Status) bool {
+	return ISO3166Alpha3(str) || len(str) == 3 && acceptCountryCode(str, accept)
+}
+
+// ISO3166Numeric checks if a string is valid three-digit country code
+func ISO3166Numeric(str string) bool {
+	_, ok := iso3166ByNumeric[str]
+	return ok
 }
 
 // DNSName will validate the given string as a DNS name
@@ -546,17 +608,26 @@ func MAC(str string) bool {
 
 // MongoID check if the string is a valid hex-encoded representation of a MongoDB ObjectId.
 func MongoID(str string) bool {
-	if str == "" || len(str) != 24 {
-		return false
+	return CheckMongoID(str) == nil
+}
+
+// CheckMongoID is like MongoID but returns a *ValidationError explaining the failure.
+func CheckMongoID(str string) error {
+	if str == "" {
+		return validationError("MongoID", ReasonEmpty, -1)
 	}
 
-	for _, c := range str {
-		if ('F' < c || c < 'A') && ('f' < c || c < 'a') && ('9' < c || c < '0') {
-			return false
+	if len(str) != 24 {
+		return validationError("MongoID", ReasonLength, -1)
+	}
+
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; ('F' < c || c < 'A') && ('f' < c || c < 'a') && ('9' < c || c < '0') {
+			return validationError("MongoID", ReasonCharacter, i)
 		}
 	}
 
-	return true
+	return nil
 }
 
 // Latitude check if a string is valid latitude.
@@ -598,110 +669,160 @@ func Longitude(str string) bool {
 // SSN will validate the given string as a U.S. Social Security Number
 // See: http://stackoverflow.com/a/1517044
 func SSN(s string) bool {
+	return CheckSSN(s) == nil
+}
+
+// CheckSSN is like SSN but returns a *ValidationError explaining the failure.
+func CheckSSN(s string) error {
+	if len(s) == 0 {
+		return validationError("SSN", ReasonEmpty, -1)
+	}
+
 	if len(s) != 9 && len(s) != 11 {
-		return false
+		return validationError("SSN", ReasonLength, -1)
 	}
 
-	s = stripNonNumeric(s)
+	// nine digits, optionally with two separators
+	separators := len(s) - 9
+	pos := make([]int, 0, 9)
+	for i := 0; i < len(s); i++ {
+		if '9' < s[i] || s[i] < '0' {
+			if separators == 0 {
+				return validationError("SSN", ReasonCharacter, i)
+			}
+			separators--
+			continue
+		}
+		pos = append(pos, i)
+	}
 
-	if len(s) != 9 {
-		return false
+	if len(pos) != 9 {
+		return validationError("SSN", ReasonLength, -1)
 	}
 
-	if s[:3] == "000" || s[:3] == "666" || s[3:5] == "00" || s[5:] == "0000" {
-		return false
+	s = stripNonNumeric(s)
+
+	if s[:3] == "000" || s[:3] == "666" || s[0] == '9' {
+		return validationError("SSN", ReasonReserved, pos[0])
 	}
 
-	p, err := strconv.ParseInt(s[:3], 10, 0)
-	if err != nil {
-		return false
+	if s[3:5] == "00" {
+		return validationError("SSN", ReasonReserved, pos[3])
 	}
 
-	if 900 <= p && p <= 999 {
-		return false
+	if s[5:] == "0000" {
+		return validationError("SSN", ReasonReserved, pos[5])
 	}
 
-	return true
+	return nil
 }
 
 // Semver check if string is valid semantic version
 func Semver(s string) bool {
-	// minimal valid string is "0.0.1"
-	if len(s) < 5 {
-		return false
-	}
+	return CheckSemver(s) == nil
+}
 
-	// invalid special chars sequence
-	if strings.Contains(s, "+-") || strings.Contains(s, "-+") {
-		return false
+// CheckSemver is like Semver but returns a *ValidationError explaining the failure.
+// See: https://semver.org/spec/v2.0.0.html
+func CheckSemver(s string) error {
+	if len(s) == 0 {
+		return validationError("Semver", ReasonEmpty, -1)
 	}
 
-	// strip leading "v"
+	i := 0
+	// skip leading "v"
 	if s[0] == 'v' {
-		s = s[1:]
+		i++
 	}
 
-	// get clean major, clean minor and maybe dirty patch parts
-	p := strings.SplitN(s, ".", 3)
-	if len(p) != 3 {
-		return false
-	}
+	// major, minor and patch
+	for n := 0; n < 3; n++ {
+		if n > 0 {
+			if i == len(s) {
+				return validationError("Semver", ReasonFormat, -1)
+			}
+			if s[i] != '.' {
+				return validationError("Semver", ReasonCharacter, i)
+			}
+			i++
+		}
 
-	// try to extract meta part from patch first
-	p = append(p[:2], strings.SplitN(p[2], "+", 2)...)
-	if len(p) == 3 {
-		// no meta - try to extract pre-release
-		p = append(p[:2], strings.SplitN(p[2], "-", 2)...)
-	} else {
-		// try to extract pre-release and move meta to the end
-		pr := strings.SplitN(p[2], "-", 2)
-		if len(pr) == 2 {
-			p = append(p[:2], pr[0], pr[1], p[3])
+		start := i
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == start {
+			if i == len(s) {
+				return validationError("Semver", ReasonFormat, -1)
+			}
+			return validationError("Semver", ReasonCharacter, i)
+		}
+		if i-start > 1 && s[start] == '0' {
+			return validationError("Semver", ReasonLeadingZero, start)
 		}
 	}
 
-	// check that major does not have leading zero
-	if len(p[0]) > 1 && p[0][0] == '0' {
-		return false
+	// pre-release
+	if i < len(s) && s[i] == '-' {
+		i++
+		for {
+			start := i
+			numeric := true
+			for i < len(s) && semverIdentChar(s[i]) {
+				if '9' < s[i] || s[i] < '0' {
+					numeric = false
+				}
+				i++
+			}
+			if i == start {
+				return semverIdentError(s, i)
+			}
+			if numeric && i-start > 1 && s[start] == '0' {
+				return validationError("Semver", ReasonLeadingZero, start)
+			}
+			if i == len(s) || s[i] != '.' {
+				break
+			}
+			i++
+		}
 	}
 
-	// check that minor does not have leading zero
-	if len(p[1]) > 1 && p[1][0] == '0' {
-		return false
+	// build metadata
+	if i < len(s) && s[i] == '+' {
+		i++
+		for {
+			start := i
+			for i < len(s) && semverIdentChar(s[i]) {
+				i++
+			}
+			if i == start {
+				return semverIdentError(s, i)
+			}
+			if i == len(s) || s[i] != '.' {
+				break
+			}
+			i++
+		}
 	}
 
-	// check that patch does not have leading zero
-	if len(p[2]) > 1 && p[2][0] == '0' {
-		return false
+	if i != len(s) {
+		return validationError("Semver", ReasonCharacter, i)
 	}
 
-	// check that major, minor and patch are ints
-	if !Int(p[0]) || !Int(p[1]) || !Int(p[2]) {
-		return false
-	}
+	return nil
+}
 
-	// if pre-release or meta exists - check them
-	if len(p) > 3 {
-		for i, sp := range p[3:] {
-			// neither pre-release nor meta parts must not have characters except [0-9a-zA-Z-]
-			for _, v := range sp {
-				if ('Z' < v || v < 'A') && ('z' < v || v < 'a') && ('9' < v || v < '0') && v != '.' && v != '-' {
-					return false
-				}
-			}
+// semverIdentChar reports whether c is allowed in pre-release and build identifiers.
+func semverIdentChar(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c == '-'
+}
 
-			// pre-release part must not have leading zeroes in numeric parts
-			if i == 0 {
-				for _, psp := range strings.Split(sp, ".") {
-					if len(psp) > 1 && psp[0] == '0' && (psp[1] >= '0' && psp[1] <= '9') {
-						return false
-					}
-				}
-			}
-		}
+// semverIdentError reports an empty identifier which ends at offset i.
+func semverIdentError(s string, i int) error {
+	if i == len(s) {
+		return validationError("Semver", ReasonFormat, -1)
 	}
-
-	return true
+	return validationError("Semver", ReasonCharacter, i)
 }
 
 // StringLength check string's length (including multi byte strings)
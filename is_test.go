@@ -1733,3 +1733,88 @@ func TestStringLength(t *testing.T) {
 		}
 	}
 }
+
+func TestISBNStrip(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"3 401 01319 X", true},
+		{"3-401-01319-x", true},
+		{"340101319X ", true},
+		{"X340101319 0", false},
+		{"3 401 X01319 0", false},
+		{"3a836221195", true},
+		{"ISBN 3836221195", true},
+		{"ISBN 383622119X5", false},
+	}
+	for _, test := range tests {
+		actual := ISBN10(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected ISBN10(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestISBNConversion(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) (string, error)
+		name     string
+		param    string
+		expected string
+	}{
+		{ISBN10To13, "ISBN10To13", "3836221195", "9783836221191"},
+		{ISBN10To13, "ISBN10To13", "3 401 01319 X", "9783401013190"},
+		{ISBN10To13, "ISBN10To13", "0-306-40615-2", "9780306406157"},
+		{ISBN10To13, "ISBN10To13", "3836221196", ""},
+		{ISBN10To13, "ISBN10To13", "9783836221191", ""},
+		{ISBN13To10, "ISBN13To10", "978-3-8362-2119-1", "3836221195"},
+		{ISBN13To10, "ISBN13To10", "9783401013190", "340101319X"},
+		{ISBN13To10, "ISBN13To10", "979-10-90636-07-1", ""},
+		{ISBN13To10, "ISBN13To10", "9783836221190", ""},
+		{HyphenateISBN, "HyphenateISBN", "9780306406157", "978-0-306-40615-7"},
+		{HyphenateISBN, "HyphenateISBN", "0306406152", "0-306-40615-2"},
+		{HyphenateISBN, "HyphenateISBN", "978 3836221191", "978-3-8362-2119-1"},
+		{HyphenateISBN, "HyphenateISBN", "340101319X", "3-401-01319-X"},
+		{HyphenateISBN, "HyphenateISBN", "9784873113685", "978-4-87311-368-5"},
+		{HyphenateISBN, "HyphenateISBN", "9781402894626", "978-1-4028-9462-6"},
+		{HyphenateISBN, "HyphenateISBN", "9791090636071", "979-10-90636-07-1"},
+		{HyphenateISBN, "HyphenateISBN", "9798886451740", "979-8-88645-174-0"},
+		{HyphenateISBN, "HyphenateISBN", "9788420412146", "978-84-204-1214-6"},
+		{HyphenateISBN, "HyphenateISBN", "9789100123451", "978-91-0-012345-1"},
+		{HyphenateISBN, "HyphenateISBN", "9789999999991", ""},
+		{HyphenateISBN, "HyphenateISBN", "foo", ""},
+	}
+	for _, test := range tests {
+		actual, err := test.function(test.param)
+		if actual != test.expected || (err == nil) != (test.expected != "") {
+			t.Errorf("Expected %s(%q) to be %q, got %q, %v", test.name, test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestHyphenateISBNReason(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		reason Reason
+	}{
+		{"9786600000008", ReasonReserved},
+		{"9795000000006", ReasonReserved},
+		{"9798990000001", ReasonReserved},
+		{"9791200000006", ReasonUnsupported},
+		{"9788800000000", ReasonUnsupported},
+		{"9783836221190", ReasonChecksum},
+	}
+	for _, test := range tests {
+		_, err := HyphenateISBN(test.param)
+		if verr, ok := err.(*ValidationError); !ok || verr.Reason != test.reason {
+			t.Errorf("Expected HyphenateISBN(%q) to fail with %s, got %v", test.param, test.reason, err)
+		}
+	}
+}
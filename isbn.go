@@ -1,6 +1,11 @@
 package is
 
-import "bytes"
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
 
 // ISBN check if the string is an ISBN (version 10 or 13).
 // If version value is not equal to 10 or 13, it will be check both variants.
@@ -18,65 +23,201 @@ func ISBN(s string, version int) bool {
 
 // ISBN10 check if the string is an ISBN version 10.
 func ISBN10(s string) bool {
+	return CheckISBN10(s) == nil
+}
+
+// CheckISBN10 is like ISBN10 but returns a *ValidationError explaining the failure.
+func CheckISBN10(s string) error {
+	if len(s) == 0 {
+		return validationError("ISBN10", ReasonEmpty, -1)
+	}
+
 	if len(s) < 10 {
-		return false
+		return validationError("ISBN10", ReasonLength, -1)
 	}
 
-	s = stripISBN(s, 10)
+	s, pos, err := stripISBN(s, 10)
+	if err != nil {
+		return err
+	}
 
 	if len(s) != 10 {
-		return false
+		return validationError("ISBN10", ReasonLength, -1)
 	}
 
-	var sum int
-	for i := 0; i < 9; i++ {
-		sum += (i + 1) * int(s[i]-'0')
-	}
-	if s[9] == 'X' {
-		sum += 100 // aka 10 * 10
-	} else {
-		sum += 10 * int(s[9]-'0')
+	if !checkdigit.ISBN10.Verify(s) {
+		return validationError("ISBN10", ReasonChecksum, pos[9])
 	}
 
-	return sum%11 == 0
+	return nil
 }
 
 // ISBN13 check if the string is an ISBN version 13.
 func ISBN13(s string) bool {
+	return CheckISBN13(s) == nil
+}
+
+// CheckISBN13 is like ISBN13 but returns a *ValidationError explaining the failure.
+func CheckISBN13(s string) error {
+	if len(s) == 0 {
+		return validationError("ISBN13", ReasonEmpty, -1)
+	}
+
 	if len(s) < 13 {
-		return false
+		return validationError("ISBN13", ReasonLength, -1)
 	}
 
-	s = stripISBN(s, 13)
+	s, pos, err := stripISBN(s, 13)
+	if err != nil {
+		return err
+	}
 
 	if len(s) != 13 {
-		return false
+		return validationError("ISBN13", ReasonLength, -1)
 	}
 
-	var sum int
-	for i := 0; i < 12; i++ {
-		if i%2 == 0 {
-			sum += int(s[i] - '0')
-		} else {
-			sum += int(s[i]-'0') * 3
-		}
+	if !checkdigit.ISBN13.Verify(s) {
+		return validationError("ISBN13", ReasonChecksum, pos[12])
 	}
 
-	return int(s[12]-'0')-((10-(sum%10))%10) == 0
+	return nil
 }
 
-func stripISBN(s string, version int) string {
+// stripISBN returns digits of the ISBN along with their offsets in s.
+// Characters other than digits are ignored, e.g. spaces, hyphens and "ISBN" prefix,
+// except "X" (or "x") which stands for check digit 10 of ISBN 10. It is only allowed
+// after the last digit, in any other position it is reported as ReasonCharacter.
+func stripISBN(s string, version int) (string, []int, error) {
+	validator := "ISBN13"
+	if version == 10 {
+		validator = "ISBN10"
+	}
+
 	b := bytes.NewBuffer(nil)
-	for i, c := range s {
-		if '0' <= c && c <= '9' {
-			b.WriteByte(s[i])
+	var pos []int
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case '0' <= c && c <= '9':
+			b.WriteByte(c)
+			pos = append(pos, i)
+		case c != 'X' && c != 'x':
+		case version == 10 && !strings.ContainsAny(s[i+1:], "0123456789Xx"):
+			b.WriteByte('X')
+			pos = append(pos, i)
+		default:
+			return "", nil, validationError(validator, ReasonCharacter, i)
+		}
+	}
+
+	return b.String(), pos, nil
+}
+
+// ISBN10To13 converts ISBN version 10 to ISBN version 13 with "978" prefix.
+// The result contains digits only. On failure the error is a *ValidationError.
+func ISBN10To13(s string) (string, error) {
+	if err := CheckISBN10(s); err != nil {
+		return "", err
+	}
+
+	d, _, _ := stripISBN(s, 10)
+	d = "978" + d[:9]
+
+	c, _ := checkdigit.ISBN13.Generate(d)
+
+	return d + c, nil
+}
+
+// ISBN13To10 converts ISBN version 13 to ISBN version 10.
+// Only ISBNs with "978" prefix have ISBN 10 counterparts.
+// The result contains digits only (and "X" check digit).
+// On failure the error is a *ValidationError.
+func ISBN13To10(s string) (string, error) {
+	if err := CheckISBN13(s); err != nil {
+		return "", err
+	}
+
+	d, pos, _ := stripISBN(s, 13)
+	if d[:3] != "978" {
+		return "", validationError("ISBN13", ReasonFormat, pos[0])
+	}
+
+	d = d[3:12]
+
+	c, _ := checkdigit.ISBN10.Generate(d)
+
+	return d + c, nil
+}
+
+// HyphenateISBN returns ISBN version 10 or 13 with hyphens separating
+// prefix, registration group, registrant, publication and check digit,
+// e.g. "978-3-8362-2119-1". The version of the input is preserved.
+// Element boundaries come from the embedded International ISBN Agency range table.
+// On failure the error is a *ValidationError, ISBNs from ranges not defined for use
+// are reported with ReasonReserved and ISBNs from registration groups missing
+// in the table with ReasonUnsupported.
+func HyphenateISBN(s string) (string, error) {
+	d, _, err := stripISBN(s, 10)
+	if err != nil {
+		return "", err
+	}
+
+	var d13 string
+	if len(d) == 10 {
+		if d13, err = ISBN10To13(s); err != nil {
+			return "", err
 		}
+	} else {
+		if err = CheckISBN13(s); err != nil {
+			return "", err
+		}
+		d13 = d
 	}
 
-	// special case for ISBN 10
-	if version == 10 && (s[len(s)-1] == 'x' || s[len(s)-1] == 'X') {
-		b.WriteString("X")
+	prefix := d13[:3]
+	gl, ok := isbnRangeLength(isbnGroupRanges[prefix], d13[3:10])
+	if !ok {
+		return "", validationError("ISBN", ReasonReserved, -1)
+	}
+
+	group := d13[3 : 3+gl]
+	rest := d13[3+gl : 12]
+	ranges, ok := isbnRegistrantRanges[prefix+"-"+group]
+	if !ok {
+		return "", validationError("ISBN", ReasonUnsupported, -1)
+	}
+
+	pl, ok := isbnRangeLength(ranges, (rest + "000000")[:7])
+	if !ok || pl >= len(rest) {
+		return "", validationError("ISBN", ReasonReserved, -1)
+	}
+
+	h := group + "-" + rest[:pl] + "-" + rest[pl:] + "-"
+	if len(d) == 10 {
+		return h + d[9:], nil
+	}
+
+	return prefix + "-" + h + d13[12:], nil
+}
+
+//go:generate go run isbn_ranges_gen.go -i RangeMessage.xml -o isbn_ranges.go
+
+// isbnRange maps a range of 7 digit ISBN fragments to the length of the element they start with.
+// Length 0 means that the range is not defined for use.
+type isbnRange struct {
+	from   string
+	to     string
+	length int
+}
+
+// isbnRangeLength returns the length of the element starting the 7 digit fragment s.
+// The second value is false if the fragment is not covered by the ranges.
+func isbnRangeLength(ranges []isbnRange, s string) (int, bool) {
+	for _, r := range ranges {
+		if r.from <= s && s <= r.to {
+			return r.length, r.length > 0
+		}
 	}
 
-	return b.String()
+	return 0, false
 }
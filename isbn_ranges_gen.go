@@ -0,0 +1,106 @@
+//go:build ignore
+// +build ignore
+
+// This program generates isbn_ranges.go from the International ISBN Agency
+// range message. Download RangeMessage.xml from
+// https://www.isbn-international.org/range_file_generation and run:
+//
+//	go run isbn_ranges_gen.go -i RangeMessage.xml -o isbn_ranges.go
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+)
+
+type rangeGroup struct {
+	Prefix string `xml:"Prefix"`
+	Agency string `xml:"Agency"`
+	Rules  []struct {
+		Range  string `xml:"Range"`
+		Length string `xml:"Length"`
+	} `xml:"Rules>Rule"`
+}
+
+type rangeMessage struct {
+	Source   string       `xml:"MessageSource"`
+	Serial   string       `xml:"MessageSerialNumber"`
+	Date     string       `xml:"MessageDate"`
+	Prefixes []rangeGroup `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups   []rangeGroup `xml:"RegistrationGroups>Group"`
+}
+
+func main() {
+	in := flag.String("i", "RangeMessage.xml", "range message to read")
+	out := flag.String("o", "isbn_ranges.go", "file to write")
+	flag.Parse()
+
+	data, err := ioutil.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var msg rangeMessage
+	if err := xml.Unmarshal(data, &msg); err != nil {
+		log.Fatal(err)
+	}
+	if len(msg.Prefixes) == 0 || len(msg.Groups) == 0 {
+		log.Fatalf("%s: no ranges found", *in)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by isbn_ranges_gen.go; DO NOT EDIT.\n\npackage is\n\n")
+	fmt.Fprintf(&b, "// isbnGroupRanges holds registration group ranges per EAN.UCC prefix.\n")
+	fmt.Fprintf(&b, "// Taken from the International ISBN Agency range message")
+	if msg.Serial != "" {
+		fmt.Fprintf(&b, " %s", msg.Serial)
+	}
+	if msg.Date != "" {
+		fmt.Fprintf(&b, " of %s", msg.Date)
+	}
+	fmt.Fprintf(&b, ":\n// https://www.isbn-international.org/range_file_generation\n")
+	b.WriteString("var isbnGroupRanges = map[string][]isbnRange{\n")
+	for _, g := range msg.Prefixes {
+		writeGroup(&b, g, false)
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// isbnRegistrantRanges holds registrant (publisher) ranges per registration group.\n")
+	b.WriteString("var isbnRegistrantRanges = map[string][]isbnRange{\n")
+	for _, g := range msg.Groups {
+		writeGroup(&b, g, true)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeGroup writes ranges of g as a map entry keyed by its prefix.
+func writeGroup(b *bytes.Buffer, g rangeGroup, agency bool) {
+	if agency && g.Agency != "" {
+		fmt.Fprintf(b, "// %s\n", g.Agency)
+	}
+	fmt.Fprintf(b, "%q: {\n", g.Prefix)
+	for _, r := range g.Rules {
+		bounds := strings.Split(r.Range, "-")
+		length, err := strconv.Atoi(r.Length)
+		if len(bounds) != 2 || len(bounds[0]) != 7 || len(bounds[1]) != 7 || err != nil {
+			log.Fatalf("%s: malformed rule %q %q", g.Prefix, r.Range, r.Length)
+		}
+		fmt.Fprintf(b, "{%q, %q, %d},\n", bounds[0], bounds[1], length)
+	}
+	b.WriteString("},\n")
+}
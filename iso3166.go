@@ -0,0 +1,154 @@
+package is
+
+import "strings"
+
+// Indexes of ISO3166List built once on package initialization.
+var (
+	iso3166ByAlpha2  = make(map[string]int, len(ISO3166List))
+	iso3166ByAlpha3  = make(map[string]int, len(ISO3166List))
+	iso3166ByNumeric = make(map[string]int, len(ISO3166List))
+	iso3166ByName    = make(map[string]int, 2*len(ISO3166List))
+
+	iso31662ByCode    = make(map[string]int, len(ISO31662List))
+	iso31662ByCountry = make(map[string][]int)
+
+	countryInfoByAlpha2 = make(map[string]int, len(CountryInfoList))
+)
+
+func init() {
+	for i, e := range ISO3166List {
+		iso3166ByAlpha2[e.Alpha2Code] = i
+		iso3166ByAlpha3[e.Alpha3Code] = i
+		iso3166ByNumeric[e.Numeric] = i
+		iso3166ByName[strings.ToLower(e.EnglishShortName)] = i
+		iso3166ByName[strings.ToLower(e.FrenchShortName)] = i
+	}
+
+	for i, e := range ISO31662List {
+		iso31662ByCode[e.Code] = i
+		cc := e.Code[:strings.IndexByte(e.Code, '-')]
+		iso31662ByCountry[cc] = append(iso31662ByCountry[cc], i)
+	}
+
+	for i, e := range CountryInfoList {
+		countryInfoByAlpha2[e.Alpha2Code] = i
+	}
+}
+
+// CountryByAlpha2 returns ISO3166List entry by two-letter country code, case-insensitively.
+func CountryByAlpha2(code string) (ISO3166Entry, bool) {
+	return iso3166Lookup(iso3166ByAlpha2, strings.ToUpper(code))
+}
+
+// CountryByAlpha3 returns ISO3166List entry by three-letter country code, case-insensitively.
+func CountryByAlpha3(code string) (ISO3166Entry, bool) {
+	return iso3166Lookup(iso3166ByAlpha3, strings.ToUpper(code))
+}
+
+// CountryByNumeric returns ISO3166List entry by three-digit country code.
+// Leading zeros may be omitted, e.g. "4" finds Afghanistan.
+func CountryByNumeric(code string) (ISO3166Entry, bool) {
+	if len(code) < 3 && Numeric(code) {
+		code = strings.Repeat("0", 3-len(code)) + code
+	}
+
+	return iso3166Lookup(iso3166ByNumeric, code)
+}
+
+// CountryByName returns ISO3166List entry by its English or French short name, case-insensitively.
+// Names must be spelled exactly as in ISO3166List, e.g. "Bahamas (the)" or "Bahamas (les)".
+func CountryByName(name string) (ISO3166Entry, bool) {
+	return iso3166Lookup(iso3166ByName, strings.ToLower(name))
+}
+
+func iso3166Lookup(index map[string]int, key string) (ISO3166Entry, bool) {
+	i, ok := index[key]
+	if !ok {
+		return ISO3166Entry{}, false
+	}
+
+	return ISO3166List[i], true
+}
+
+// ISO3166Subdivision checks if a string is valid ISO 3166-2 subdivision code, e.g. "US-CA" or "DE-BY",
+// case-insensitively like SubdivisionsOf.
+func ISO3166Subdivision(code string) bool {
+	code = strings.ToUpper(code)
+	i := strings.IndexByte(code, '-')
+	if i < 0 || !ISO3166Alpha2(code[:i]) {
+		return false
+	}
+
+	_, ok := iso31662ByCode[code]
+	return ok
+}
+
+// SubdivisionsOf returns ISO 3166-2 subdivisions of the country given by two-letter code, case-insensitively.
+// Subdivisions of all levels are returned, see Subdivision.Parent.
+// It returns nil for unknown countries and countries without subdivisions.
+func SubdivisionsOf(alpha2 string) []Subdivision {
+	e, ok := CountryByAlpha2(alpha2)
+	if !ok {
+		return nil
+	}
+
+	idx := iso31662ByCountry[e.Alpha2Code]
+	if len(idx) == 0 {
+		return nil
+	}
+
+	res := make([]Subdivision, len(idx))
+	for j, i := range idx {
+		res[j] = ISO31662List[i]
+	}
+
+	return res
+}
+
+// CountryMetadata returns CountryInfoList entry by two-letter country code, case-insensitively.
+func CountryMetadata(alpha2 string) (CountryInfo, bool) {
+	i, ok := countryInfoByAlpha2[strings.ToUpper(alpha2)]
+	if !ok {
+		return CountryInfo{}, false
+	}
+
+	return CountryInfoList[i], true
+}
+
+// CountryCallingCodes returns ITU-T E.164 calling codes of the country without "+", e.g. []string{"49"} for "DE".
+func CountryCallingCodes(alpha2 string) []string {
+	info, _ := CountryMetadata(alpha2)
+	return info.CallingCodes
+}
+
+// CountryCurrencies returns ISO 4217 codes of currencies in official use in the country.
+func CountryCurrencies(alpha2 string) []string {
+	info, _ := CountryMetadata(alpha2)
+	return info.Currencies
+}
+
+// CountryTLD returns the country code top-level domain with leading dot, e.g. ".uk" for "GB".
+// It returns empty string for unknown countries and countries without delegated domain.
+func CountryTLD(alpha2 string) string {
+	info, _ := CountryMetadata(alpha2)
+	return info.TLD
+}
+
+// CountryRegion returns UN M49 codes of the continental region and the sub-region of the country,
+// e.g. "150" (Europe) and "155" (Western Europe) for "DE". Names are available in M49Names.
+func CountryRegion(alpha2 string) (region, subRegion string) {
+	info, _ := CountryMetadata(alpha2)
+	return info.Region, info.SubRegion
+}
+
+// EUMember checks if the country is a member state of the European Union.
+func EUMember(alpha2 string) bool {
+	info, _ := CountryMetadata(alpha2)
+	return info.EU
+}
+
+// EEAMember checks if the country is a member of the European Economic Area.
+func EEAMember(alpha2 string) bool {
+	info, _ := CountryMetadata(alpha2)
+	return info.EEA
+}
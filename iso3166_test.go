@@ -0,0 +1,186 @@
+package is
+
+import "testing"
+
+func TestCountryLookup(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		function func(string) (ISO3166Entry, bool)
+		param    string
+		expected string
+	}{
+		{"CountryByAlpha2", CountryByAlpha2, "", ""},
+		{"CountryByAlpha2", CountryByAlpha2, "DE", "DEU"},
+		{"CountryByAlpha2", CountryByAlpha2, "de", "DEU"},
+		{"CountryByAlpha2", CountryByAlpha2, "DEU", ""},
+		{"CountryByAlpha2", CountryByAlpha2, "XX", ""},
+		{"CountryByAlpha3", CountryByAlpha3, "jpn", "JPN"},
+		{"CountryByAlpha3", CountryByAlpha3, "JP", ""},
+		{"CountryByNumeric", CountryByNumeric, "004", "AFG"},
+		{"CountryByNumeric", CountryByNumeric, "4", "AFG"},
+		{"CountryByNumeric", CountryByNumeric, "276", "DEU"},
+		{"CountryByNumeric", CountryByNumeric, "999", ""},
+		{"CountryByNumeric", CountryByNumeric, "abc", ""},
+		{"CountryByName", CountryByName, "Bahamas (the)", "BHS"},
+		{"CountryByName", CountryByName, "bahamas (LES)", "BHS"},
+		{"CountryByName", CountryByName, "CÔTE D'IVOIRE", "CIV"},
+		{"CountryByName", CountryByName, "Curaçao", "CUW"},
+		{"CountryByName", CountryByName, "Bahamas", ""},
+	}
+	for _, test := range tests {
+		actual, ok := test.function(test.param)
+		if actual.Alpha3Code != test.expected || ok != (test.expected != "") {
+			t.Errorf("Expected %s(%q) to find %q, got %+v, %v", test.name, test.param, test.expected, actual, ok)
+		}
+	}
+}
+
+func TestISO3166Numeric(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"004", true},
+		{"276", true},
+		{"4", false},
+		{"000", false},
+		{"DEU", false},
+	}
+	for _, test := range tests {
+		actual := ISO3166Numeric(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected ISO3166Numeric(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestISO3166Subdivision(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"US", false},
+		{"US-", false},
+		{"US-CA", true},
+		{"DE-BY", true},
+		{"AT-9", true},
+		{"JP-13", true},
+		{"us-ca", true},
+		{"FR-01", true},
+		{"GB-LND", true},
+		{"BR-SP", true},
+		{"IN-CG", true},
+		{"IN-CT", false},
+		{"KE-01", true},
+		{"US-XX", false},
+		{"XX-CA", false},
+		{"DE-CA", false},
+	}
+	for _, test := range tests {
+		actual := ISO3166Subdivision(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected ISO3166Subdivision(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestSubdivisionsOf(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected int
+	}{
+		{"", 0},
+		{"XX", 0},
+		{"AQ", 0},
+		{"US", 57},
+		{"de", 16},
+		{"CA", 13},
+		{"fr", 127},
+		{"KE", 47},
+	}
+	for _, test := range tests {
+		actual := SubdivisionsOf(test.param)
+		if len(actual) != test.expected {
+			t.Errorf("Expected SubdivisionsOf(%q) to have %d entries, got %d", test.param, test.expected, len(actual))
+		}
+		for _, s := range actual {
+			if !ISO3166Subdivision(s.Code) || s.Name == "" || s.Category == "" || s.Parent != "" && !ISO3166Subdivision(s.Parent) {
+				t.Errorf("Expected SubdivisionsOf(%q) to return valid subdivisions, got %+v", test.param, s)
+			}
+		}
+	}
+
+	first := SubdivisionsOf("DE")[0]
+	if first.Code != "DE-BB" || first.Name != "Brandenburg" || first.Category != "land" {
+		t.Errorf("Expected first subdivision of DE to be DE-BB, got %+v", first)
+	}
+}
+
+func TestCountryMetadata(t *testing.T) {
+	t.Parallel()
+
+	if len(CountryInfoList) != len(ISO3166List) {
+		t.Errorf("Expected CountryInfoList to have %d entries, got %d", len(ISO3166List), len(CountryInfoList))
+	}
+	for _, info := range CountryInfoList {
+		if !ISO3166Alpha2(info.Alpha2Code) {
+			t.Errorf("Expected CountryInfoList entry %q to be ISO 3166-1 alpha-2 code", info.Alpha2Code)
+		}
+		if len(info.CallingCodes) == 0 {
+			t.Errorf("Expected CountryInfoList entry %q to have calling codes", info.Alpha2Code)
+		}
+		for _, code := range []string{info.Region, info.SubRegion} {
+			if _, ok := M49Names[code]; code != "" && !ok {
+				t.Errorf("Expected CountryInfoList entry %q to have known M49 code, got %q", info.Alpha2Code, code)
+			}
+		}
+	}
+
+	var tests = []struct {
+		param     string
+		calling   string
+		currency  string
+		tld       string
+		region    string
+		subRegion string
+		eu        bool
+		eea       bool
+	}{
+		{"", "", "", "", "", "", false, false},
+		{"DE", "49", "EUR", ".de", "150", "155", true, true},
+		{"de", "49", "EUR", ".de", "150", "155", true, true},
+		{"BG", "359", "EUR", ".bg", "150", "151", true, true},
+		{"NO", "47", "NOK", ".no", "150", "154", false, true},
+		{"CH", "41", "CHF", ".ch", "150", "155", false, false},
+		{"GB", "44", "GBP", ".uk", "150", "154", false, false},
+		{"US", "1", "USD", ".us", "019", "021", false, false},
+		{"JP", "81", "JPY", ".jp", "142", "030", false, false},
+		{"AQ", "672", "", ".aq", "", "", false, false},
+	}
+	for _, test := range tests {
+		var calling, currency string
+		if codes := CountryCallingCodes(test.param); len(codes) > 0 {
+			calling = codes[0]
+		}
+		if codes := CountryCurrencies(test.param); len(codes) > 0 {
+			currency = codes[0]
+		}
+		region, subRegion := CountryRegion(test.param)
+		if calling != test.calling || currency != test.currency || CountryTLD(test.param) != test.tld ||
+			region != test.region || subRegion != test.subRegion ||
+			EUMember(test.param) != test.eu || EEAMember(test.param) != test.eea {
+			info, _ := CountryMetadata(test.param)
+			t.Errorf("Expected CountryMetadata(%q) to match %+v, got %+v", test.param, test, info)
+		}
+	}
+}
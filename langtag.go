@@ -0,0 +1,204 @@
+package is
+
+import "strings"
+
+// LanguageTagParts holds subtags of a BCP 47 language tag as they appear in the tag.
+type LanguageTagParts struct {
+	Language   string
+	ExtLang    []string
+	Script     string
+	Region     string
+	Variants   []string
+	Extensions []string
+	PrivateUse string
+	// Grandfathered is true for tags registered before RFC 5646, e.g. "i-klingon".
+	// Other fields are empty for such tags.
+	Grandfathered bool
+}
+
+// LanguageTag check if the string is a valid BCP 47 (RFC 5646) language tag, e.g. "en-US" or "zh-Hant-TW".
+// Language and script subtags are checked against ISO 639 and ISO 15924 registries,
+// extlang subtags against the IANA registry together with their primary language,
+// region subtags against ISO 3166-1 alpha-2 codes and UN M49 region codes.
+// Variants and extensions are checked for syntax only.
+func LanguageTag(s string) bool {
+	_, err := ParseLanguageTag(s)
+	return err == nil
+}
+
+// ISO639Alpha2 check if the string is an ISO 639-1 two-letter language code.
+func ISO639Alpha2(s string) bool {
+	return len(s) == 2 && iso6391Set[strings.ToLower(s)]
+}
+
+// ISO639Alpha3 check if the string is an ISO 639-2 or ISO 639-3 three-letter language code.
+// Private use codes "qaa" through "qtz" are accepted.
+func ISO639Alpha3(s string) bool {
+	if len(s) != 3 || !Alpha(s) {
+		return false
+	}
+
+	s = strings.ToLower(s)
+	if s[0] == 'q' && s[1] <= 't' {
+		return true
+	}
+
+	return iso6393Set[s]
+}
+
+// ISO15924 check if the string is an ISO 15924 four-letter script code, e.g. "Latn".
+// Private use codes "Qaaa" through "Qabx" are accepted.
+func ISO15924(s string) bool {
+	if len(s) != 4 || !Alpha(s) {
+		return false
+	}
+
+	s = strings.ToLower(s)
+	if s >= "qaaa" && s <= "qabx" {
+		return true
+	}
+
+	return iso15924Set[s]
+}
+
+// ParseLanguageTag splits BCP 47 language tag into its subtags.
+// On failure the error is a *ValidationError with the offset of the offending subtag.
+// See: https://tools.ietf.org/html/rfc5646#section-2.1
+func ParseLanguageTag(s string) (LanguageTagParts, error) {
+	var p LanguageTagParts
+	if len(s) == 0 {
+		return p, validationError("LanguageTag", ReasonEmpty, -1)
+	}
+
+	if grandfatheredSet[strings.ToLower(s)] {
+		p.Grandfathered = true
+		return p, nil
+	}
+
+	subtags := strings.Split(s, "-")
+	offsets := make([]int, len(subtags))
+	for i, off := 0, 0; i < len(subtags); i++ {
+		offsets[i] = off
+		off += len(subtags[i]) + 1
+
+		switch {
+		case len(subtags[i]) == 0 || len(subtags[i]) > 8:
+			return p, validationError("LanguageTag", ReasonFormat, offsets[i])
+		case !Alphanumeric(subtags[i]):
+			return p, validationError("LanguageTag", ReasonCharacter, offsets[i])
+		}
+	}
+
+	i := 0
+	fail := func(reason Reason) (LanguageTagParts, error) {
+		return LanguageTagParts{}, validationError("LanguageTag", reason, offsets[i])
+	}
+
+	if !strings.EqualFold(subtags[0], "x") {
+		lang := subtags[0]
+		switch {
+		case !Alpha(lang) || len(lang) < 2:
+			return fail(ReasonFormat)
+		case len(lang) == 2 && !ISO639Alpha2(lang), len(lang) == 3 && !ISO639Alpha3(lang):
+			return fail(ReasonReserved)
+		case len(lang) > 3:
+			// four letter subtags are reserved, five to eight letter ones are registered
+			// individually and the registry has none of them
+			return fail(ReasonReserved)
+		}
+		p.Language = lang
+		i++
+
+		for ; i < len(subtags) && len(p.ExtLang) < 3 && len(subtags[i]) == 3 && Alpha(subtags[i]); i++ {
+			// an extlang is registered for a single primary language, e.g. "yue" for "zh"
+			if extLangPrefixes[strings.ToLower(subtags[i])] != strings.ToLower(lang) {
+				return fail(ReasonReserved)
+			}
+			p.ExtLang = append(p.ExtLang, subtags[i])
+		}
+
+		if i < len(subtags) && len(subtags[i]) == 4 && Alpha(subtags[i]) {
+			if !ISO15924(subtags[i]) {
+				return fail(ReasonReserved)
+			}
+			p.Script = subtags[i]
+			i++
+		}
+
+		if i < len(subtags) && languageTagRegion(subtags[i]) {
+			if !languageTagKnownRegion(subtags[i]) {
+				return fail(ReasonReserved)
+			}
+			p.Region = subtags[i]
+			i++
+		}
+
+		for ; i < len(subtags) && languageTagVariant(subtags[i]); i++ {
+			for _, v := range p.Variants {
+				if strings.EqualFold(v, subtags[i]) {
+					return fail(ReasonFormat)
+				}
+			}
+			p.Variants = append(p.Variants, subtags[i])
+		}
+
+		seen := make(map[byte]bool)
+		for i < len(subtags) && len(subtags[i]) == 1 && !strings.EqualFold(subtags[i], "x") {
+			singleton := strings.ToLower(subtags[i])[0]
+			if seen[singleton] {
+				return fail(ReasonFormat)
+			}
+			seen[singleton] = true
+
+			start := i
+			for i++; i < len(subtags) && len(subtags[i]) >= 2; i++ {
+			}
+			if i == start+1 {
+				i = start
+				return fail(ReasonFormat)
+			}
+			p.Extensions = append(p.Extensions, strings.Join(subtags[start:i], "-"))
+		}
+	}
+
+	if i < len(subtags) && strings.EqualFold(subtags[i], "x") {
+		if i == len(subtags)-1 {
+			return fail(ReasonFormat)
+		}
+		p.PrivateUse = strings.Join(subtags[i:], "-")
+		i = len(subtags)
+	}
+
+	if i < len(subtags) {
+		return fail(ReasonFormat)
+	}
+
+	return p, nil
+}
+
+// languageTagRegion checks region subtag syntax: two letters or three digits.
+func languageTagRegion(s string) bool {
+	return (len(s) == 2 && Alpha(s)) || (len(s) == 3 && Numeric(s))
+}
+
+// languageTagKnownRegion checks region subtag against ISO 3166-1 and UN M49 codes.
+// Exceptionally reserved codes such as "EU" and user-assigned ones are registered
+// for use in language tags as well.
+func languageTagKnownRegion(s string) bool {
+	if len(s) == 3 {
+		_, ok := M49Names[s]
+		return ok || ISO3166Numeric(s)
+	}
+
+	return ISO3166Alpha2Status(strings.ToUpper(s), StatusUserAssigned, StatusExceptionallyReserved)
+}
+
+// languageTagVariant checks variant subtag syntax: five to eight alphanumerics
+// or four starting with a digit.
+func languageTagVariant(s string) bool {
+	if len(s) >= 5 {
+		return true
+	}
+
+	return len(s) == 4 && '0' <= s[0] && s[0] <= '9'
+}
@@ -0,0 +1,126 @@
+package is
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLanguageTag(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"en", true},
+		{"EN-us", true},
+		{"de-CH-1901", true},
+		{"zh-Hant-TW", true},
+		{"zh-yue-HK", true},
+		{"sr-Latn-RS", true},
+		{"es-419", true},
+		{"en-001", true},
+		{"sl-rozaj-biske", true},
+		{"hy-Latn-IT-arevela", true},
+		{"en-US-u-ca-gregory-x-foo", true},
+		{"de-DE-u-co-phonebk", true},
+		{"en-a-bbb-b-ccc", true},
+		{"x-whatever", true},
+		{"qaa-Qaaa-QM-x-southern", true},
+		{"i-klingon", true},
+		{"zh-min-nan", true},
+		{"en-GB-oed", true},
+		{"fr-EU", true},
+		{"sgn-ase", true},
+		{"ar-AEB", true},
+		{"bar-AT", true},
+		{"ksh", true},
+		{"en-", false},
+		{"-en", false},
+		{"en--US", false},
+		{"e", false},
+		{"enus", false},
+		{"xx", false},
+		{"en-Xxxx", false},
+		{"en-QQ-QQ", false},
+		{"en-US-US", false},
+		{"en-999", false},
+		{"de-1901-1901", false},
+		{"en-a-bbb-a-ccc", false},
+		{"en-a", false},
+		{"en-x", false},
+		{"en-us_POSIX", false},
+		{"en-abcdefghi", false},
+		{"a-DE", false},
+		{"en-fra", false},
+		{"de-eng", false},
+		{"ar-yue", false},
+	}
+	for _, test := range tests {
+		actual := LanguageTag(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected LanguageTag(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseLanguageTag(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected LanguageTagParts
+	}{
+		{"zh-cmn-Hans-CN", LanguageTagParts{Language: "zh", ExtLang: []string{"cmn"}, Script: "Hans", Region: "CN"}},
+		{"sl-IT-rozaj-biske-1994", LanguageTagParts{Language: "sl", Region: "IT", Variants: []string{"rozaj", "biske", "1994"}}},
+		{"en-US-u-ca-gregory-t-de-x-private", LanguageTagParts{Language: "en", Region: "US", Extensions: []string{"u-ca-gregory", "t-de"}, PrivateUse: "x-private"}},
+		{"i-default", LanguageTagParts{Grandfathered: true}},
+	}
+	for _, test := range tests {
+		actual, err := ParseLanguageTag(test.param)
+		if err != nil || !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected ParseLanguageTag(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestLanguageRegistries(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		fn       func(string) bool
+		param    string
+		expected bool
+	}{
+		{"ISO639Alpha2", ISO639Alpha2, "de", true},
+		{"ISO639Alpha2", ISO639Alpha2, "ZH", true},
+		{"ISO639Alpha2", ISO639Alpha2, "qq", false},
+		{"ISO639Alpha2", ISO639Alpha2, "deu", false},
+		{"ISO639Alpha3", ISO639Alpha3, "deu", true},
+		{"ISO639Alpha3", ISO639Alpha3, "ger", true},
+		{"ISO639Alpha3", ISO639Alpha3, "yue", true},
+		{"ISO639Alpha3", ISO639Alpha3, "qab", true},
+		{"ISO639Alpha3", ISO639Alpha3, "qzz", false},
+		{"ISO639Alpha3", ISO639Alpha3, "bar", true},
+		{"ISO639Alpha3", ISO639Alpha3, "ksh", true},
+		{"ISO639Alpha3", ISO639Alpha3, "frp", true},
+		{"ISO639Alpha3", ISO639Alpha3, "ain", true},
+		{"ISO639Alpha3", ISO639Alpha3, "sgn", true},
+		{"ISO639Alpha3", ISO639Alpha3, "zzz", false},
+		{"ISO639Alpha3", ISO639Alpha3, "de", false},
+		{"ISO15924", ISO15924, "Latn", true},
+		{"ISO15924", ISO15924, "cyrl", true},
+		{"ISO15924", ISO15924, "Qabx", true},
+		{"ISO15924", ISO15924, "Qaby", false},
+		{"ISO15924", ISO15924, "Abcd", false},
+		{"ISO15924", ISO15924, "Lat", false},
+	}
+	for _, test := range tests {
+		actual := test.fn(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// NationalIDKind names the kind of national identification number.
+type NationalIDKind string
+
+// Kinds of national identification numbers returned by ParseNationalID
+const (
+	// NationalIDDNI is the Spanish Documento Nacional de Identidad.
+	NationalIDDNI NationalIDKind = "DNI"
+	// NationalIDNIE is the Spanish Número de Identidad de Extranjero.
+	NationalIDNIE NationalIDKind = "NIE"
+	// NationalIDCodiceFiscale is the Italian fiscal code of natural persons.
+	NationalIDCodiceFiscale NationalIDKind = "CodiceFiscale"
+	// NationalIDNIR is the French social security number.
+	NationalIDNIR NationalIDKind = "NIR"
+	// NationalIDSteuerID is the German tax identification number of natural persons.
+	NationalIDSteuerID NationalIDKind = "SteuerID"
+	// NationalIDPESEL is the Polish national identification number.
+	NationalIDPESEL NationalIDKind = "PESEL"
+	// NationalIDPersonnummer is the Swedish personal identity number.
+	NationalIDPersonnummer NationalIDKind = "Personnummer"
+	// NationalIDSamordningsnummer is the Swedish coordination number of non-residents.
+	NationalIDSamordningsnummer NationalIDKind = "Samordningsnummer"
+	// NationalIDBSN is the Dutch citizen service number.
+	NationalIDBSN NationalIDKind = "BSN"
+	// NationalIDNN is the Belgian national register number, including BIS numbers of non-residents.
+	NationalIDNN NationalIDKind = "NN"
+	// NationalIDNINO is the UK National Insurance number.
+	NationalIDNINO NationalIDKind = "NINO"
+	// NationalIDCPF is the Brazilian Cadastro de Pessoas Físicas.
+	NationalIDCPF NationalIDKind = "CPF"
+	// NationalIDSIN is the Canadian Social Insurance Number.
+	NationalIDSIN NationalIDKind = "SIN"
+	// NationalIDCURP is the Mexican Clave Única de Registro de Población.
+	NationalIDCURP NationalIDKind = "CURP"
+	// NationalIDRUT is the Chilean Rol Único Tributario, also used as national identity number.
+	NationalIDRUT NationalIDKind = "RUT"
+	// NationalIDCUIT is the Argentine Clave Única de Identificación Tributaria, including CUIL of employees.
+	NationalIDCUIT NationalIDKind = "CUIT"
+	// NationalIDAadhaar is the Indian Aadhaar number.
+	NationalIDAadhaar NationalIDKind = "Aadhaar"
+	// NationalIDResidentID is the Chinese resident identity card number.
+	NationalIDResidentID NationalIDKind = "ResidentID"
+	// NationalIDRRN is the South Korean resident registration number.
+	NationalIDRRN NationalIDKind = "RRN"
+)
+
+// Sex of the holder encoded in a national identification number.
+type Sex int
+
+// Sexes returned by ParseNationalID
+const (
+	SexUnknown Sex = iota
+	SexMale
+	SexFemale
+)
+
+// NationalIDParts holds a parsed national identification number.
+type NationalIDParts struct {
+	// CountryCode is the ISO 3166-1 alpha-2 code of the issuing country.
+	CountryCode string
+	Kind        NationalIDKind
+	// Number is the identifier without separators.
+	Number string
+	// BirthDate is zero if the number does not encode it.
+	BirthDate time.Time
+	// Sex is SexUnknown if the number does not encode it.
+	Sex Sex
+}
+
+// nationalIDRule checks a kind of national identification number with separators removed
+// and fills encoded personal data. Two-digit birth years are expanded relative to now.
+// check returns empty Reason on success.
+type nationalIDRule struct {
+	kind  NationalIDKind
+	check func(n string, p *NationalIDParts, now time.Time) Reason
+}
+
+// nationalIDRules maps ISO 3166-1 alpha-2 codes to rules tried in order.
+// Rules of each country live in nationalid_<country>.go files.
+var nationalIDRules = map[string][]nationalIDRule{
+	"AR": {{NationalIDCUIT, checkCUIT}},
+	"BE": {{NationalIDNN, checkBelgianNN}},
+	"BR": {{NationalIDCPF, checkCPF}},
+	"CA": {{NationalIDSIN, checkSIN}},
+	"CL": {{NationalIDRUT, checkRUT}},
+	"CN": {{NationalIDResidentID, checkChineseResidentID}},
+	"DE": {{NationalIDSteuerID, checkSteuerID}},
+	"ES": {{NationalIDDNI, checkDNI}, {NationalIDNIE, checkNIE}},
+	"FR": {{NationalIDNIR, checkNIR}},
+	"GB": {{NationalIDNINO, checkNINO}},
+	"IN": {{NationalIDAadhaar, checkAadhaar}},
+	"IT": {{NationalIDCodiceFiscale, checkCodiceFiscale}},
+	"KR": {{NationalIDRRN, checkRRN}},
+	"MX": {{NationalIDCURP, checkCURP}},
+	"NL": {{NationalIDBSN, checkBSN}},
+	"PL": {{NationalIDPESEL, checkPESEL}},
+	"SE": {{NationalIDPersonnummer, checkPersonnummer}, {NationalIDSamordningsnummer, checkSamordningsnummer}},
+}
+
+// NationalID check if the string is a national identification number of the country
+// given by its ISO 3166-1 alpha-2 code, see ParseNationalID for supported numbers.
+func NationalID(countryAlpha2, s string) bool {
+	_, err := ParseNationalID(countryAlpha2, s)
+	return err == nil
+}
+
+// ParseNationalID validates the string as national identification number of the country
+// and extracts the birth date and the sex of the holder where the number encodes them.
+// Supported are Spanish DNI and NIE, Italian Codice Fiscale, French NIR, German Steuer-ID,
+// Polish PESEL, Swedish personnummer and samordningsnummer, Dutch BSN,
+// Belgian national register number, UK National Insurance number, Brazilian CPF,
+// Canadian SIN, Mexican CURP, Chilean RUT, Argentine CUIT and CUIL, Indian Aadhaar,
+// Chinese resident identity card number and Korean resident registration number.
+// Spaces, hyphens and dots are ignored, except for the Swedish "+" separator of people over 100.
+// Two-digit birth years are taken from the hundred years up to now, see ParseNationalIDAt.
+// On failure the error is a *ValidationError. Unsupported countries are reported as ReasonFormat.
+// If no kind matches, the reason comes from the first kind of matching length.
+func ParseNationalID(countryAlpha2 string, s string) (NationalIDParts, error) {
+	return ParseNationalIDAt(countryAlpha2, s, time.Now())
+}
+
+// ParseNationalIDAt is like ParseNationalID but takes two-digit birth years
+// from the hundred years up to the year of now, so results do not depend on the clock.
+// Italian, French and Swedish numbers may encode the year with two digits only.
+func ParseNationalIDAt(countryAlpha2 string, s string, now time.Time) (NationalIDParts, error) {
+	rules, ok := nationalIDRules[countryAlpha2]
+	if !ok || !ISO3166Alpha2(countryAlpha2) {
+		return NationalIDParts{}, validationError("NationalID", ReasonFormat, -1)
+	}
+
+	n := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '.' {
+			return -1
+		}
+		return r
+	}, s)
+
+	if len(n) == 0 {
+		return NationalIDParts{}, validationError("NationalID", ReasonEmpty, -1)
+	}
+
+	reason := ReasonLength
+	for _, r := range rules {
+		p := NationalIDParts{CountryCode: countryAlpha2, Kind: r.kind, Number: n}
+		res := r.check(n, &p, now)
+		if res == "" {
+			return p, nil
+		}
+		if reason == ReasonLength {
+			reason = res
+		}
+	}
+
+	return NationalIDParts{}, validationError("NationalID", reason, -1)
+}
+
+// birthDate returns the date if it exists in the calendar.
+func birthDate(year, month, day int) (time.Time, bool) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return t, t.Year() == year && int(t.Month()) == month && t.Day() == day
+}
+
+// birthYear expands the two-digit year to the hundred years up to the year of now.
+func birthYear(now time.Time, yy int) int {
+	return now.Year() - (now.Year()-yy)%100
+}
@@ -0,0 +1,36 @@
+package is
+
+import "time"
+
+// checkCUIT checks Argentine Clave Única de Identificación Tributaria (CUIT) and
+// Código Único de Identificación Laboral (CUIL): type prefix, 8 digit document number
+// and a mod 11 check digit. Prefix 20 is used for men and 27 for women.
+// See: https://www.afip.gob.ar
+func checkCUIT(n string, p *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 11:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	}
+
+	switch n[:2] {
+	case "20":
+		p.Sex = SexMale
+	case "27":
+		p.Sex = SexFemale
+	case "23", "24", "30", "33", "34":
+	default:
+		return ReasonFormat
+	}
+
+	c := 11 - weightedSum(n, 5, 4, 3, 2, 7, 6, 5, 4, 3, 2)%11
+	if c == 11 {
+		c = 0
+	}
+	if c != int(n[10]-'0') {
+		return ReasonChecksum
+	}
+
+	return ""
+}
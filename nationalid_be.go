@@ -0,0 +1,56 @@
+package is
+
+import "time"
+
+// checkBelgianNN checks Belgian national register number "YYMMDD-SSS-CC": birth date,
+// serial number which is odd for men and mod 97 check number. For people born since 2000
+// the check number is computed with "2" prepended. BIS numbers of non-residents have
+// 20 (sex unknown) or 40 (sex known) added to the month. Unknown month or day of birth is 0.
+// See: https://www.ibz.rrn.fgov.be/fr/registre-national/
+func checkBelgianNN(n string, p *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 11:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	}
+
+	year := 1900 + atoi(n[:2])
+	switch key := atoi(n[9:]); key {
+	case 97 - atoi(n[:9])%97:
+	case 97 - atoi("2"+n[:9])%97:
+		year += 100
+	default:
+		return ReasonChecksum
+	}
+
+	month, day := atoi(n[2:4]), atoi(n[4:6])
+	sexKnown := true
+	switch {
+	case month > 40:
+		month -= 40
+	case month > 20:
+		month -= 20
+		sexKnown = false
+	}
+
+	if month > 12 {
+		return ReasonFormat
+	}
+
+	if month > 0 && day > 0 {
+		var ok bool
+		if p.BirthDate, ok = birthDate(year, month, day); !ok {
+			return ReasonFormat
+		}
+	}
+
+	if sexKnown {
+		p.Sex = SexFemale
+		if (n[8]-'0')%2 == 1 {
+			p.Sex = SexMale
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,27 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// checkCPF checks Brazilian Cadastro de Pessoas Físicas: 9 digits and 2 mod 11 check digits.
+// Numbers made of a single repeated digit pass the check but are never issued.
+func checkCPF(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 11:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case n == strings.Repeat(n[:1], len(n)):
+		return ReasonReserved
+	}
+
+	d1 := weightedSum(n, 10, 9, 8, 7, 6, 5, 4, 3, 2) * 10 % 11 % 10
+	d2 := weightedSum(n, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2) * 10 % 11 % 10
+	if d1 != int(n[9]-'0') || d2 != int(n[10]-'0') {
+		return ReasonChecksum
+	}
+
+	return ""
+}
@@ -0,0 +1,24 @@
+package is
+
+import (
+	"time"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// checkSIN checks Canadian Social Insurance Number: 9 digits passing Luhn check.
+// Numbers starting with 0 are not assigned and ones starting with 8 are business numbers.
+func checkSIN(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 9:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case n[0] == '0' || n[0] == '8':
+		return ReasonReserved
+	case !checkdigit.Luhn.Verify(n):
+		return ReasonChecksum
+	}
+
+	return ""
+}
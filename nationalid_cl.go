@@ -0,0 +1,28 @@
+package is
+
+import "time"
+
+// checkRUT checks Chilean Rol Único Tributario "12.345.678-5": 7 or 8 digits
+// and a mod 11 check digit which may be "K" in either case.
+func checkRUT(n string, _ *NationalIDParts, _ time.Time) Reason {
+	if len(n) != 8 && len(n) != 9 {
+		return ReasonLength
+	}
+
+	body := n[:len(n)-1]
+	if !Numeric(body) {
+		return ReasonCharacter
+	}
+
+	var sum int
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * ((len(body)-1-i)%6 + 2)
+	}
+
+	c := "0K987654321"[sum%11]
+	if last := n[len(n)-1]; c != last && !(c == 'K' && last == 'k') {
+		return ReasonChecksum
+	}
+
+	return ""
+}
@@ -0,0 +1,53 @@
+package is
+
+import (
+	"time"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// chineseProvinces holds the first two digits of administrative division codes of provinces,
+// including Taiwan, Hong Kong and Macau.
+var chineseProvinces = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true,
+	"21": true, "22": true, "23": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "37": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true,
+	"50": true, "51": true, "52": true, "53": true, "54": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true,
+	"71": true, "81": true, "82": true, "83": true,
+}
+
+// checkChineseResidentID checks 18 character Chinese resident identity card number:
+// 6 digit administrative division code, birth date, sequence number which is odd for men
+// and ISO 7064 MOD 11-2 check character which may be "X".
+// See: GB 11643-1999
+func checkChineseResidentID(n string, p *NationalIDParts, _ time.Time) Reason {
+	if len(n) != 18 {
+		return ReasonLength
+	}
+
+	if !Numeric(n[:17]) || (!Numeric(n[17:]) && n[17] != 'X') {
+		return ReasonCharacter
+	}
+
+	if !chineseProvinces[n[:2]] {
+		return ReasonFormat
+	}
+
+	if !checkdigit.ISO7064Mod11Radix2.Verify(n) {
+		return ReasonChecksum
+	}
+
+	var ok bool
+	if p.BirthDate, ok = birthDate(atoi(n[6:10]), atoi(n[10:12]), atoi(n[12:14])); !ok {
+		return ReasonFormat
+	}
+
+	p.Sex = SexFemale
+	if (n[16]-'0')%2 == 1 {
+		p.Sex = SexMale
+	}
+
+	return ""
+}
@@ -0,0 +1,53 @@
+package is
+
+import (
+	"time"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// checkSteuerID checks German Steuerliche Identifikationsnummer: 10 digits and
+// ISO 7064 MOD 11,10 check digit. The first digit is not zero and exactly one digit
+// of the first ten occurs two or three times, but not three times in a row.
+// See: https://www.bzst.de/DE/Privatpersonen/SteuerlicheIdentifikationsnummer/steuerlicheidentifikationsnummer_node.html
+func checkSteuerID(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 11:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case n[0] == '0':
+		return ReasonFormat
+	}
+
+	var counts [10]int
+	for i := 0; i < 10; i++ {
+		counts[n[i]-'0']++
+	}
+
+	repeated := 0
+	for d, c := range counts {
+		switch {
+		case c == 3:
+			for i := 0; i < 8; i++ {
+				if int(n[i]-'0') == d && n[i] == n[i+1] && n[i] == n[i+2] {
+					return ReasonFormat
+				}
+			}
+			fallthrough
+		case c == 2:
+			repeated++
+		case c > 3:
+			return ReasonFormat
+		}
+	}
+	if repeated != 1 {
+		return ReasonFormat
+	}
+
+	if !checkdigit.ISO7064Mod11Hybrid10.Verify(n) {
+		return ReasonChecksum
+	}
+
+	return ""
+}
@@ -0,0 +1,34 @@
+package is
+
+import "time"
+
+// checkDNI checks Spanish Documento Nacional de Identidad: 8 digits and a mod 23 check letter.
+func checkDNI(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 9:
+		return ReasonLength
+	case !Numeric(n[:8]) || 'Z' < n[8] || n[8] < 'A':
+		return ReasonCharacter
+	case !spanishDNI(n):
+		return ReasonChecksum
+	}
+
+	return ""
+}
+
+// checkNIE checks Spanish Número de Identidad de Extranjero: "X", "Y" or "Z",
+// 7 digits and a mod 23 check letter.
+func checkNIE(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 9:
+		return ReasonLength
+	case n[0] != 'X' && n[0] != 'Y' && n[0] != 'Z':
+		return ReasonFormat
+	case !Numeric(n[1:8]) || 'Z' < n[8] || n[8] < 'A':
+		return ReasonCharacter
+	case !spanishNIE(n):
+		return ReasonChecksum
+	}
+
+	return ""
+}
@@ -0,0 +1,47 @@
+package is
+
+import "time"
+
+// checkNIR checks French Numéro d'Inscription au Répertoire (social security number):
+// sex, birth year and month, department and commune of birth, order number and mod 97 key.
+// Corsican departments "2A" and "2B" are computed as 19 and 18. NIR does not encode
+// the day of birth, so BirthDate is the first day of the month when the month is known.
+// See: https://www.insee.fr/fr/metadonnees/definition/c1409
+func checkNIR(n string, p *NationalIDParts, now time.Time) Reason {
+	if len(n) != 15 {
+		return ReasonLength
+	}
+
+	num := n[:13]
+	switch n[5:7] {
+	case "2A":
+		num = n[:5] + "19" + n[7:13]
+	case "2B":
+		num = n[:5] + "18" + n[7:13]
+	}
+	if !Numeric(num) || !Numeric(n[13:]) {
+		return ReasonCharacter
+	}
+
+	switch n[0] {
+	case '1', '7':
+		p.Sex = SexMale
+	case '2', '8':
+		p.Sex = SexFemale
+	default:
+		return ReasonFormat
+	}
+
+	if 97-atoi(num)%97 != atoi(n[13:]) {
+		return ReasonChecksum
+	}
+
+	// months 20-42 and 50-99 stand for unknown month of birth
+	if month := atoi(n[3:5]); 1 <= month && month <= 12 {
+		p.BirthDate, _ = birthDate(birthYear(now, atoi(n[1:3])), month, 1)
+	} else if month == 0 || (12 < month && month < 20) || (42 < month && month < 50) {
+		return ReasonFormat
+	}
+
+	return ""
+}
@@ -0,0 +1,31 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// ninoUnusedPrefixes are National Insurance number prefixes which are never allocated.
+var ninoUnusedPrefixes = map[string]bool{
+	"BG": true, "GB": true, "KN": true, "NK": true, "NT": true, "TN": true, "ZZ": true,
+}
+
+// checkNINO checks UK National Insurance number "AB123456C": two prefix letters, 6 digits
+// and an optional suffix from "A" to "D".
+// See: https://www.gov.uk/hmrc-internal-manuals/national-insurance-manual/nim39110
+func checkNINO(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 8 && len(n) != 9:
+		return ReasonLength
+	case !Alpha(n[:2]) || !UpperCase(n[:2]) || !Numeric(n[2:8]):
+		return ReasonCharacter
+	case len(n) == 9 && (n[8] < 'A' || 'D' < n[8]):
+		return ReasonCharacter
+	case strings.IndexByte("DFIQUV", n[0]) >= 0 || strings.IndexByte("DFIOQUV", n[1]) >= 0:
+		return ReasonReserved
+	case ninoUnusedPrefixes[n[:2]]:
+		return ReasonReserved
+	}
+
+	return ""
+}
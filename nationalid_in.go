@@ -0,0 +1,25 @@
+package is
+
+import (
+	"time"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// checkAadhaar checks Indian Aadhaar number: 12 digits not starting with 0 or 1
+// and ending with Verhoeff check digit.
+// See: https://uidai.gov.in
+func checkAadhaar(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 12:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case n[0] == '0' || n[0] == '1':
+		return ReasonReserved
+	case !checkdigit.Verhoeff.Verify(n):
+		return ReasonChecksum
+	}
+
+	return ""
+}
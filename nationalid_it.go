@@ -0,0 +1,80 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// Codice Fiscale encoding tables.
+const (
+	// codiceFiscaleMonths are letters of months from January to December.
+	codiceFiscaleMonths = "ABCDEHLMPRST"
+	// codiceFiscaleOmocodia are letters replacing digits 0-9 when codes of two people collide.
+	codiceFiscaleOmocodia = "LMNPQRSTUV"
+)
+
+// codiceFiscaleOdd holds values of digits 0-9 and letters A-Z at odd positions.
+var codiceFiscaleOdd = [36]int{
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21,
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23,
+}
+
+// checkCodiceFiscale checks Italian Codice Fiscale of natural persons: 6 letters of the name,
+// birth year, month letter, birth day (plus 40 for women), place of birth code and a check letter.
+// Digits may be replaced by letters in case of omocodia.
+// See: https://www.agenziaentrate.gov.it/portale/web/guest/schede/istanze/richiesta-ts_cf/informazioni-codificazione-pf
+func checkCodiceFiscale(n string, p *NationalIDParts, now time.Time) Reason {
+	if len(n) != 16 {
+		return ReasonLength
+	}
+
+	if !Alphanumeric(n) || !UpperCase(n) {
+		return ReasonCharacter
+	}
+
+	// restore digits replaced in case of omocodia
+	d := []byte(n)
+	for _, i := range []int{6, 7, 9, 10, 12, 13, 14} {
+		if v := strings.IndexByte(codiceFiscaleOmocodia, d[i]); v >= 0 {
+			d[i] = byte('0' + v)
+		}
+	}
+
+	if !Alpha(n[:6]) || !Numeric(string(d[6:8])) || !Numeric(string(d[9:11])) ||
+		!Alpha(n[11:12]) || !Numeric(string(d[12:15])) || !Alpha(n[15:]) {
+		return ReasonFormat
+	}
+
+	var sum int
+	for i := 0; i < 15; i++ {
+		v := int(n[i] - '0')
+		if n[i] >= 'A' {
+			v = int(n[i] - 'A')
+		}
+		if i%2 == 0 {
+			if n[i] >= 'A' {
+				v += 10
+			}
+			v = codiceFiscaleOdd[v]
+		}
+		sum += v
+	}
+	if byte('A'+sum%26) != n[15] {
+		return ReasonChecksum
+	}
+
+	month := strings.IndexByte(codiceFiscaleMonths, n[8]) + 1
+	day := atoi(string(d[9:11]))
+	p.Sex = SexMale
+	if day > 40 {
+		day -= 40
+		p.Sex = SexFemale
+	}
+
+	var ok bool
+	if p.BirthDate, ok = birthDate(birthYear(now, atoi(string(d[6:8]))), month, day); !ok {
+		return ReasonFormat
+	}
+
+	return ""
+}
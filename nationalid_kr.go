@@ -0,0 +1,31 @@
+package is
+
+import "time"
+
+// checkRRN checks South Korean resident registration number "YYMMDD-SBBBBNC":
+// birth date and digit encoding sex and century.
+// Digits 1-4 are used for citizens, 5-8 for foreigners and 9 and 0 for people born in 1800s.
+// The last digit is not checked: numbers issued since October 2020 end with random digits
+// and the issue date is not encoded, so a check digit failure does not prove the number invalid.
+func checkRRN(n string, p *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 13:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	}
+
+	s := int(n[6] - '0')
+	century := [10]int{1800, 1900, 1900, 2000, 2000, 1900, 1900, 2000, 2000, 1800}[s]
+	p.Sex = SexFemale
+	if s%2 == 1 {
+		p.Sex = SexMale
+	}
+
+	var ok bool
+	if p.BirthDate, ok = birthDate(century+atoi(n[:2]), atoi(n[2:4]), atoi(n[4:6])); !ok {
+		return ReasonFormat
+	}
+
+	return ""
+}
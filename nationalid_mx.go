@@ -0,0 +1,66 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// curpStates holds CURP codes of Mexican states, "NE" stands for people born abroad.
+var curpStates = map[string]bool{
+	"AS": true, "BC": true, "BS": true, "CC": true, "CL": true, "CM": true, "CS": true, "CH": true,
+	"DF": true, "DG": true, "GT": true, "GR": true, "HG": true, "JC": true, "MC": true, "MN": true,
+	"MS": true, "NT": true, "NL": true, "OC": true, "PL": true, "QT": true, "QR": true, "SP": true,
+	"SL": true, "SR": true, "TC": true, "TS": true, "TL": true, "VZ": true, "YN": true, "ZS": true,
+	"NE": true,
+}
+
+// curpChars are characters in the order of their CURP check values, "&" stands for "Ñ".
+const curpChars = "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ"
+
+// checkCURP checks Mexican Clave Única de Registro de Población: 4 letters of the name,
+// birth date, sex ("H" for men, "M" for women), state of birth, 3 consonants of the name,
+// a homonymy character which is a digit for people born before 2000 and the check digit.
+// See: https://www.gob.mx/curp/
+func checkCURP(n string, p *NationalIDParts, _ time.Time) Reason {
+	if len(n) != 18 {
+		return ReasonLength
+	}
+
+	if !Alpha(n[:4]) || !Numeric(n[4:10]) || !Alpha(n[10:16]) || !Alphanumeric(n[16:17]) ||
+		!Numeric(n[17:]) || !UpperCase(n) {
+		return ReasonCharacter
+	}
+
+	switch n[10] {
+	case 'H':
+		p.Sex = SexMale
+	case 'M':
+		p.Sex = SexFemale
+	default:
+		return ReasonFormat
+	}
+
+	if !curpStates[n[11:13]] {
+		return ReasonFormat
+	}
+
+	var sum int
+	for i := 0; i < 17; i++ {
+		sum += strings.IndexByte(curpChars, n[i]) * (18 - i)
+	}
+	if (10-sum%10)%10 != int(n[17]-'0') {
+		return ReasonChecksum
+	}
+
+	year := 1900 + atoi(n[4:6])
+	if n[16] >= 'A' {
+		year += 100
+	}
+
+	var ok bool
+	if p.BirthDate, ok = birthDate(year, atoi(n[6:8]), atoi(n[8:10])); !ok {
+		return ReasonFormat
+	}
+
+	return ""
+}
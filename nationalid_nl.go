@@ -0,0 +1,21 @@
+package is
+
+import "time"
+
+// checkBSN checks Dutch Burgerservicenummer: 9 digits passing the "11-proof",
+// i.e. having weighted sum with the last digit weighted -1 divisible by 11.
+func checkBSN(n string, _ *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 9:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	}
+
+	sum := weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2) - int(n[8]-'0')
+	if sum == 0 || sum%11 != 0 {
+		return ReasonChecksum
+	}
+
+	return ""
+}
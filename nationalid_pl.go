@@ -0,0 +1,35 @@
+package is
+
+import "time"
+
+// checkPESEL checks Polish Powszechny Elektroniczny System Ewidencji Ludności number:
+// birth date with the century encoded in the month, serial number with odd digit for men
+// and the check digit.
+// See: https://www.gov.pl/web/gov/czym-jest-numer-pesel
+func checkPESEL(n string, p *NationalIDParts, _ time.Time) Reason {
+	switch {
+	case len(n) != 11:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case (10-weightedSum(n, 1, 3, 7, 9, 1, 3, 7, 9, 1, 3)%10)%10 != int(n[10]-'0'):
+		return ReasonChecksum
+	}
+
+	// months 81-92 are used for 1800s, 1-12 for 1900s, 21-32 for 2000s and so on
+	month := atoi(n[2:4])
+	century := (month/20+1)%5*100 + 1800
+	month %= 20
+
+	var ok bool
+	if p.BirthDate, ok = birthDate(century+atoi(n[:2]), month, atoi(n[4:6])); !ok {
+		return ReasonFormat
+	}
+
+	p.Sex = SexFemale
+	if (n[9]-'0')%2 == 1 {
+		p.Sex = SexMale
+	}
+
+	return ""
+}
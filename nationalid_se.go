@@ -0,0 +1,68 @@
+package is
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// checkPersonnummer checks Swedish personal identity number "YYMMDD-NNNC" or "YYYYMMDDNNNC".
+func checkPersonnummer(n string, p *NationalIDParts, now time.Time) Reason {
+	return checkSwedishID(n, p, now, 0)
+}
+
+// checkSamordningsnummer checks Swedish coordination number which has 60 added to the day of birth.
+func checkSamordningsnummer(n string, p *NationalIDParts, now time.Time) Reason {
+	return checkSwedishID(n, p, now, 60)
+}
+
+// checkSwedishID checks the birth date with dayOffset added to the day, the serial number
+// with odd third digit for men and the Luhn check digit of the last ten digits.
+// The "+" separator marks ten digit numbers of people who are 100 or older.
+// See: https://www.skatteverket.se/privat/folkbokforing/personnummer.4.3810a01c150939e893f18c29.html
+func checkSwedishID(n string, p *NationalIDParts, now time.Time, dayOffset int) Reason {
+	old := false
+	if i := strings.IndexByte(n, '+'); i >= 0 {
+		if i != 6 || len(n) != 11 {
+			return ReasonFormat
+		}
+		n = n[:6] + n[7:]
+		old = true
+	}
+
+	if len(n) != 10 && len(n) != 12 {
+		return ReasonLength
+	}
+
+	if !Numeric(n) {
+		return ReasonCharacter
+	}
+
+	var year int
+	if len(n) == 12 {
+		year = atoi(n[:4])
+		n = n[2:]
+	} else {
+		year = birthYear(now, atoi(n[:2]))
+		if old {
+			year -= 100
+		}
+	}
+
+	var ok bool
+	if p.BirthDate, ok = birthDate(year, atoi(n[2:4]), atoi(n[4:6])-dayOffset); !ok {
+		return ReasonFormat
+	}
+
+	if !checkdigit.Luhn.Verify(n) {
+		return ReasonChecksum
+	}
+
+	p.Sex = SexFemale
+	if (n[8]-'0')%2 == 1 {
+		p.Sex = SexMale
+	}
+
+	return ""
+}
@@ -0,0 +1,168 @@
+package is
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNationalID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		country  string
+		param    string
+		expected bool
+	}{
+		{"ES", "", false},
+		{"ES", "12345678Z", true},
+		{"ES", "12345678-Z", true},
+		{"ES", "12345678A", false},
+		{"ES", "X1234567L", true},
+		{"ES", "X1234567A", false},
+		{"ES", "K1234567L", false},
+		{"IT", "RSSMRA85T10A562S", true},
+		{"IT", "RSSMRA85T10A56NH", true},
+		{"IT", "RSSMRA85T10A562T", false},
+		{"IT", "RSSMRA85T10A562", false},
+		{"IT", "rssmra85t10a562s", false},
+		{"FR", "1 85 05 78 006 084 91", true},
+		{"FR", "185057800608492", false},
+		{"FR", "269052A12345688", true},
+		{"FR", "385057800608491", false},
+		{"DE", "86095742719", true},
+		{"DE", "65929970489", true},
+		{"DE", "86095742718", false},
+		{"DE", "06095742719", false},
+		{"DE", "12345678903", false},
+		{"DE", "11145678908", false},
+		{"PL", "44051401359", true},
+		{"PL", "44051401358", false},
+		{"PL", "44133101354", false},
+		{"SE", "811218-9876", true},
+		{"SE", "8112189876", true},
+		{"SE", "19811218-9876", true},
+		{"SE", "811218+9876", true},
+		{"SE", "811218-9874", false},
+		{"SE", "811278-9873", true},
+		{"SE", "811238-9876", false},
+		{"NL", "111222333", true},
+		{"NL", "111222334", false},
+		{"NL", "000000000", false},
+		{"BE", "85.07.30-033.28", true},
+		{"BE", "17010100171", true},
+		{"BE", "85073003329", false},
+		{"GB", "AB 12 34 56 C", true},
+		{"GB", "AB123456", true},
+		{"GB", "AB123456E", false},
+		{"GB", "QQ123456C", false},
+		{"GB", "GB123456A", false},
+		{"BR", "529.982.247-25", true},
+		{"BR", "529.982.247-26", false},
+		{"BR", "111.111.111-11", false},
+		{"CA", "130 692 544", true},
+		{"CA", "130 692 545", false},
+		{"CA", "046 454 286", false},
+		{"MX", "HEGG560427MVZRRL04", true},
+		{"MX", "HEGG560427MVZRRL05", false},
+		{"MX", "HEGG560427MXXRRL04", false},
+		{"MX", "GOMC050101MDFRRNA2", true},
+		{"CL", "12.345.678-5", true},
+		{"CL", "12.345.678-K", false},
+		{"CL", "1.000.005-K", true},
+		{"CL", "1.000.005-k", true},
+		{"CL", "1000005k", true},
+		{"CL", "12.345.678-k", false},
+		{"AR", "20-17254359-7", true},
+		{"AR", "30-50001091-2", true},
+		{"AR", "20-17254359-8", false},
+		{"AR", "10-17254359-7", false},
+		{"IN", "2341 2341 2346", true},
+		{"IN", "2341 2341 2347", false},
+		{"IN", "1341 2341 2346", false},
+		{"CN", "11010519491231002X", true},
+		{"CN", "110105194912310021", false},
+		{"CN", "990105194912310021", false},
+		{"KR", "800101-1234560", true},
+		{"KR", "800101-1234561", true},
+		{"KR", "801301-1234560", false},
+		{"KR", "210101-3234567", true},
+		{"US", "123-45-6789", false},
+		{"XX", "12345678Z", false},
+	}
+	for _, test := range tests {
+		actual := NationalID(test.country, test.param)
+		if actual != test.expected {
+			t.Errorf("Expected NationalID(%q, %q) to be %v, got %v", test.country, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseNationalID(t *testing.T) {
+	t.Parallel()
+
+	date := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+	var tests = []struct {
+		country string
+		param   string
+		kind    NationalIDKind
+		birth   time.Time
+		sex     Sex
+	}{
+		{"ES", "X1234567L", NationalIDNIE, time.Time{}, SexUnknown},
+		{"IT", "RSSMRA85T10A562S", NationalIDCodiceFiscale, date(1985, 12, 10), SexMale},
+		{"IT", "BNCLRA90A41H501F", NationalIDCodiceFiscale, date(1990, 1, 1), SexFemale},
+		{"FR", "269052A12345688", NationalIDNIR, date(1969, 5, 1), SexFemale},
+		{"DE", "86095742719", NationalIDSteuerID, time.Time{}, SexUnknown},
+		{"PL", "44051401359", NationalIDPESEL, date(1944, 5, 14), SexMale},
+		{"PL", "02070803628", NationalIDPESEL, date(1902, 7, 8), SexFemale},
+		{"SE", "811218-9876", NationalIDPersonnummer, date(1981, 12, 18), SexMale},
+		{"SE", "811218+9876", NationalIDPersonnummer, date(1881, 12, 18), SexMale},
+		{"SE", "811278-9873", NationalIDSamordningsnummer, date(1981, 12, 18), SexMale},
+		{"BE", "85073003328", NationalIDNN, date(1985, 7, 30), SexMale},
+		{"BE", "17010100171", NationalIDNN, date(2017, 1, 1), SexMale},
+		{"GB", "AB123456C", NationalIDNINO, time.Time{}, SexUnknown},
+		{"BR", "52998224725", NationalIDCPF, time.Time{}, SexUnknown},
+		{"MX", "HEGG560427MVZRRL04", NationalIDCURP, date(1956, 4, 27), SexFemale},
+		{"MX", "GOMC050101MDFRRNA2", NationalIDCURP, date(2005, 1, 1), SexFemale},
+		{"AR", "20172543597", NationalIDCUIT, time.Time{}, SexMale},
+		{"CN", "11010519491231002X", NationalIDResidentID, date(1949, 12, 31), SexFemale},
+		{"CN", "440524188001010014", NationalIDResidentID, date(1880, 1, 1), SexMale},
+		{"KR", "8001011234560", NationalIDRRN, date(1980, 1, 1), SexMale},
+	}
+	for _, test := range tests {
+		actual, err := ParseNationalID(test.country, test.param)
+		if err != nil || actual.Kind != test.kind || !actual.BirthDate.Equal(test.birth) || actual.Sex != test.sex {
+			t.Errorf("Expected ParseNationalID(%q, %q) to be %s born %v sex %d, got %+v, %v",
+				test.country, test.param, test.kind, test.birth, test.sex, actual, err)
+		}
+	}
+}
+
+func TestParseNationalIDAt(t *testing.T) {
+	t.Parallel()
+
+	date := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+	var tests = []struct {
+		country string
+		param   string
+		now     time.Time
+		birth   time.Time
+	}{
+		{"IT", "RSSMRA85T10A562S", date(2026, 10, 18), date(1985, 12, 10)},
+		{"IT", "RSSMRA85T10A562S", date(2085, 1, 1), date(2085, 12, 10)},
+		{"IT", "RSSMRA85T10A562S", date(2084, 12, 31), date(1985, 12, 10)},
+		{"FR", "269052A12345688", date(1999, 1, 1), date(1969, 5, 1)},
+		{"FR", "269052A12345688", date(2100, 1, 1), date(2069, 5, 1)},
+		{"SE", "811218-9876", date(2026, 10, 18), date(1981, 12, 18)},
+		{"SE", "811218+9876", date(2026, 10, 18), date(1881, 12, 18)},
+		{"SE", "811218-9876", date(2101, 6, 1), date(2081, 12, 18)},
+		{"SE", "198112189876", date(2101, 6, 1), date(1981, 12, 18)},
+	}
+	for _, test := range tests {
+		actual, err := ParseNationalIDAt(test.country, test.param, test.now)
+		if err != nil || !actual.BirthDate.Equal(test.birth) {
+			t.Errorf("Expected ParseNationalIDAt(%q, %q, %v) to be born %v, got %+v, %v",
+				test.country, test.param, test.now, test.birth, actual, err)
+		}
+	}
+}
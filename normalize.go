@@ -0,0 +1,167 @@
+package is
+
+import (
+	"net"
+	"strings"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// NormalizeEmail lower-cases the domain of the email address and converts
+// an internationalized domain to its ASCII form, e.g. "Jane@Bücher.EXAMPLE" becomes
+// "Jane@xn--bcher-kva.example". The local part is kept as is, since it may be case-sensitive.
+// On failure the error is a *ValidationError, domains which are not valid UTF-8
+// are reported with ReasonEncoding.
+func NormalizeEmail(s string) (string, error) {
+	if len(s) == 0 {
+		return "", validationError("Email", ReasonEmpty, -1)
+	}
+
+	if !Email(s) {
+		return "", validationError("Email", ReasonFormat, -1)
+	}
+
+	at := strings.LastIndex(s, "@")
+	domain, ok := idnaToASCII(s[at+1:])
+	if !ok {
+		return "", validationError("Email", ReasonEncoding, at+1)
+	}
+
+	return s[:at+1] + domain, nil
+}
+
+// NormalizeMAC converts the hardware address to the lower case colon-separated form,
+// e.g. "00-1A-2B-3C-4D-5E" becomes "00:1a:2b:3c:4d:5e".
+// See MAC for accepted formats. On failure the error is a *ValidationError.
+func NormalizeMAC(s string) (string, error) {
+	if len(s) == 0 {
+		return "", validationError("MAC", ReasonEmpty, -1)
+	}
+
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return "", validationError("MAC", ReasonFormat, -1)
+	}
+
+	return mac.String(), nil
+}
+
+// NormalizeIP converts the IP address to its canonical form: IPv4 addresses
+// in dotted decimal form, including IPv4-mapped IPv6 ones ("::ffff:192.0.2.1" becomes "192.0.2.1"),
+// and other IPv6 addresses in compressed lower case form as per RFC 5952.
+// On failure the error is a *ValidationError.
+func NormalizeIP(s string) (string, error) {
+	if len(s) == 0 {
+		return "", validationError("IP", ReasonEmpty, -1)
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return "", validationError("IP", ReasonFormat, -1)
+	}
+
+	return ip.String(), nil
+}
+
+// NormalizeUUID converts the UUID to the lower case hyphenated form.
+// Upper case digits, "urn:uuid:" prefix, surrounding braces and missing hyphens are accepted,
+// e.g. "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}" becomes "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+// On failure the error is a *ValidationError.
+func NormalizeUUID(s string) (string, error) {
+	if len(s) == 0 {
+		return "", validationError("UUID", ReasonEmpty, -1)
+	}
+
+	// offset of the UUID itself within s
+	u, off := s, 0
+	if len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+		u, off = s[9:], 9
+	} else if len(s) > 2 && s[0] == '{' && s[len(s)-1] == '}' {
+		u, off = s[1:len(s)-1], 1
+	}
+	switch len(u) {
+	case 32:
+		for i := 0; i < len(u); i++ {
+			if !uuidHexDigit(u[i]) {
+				return "", validationError("UUID", ReasonCharacter, off+i)
+			}
+		}
+		u = u[:8] + "-" + u[8:12] + "-" + u[12:16] + "-" + u[16:20] + "-" + u[20:]
+	case 36:
+		for i := 0; i < len(u); i++ {
+			hyphen := i == 8 || i == 13 || i == 18 || i == 23
+			if hyphen && u[i] != '-' || !hyphen && !uuidHexDigit(u[i]) {
+				return "", validationError("UUID", ReasonCharacter, off+i)
+			}
+		}
+	default:
+		return "", validationError("UUID", ReasonLength, -1)
+	}
+
+	return strings.ToLower(u), nil
+}
+
+// uuidHexDigit reports whether c is a hexadecimal digit of either case.
+func uuidHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// NormalizeISBN converts ISBN version 10 or 13 to ISBN 13 digits without hyphens,
+// e.g. "3-8362-2119-5" becomes "9783836221191".
+// On failure the error is a *ValidationError.
+func NormalizeISBN(s string) (string, error) {
+	d, _, err := stripISBN(s, 10)
+	if err != nil {
+		return "", err
+	}
+
+	if len(d) == 10 {
+		return ISBN10To13(s)
+	}
+
+	if err = CheckISBN13(s); err != nil {
+		return "", err
+	}
+
+	return d, nil
+}
+
+// NormalizeCard returns digits of the card number without spaces or hyphens,
+// e.g. "4111 1111 1111 1111" becomes "4111111111111111".
+// The number must have 12 to 19 digits passing the Luhn check, other separators are not allowed.
+// On failure the error is a *ValidationError.
+func NormalizeCard(s string) (string, error) {
+	if len(s) == 0 {
+		return "", validationError("CreditCard", ReasonEmpty, -1)
+	}
+
+	d := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			d = append(d, c)
+		case c != ' ' && c != '-':
+			return "", validationError("CreditCard", ReasonCharacter, i)
+		}
+	}
+
+	if len(d) < 12 || len(d) > 19 {
+		return "", validationError("CreditCard", ReasonLength, -1)
+	}
+
+	if !checkdigit.Luhn.Verify(string(d)) {
+		return "", validationError("CreditCard", ReasonChecksum, strings.LastIndexAny(s, "0123456789"))
+	}
+
+	return string(d), nil
+}
+
+// NormalizeSemver strips the leading "v" from the semantic version, e.g. "v1.2.3-rc.1" becomes "1.2.3-rc.1".
+// On failure the error is the same *ValidationError as returned by CheckSemver.
+func NormalizeSemver(s string) (string, error) {
+	if err := CheckSemver(s); err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(s, "v"), nil
+}
@@ -0,0 +1,102 @@
+package is
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name      string
+		normalize func(string) (string, error)
+		validator func(string) bool
+		param     string
+		expected  string
+		reason    Reason
+	}{
+		{"Email", NormalizeEmail, Email, "Jane.Doe@Example.COM", "Jane.Doe@example.com", ""},
+		{"Email", NormalizeEmail, Email, "jane@Bücher.example", "jane@xn--bcher-kva.example", ""},
+		{"Email", NormalizeEmail, Email, "jane@例子。测试", "jane@xn--fsqu00a.xn--0zwm56d", ""},
+		{"Email", NormalizeEmail, Email, "jane@пример.рф", "jane@xn--e1afmkfd.xn--p1ai", ""},
+		{"Email", NormalizeEmail, Email, `"a@b"@ÑANDÚ.ar`, `"a@b"@xn--and-6ma2c.ar`, ""},
+		{"Email", NormalizeEmail, Email, "", "", ReasonEmpty},
+		{"Email", NormalizeEmail, Email, "jane.example.com", "", ReasonFormat},
+		{"Email", NormalizeEmail, Email, "jane@", "", ReasonFormat},
+		{"Email", NormalizeEmail, Email, "jane@\xffexample.com", "", ReasonEncoding},
+		{"MAC", NormalizeMAC, MAC, "00-1A-2B-3C-4D-5E", "00:1a:2b:3c:4d:5e", ""},
+		{"MAC", NormalizeMAC, MAC, "001a.2b3c.4d5e", "00:1a:2b:3c:4d:5e", ""},
+		{"MAC", NormalizeMAC, MAC, "00:1a:2b:3c:4d:5e", "00:1a:2b:3c:4d:5e", ""},
+		{"MAC", NormalizeMAC, MAC, "", "", ReasonEmpty},
+		{"MAC", NormalizeMAC, MAC, "00:1a:2b:3c:4d", "", ReasonFormat},
+		{"IP", NormalizeIP, IP, "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1", ""},
+		{"IP", NormalizeIP, IP, "::ffff:192.0.2.1", "192.0.2.1", ""},
+		{"IP", NormalizeIP, IP, "192.0.2.1", "192.0.2.1", ""},
+		{"IP", NormalizeIP, IP, "::1", "::1", ""},
+		{"IP", NormalizeIP, IP, "", "", ReasonEmpty},
+		{"IP", NormalizeIP, IP, "192.0.2.256", "", ReasonFormat},
+		{"UUID", NormalizeUUID, UUID, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"UUID", NormalizeUUID, UUID, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"UUID", NormalizeUUID, UUID, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"UUID", NormalizeUUID, UUID, "URN:UUID:6BA7B8109DAD11D180B400C04FD430C8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ""},
+		{"UUID", NormalizeUUID, UUID, "", "", ReasonEmpty},
+		{"UUID", NormalizeUUID, UUID, "6ba7b810-9dad-11d1-80b4-00c04fd430c", "", ReasonLength},
+		{"UUID", NormalizeUUID, UUID, "{6ba7b810-9dad-11d1-80b4-00c04fd430cg}", "", ReasonCharacter},
+		{"UUID", NormalizeUUID, UUID, "6ba7b8109-dad-11d1-80b4-00c04fd430c8", "", ReasonCharacter},
+		{"ISBN", NormalizeISBN, func(s string) bool { return ISBN(s, 0) }, "3-8362-2119-5", "9783836221191", ""},
+		{"ISBN", NormalizeISBN, func(s string) bool { return ISBN(s, 0) }, "978 3 8362 2119 1", "9783836221191", ""},
+		{"ISBN", NormalizeISBN, func(s string) bool { return ISBN(s, 0) }, "", "", ReasonEmpty},
+		{"ISBN", NormalizeISBN, func(s string) bool { return ISBN(s, 0) }, "3-8362-2119-6", "", ReasonChecksum},
+		{"ISBN", NormalizeISBN, func(s string) bool { return ISBN(s, 0) }, "978-3-8362-2119", "", ReasonLength},
+		{"ISBN", NormalizeISBN, func(s string) bool { return ISBN(s, 0) }, "3-8362-211X-5", "", ReasonCharacter},
+		{"Card", NormalizeCard, CreditCard, "4111 1111 1111 1111", "4111111111111111", ""},
+		{"Card", NormalizeCard, CreditCard, "4111-1111-1111-1111", "4111111111111111", ""},
+		{"Card", NormalizeCard, CreditCard, "", "", ReasonEmpty},
+		{"Card", NormalizeCard, CreditCard, "4111 1111 1111 1112", "", ReasonChecksum},
+		{"Semver", NormalizeSemver, Semver, "v1.2.3-rc.1+build.5", "1.2.3-rc.1+build.5", ""},
+		{"Semver", NormalizeSemver, Semver, "1.2.3", "1.2.3", ""},
+		{"Semver", NormalizeSemver, Semver, "", "", ReasonEmpty},
+		{"Semver", NormalizeSemver, Semver, "v01.2.3", "", ReasonLeadingZero},
+	}
+	for _, test := range tests {
+		actual, err := test.normalize(test.param)
+		if test.reason == "" {
+			if err != nil || actual != test.expected {
+				t.Errorf("Expected Normalize%s(%q) to be %q, got %q (%v)", test.name, test.param, test.expected, actual, err)
+			}
+			if !test.validator(actual) {
+				t.Errorf("Expected normalized %s %q to be valid", test.name, actual)
+			}
+			continue
+		}
+
+		if verr, ok := err.(*ValidationError); !ok || verr.Reason != test.reason {
+			t.Errorf("Expected Normalize%s(%q) to fail with %s, got %q (%v)", test.name, test.param, test.reason, actual, err)
+		}
+		// invalid UTF-8 is only detected when converting the domain
+		if test.reason != ReasonEncoding && test.validator(test.param) {
+			t.Errorf("Expected %s(%q) to be false", test.name, test.param)
+		}
+	}
+}
+
+func TestNormalizeCard(t *testing.T) {
+	t.Parallel()
+
+	// CreditCard ignores all non-numeric characters, NormalizeCard only spaces and hyphens
+	var tests = []struct {
+		param  string
+		reason Reason
+		offset int
+	}{
+		{"4111.1111.1111.1111", ReasonCharacter, 4},
+		{"4111 1111 1111 111x", ReasonCharacter, 18},
+		{"4111 1111 11", ReasonLength, -1},
+		{"4111 1111 1111 1111 0000", ReasonLength, -1},
+		{"4111-1111-1111-1112 ", ReasonChecksum, 18},
+	}
+	for _, test := range tests {
+		actual, err := NormalizeCard(test.param)
+		if verr, ok := err.(*ValidationError); !ok || verr.Reason != test.reason || verr.Offset != test.offset {
+			t.Errorf("Expected NormalizeCard(%q) to fail with %s at %d, got %q (%v)", test.param, test.reason, test.offset, actual, err)
+		}
+	}
+}
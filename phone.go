@@ -0,0 +1,285 @@
+package is
+
+import "strings"
+
+// PhoneType is the kind of line a phone number belongs to.
+type PhoneType int
+
+// Phone number types returned by ParsePhone
+const (
+	// PhoneUnknown is reported for regions without embedded numbering plan.
+	PhoneUnknown PhoneType = iota
+	PhoneFixedLine
+	PhoneMobile
+	// PhoneFixedLineOrMobile is reported where both types share the same ranges, e.g. in the US.
+	PhoneFixedLineOrMobile
+	PhoneTollFree
+)
+
+// PhoneParts holds components of a phone number.
+type PhoneParts struct {
+	// CallingCode is the country calling code without "+", e.g. "44".
+	CallingCode string
+	// NationalNumber is the national significant number, without the national prefix.
+	NationalNumber string
+	// Region is the ISO 3166-1 alpha-2 code of the region the number belongs to.
+	Region string
+	Type   PhoneType
+}
+
+// phonePlan is phoneMetadata with number masks split by type.
+type phonePlan struct {
+	phoneMetadata
+	areaCodes []string
+	patterns  []phonePattern
+}
+
+type phonePattern struct {
+	typ   PhoneType
+	masks []string
+}
+
+// Indexes built on package initialization.
+var (
+	phonePlanByRegion  = make(map[string]*phonePlan)
+	phonePlansByCode   = make(map[string][]*phonePlan)
+	phoneRegionsByCode = make(map[string][]string)
+)
+
+// phoneMaxCallingCodeLen is the length of the longest country calling codes.
+// Calling codes are prefix-free, so the first match is the only one.
+const phoneMaxCallingCodeLen = 3
+
+func init() {
+	for _, m := range phoneMetadataList {
+		p := &phonePlan{phoneMetadata: m, areaCodes: strings.Fields(m.areaCodes)}
+		for _, t := range []struct {
+			typ   PhoneType
+			masks string
+		}{
+			{PhoneTollFree, m.tollFree},
+			{PhoneMobile, m.mobile},
+			{PhoneFixedLine, m.fixedLine},
+			{PhoneFixedLineOrMobile, m.fixedLineOrMobile},
+		} {
+			if t.masks != "" {
+				p.patterns = append(p.patterns, phonePattern{t.typ, strings.Fields(t.masks)})
+			}
+		}
+		phonePlanByRegion[m.region] = p
+		phonePlansByCode[m.callingCode] = append(phonePlansByCode[m.callingCode], p)
+	}
+
+	for _, c := range CountryInfoList {
+		for _, code := range c.CallingCodes {
+			phoneRegionsByCode[code] = append(phoneRegionsByCode[code], c.Alpha2Code)
+		}
+	}
+}
+
+// Phone check if the string is a phone number, either in international form
+// ("+44 20 7946 0958") or in the national form of defaultRegion ("020 7946 0958").
+// defaultRegion is an ISO 3166-1 alpha-2 code and may be empty if only international numbers are expected.
+func Phone(s, defaultRegion string) bool {
+	_, err := ParsePhone(s, defaultRegion)
+	return err == nil
+}
+
+// NormalizePhoneE164 converts the phone number to E.164 form, e.g. "+442079460958".
+// See ParsePhone for accepted formats.
+func NormalizePhoneE164(s, defaultRegion string) (string, error) {
+	p, err := ParsePhone(s, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+
+	return "+" + p.CallingCode + p.NationalNumber, nil
+}
+
+// ParsePhone splits the phone number into calling code and national number
+// and determines the region and the type of the number.
+// Digits may be grouped with spaces, hyphens, dots, slashes and parentheses.
+// Numbers starting with "+" or the international prefix of defaultRegion are international,
+// other ones are national numbers of defaultRegion.
+// Numbers of regions with embedded numbering plan must match the plan,
+// numbers of other regions are checked against E.164 length limits only.
+// On failure the error is a *ValidationError. Missing or invalid defaultRegion
+// for a national number is reported as ReasonFormat.
+func ParsePhone(s, defaultRegion string) (PhoneParts, error) {
+	var p PhoneParts
+	if len(s) == 0 {
+		return PhoneParts{}, validationError("Phone", ReasonEmpty, -1)
+	}
+
+	if defaultRegion != "" && !ISO3166Alpha2Status(defaultRegion, StatusUserAssigned) {
+		return PhoneParts{}, validationError("Phone", ReasonFormat, -1)
+	}
+
+	digits := make([]byte, 0, len(s))
+	international := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			digits = append(digits, c)
+		case c == '+' && len(digits) == 0 && !international:
+			international = true
+		case c == ' ' || c == '-' || c == '.' || c == '/' || c == '(' || c == ')':
+		default:
+			return PhoneParts{}, validationError("Phone", ReasonCharacter, i)
+		}
+	}
+
+	if len(digits) == 0 {
+		return PhoneParts{}, validationError("Phone", ReasonFormat, -1)
+	}
+
+	number := string(digits)
+	plan := phonePlanByRegion[defaultRegion]
+	if !international && defaultRegion != "" {
+		prefix := "00"
+		if plan != nil {
+			prefix = plan.internationalPrefix
+		}
+		if strings.HasPrefix(number, prefix) {
+			international = true
+			number = number[len(prefix):]
+		}
+	}
+
+	if international {
+		for n := 1; n <= phoneMaxCallingCodeLen && n < len(number); n++ {
+			if len(phoneRegionsByCode[number[:n]]) > 0 {
+				p.CallingCode = number[:n]
+				break
+			}
+		}
+		if p.CallingCode == "" {
+			return PhoneParts{}, validationError("Phone", ReasonReserved, -1)
+		}
+		p.NationalNumber = number[len(p.CallingCode):]
+	} else {
+		codes := CountryCallingCodes(defaultRegion)
+		if len(codes) == 0 {
+			return PhoneParts{}, validationError("Phone", ReasonFormat, -1)
+		}
+		p.CallingCode = codes[0]
+
+		prefix := "0"
+		if plan != nil {
+			prefix = plan.nationalPrefix
+		}
+		p.NationalNumber = number
+		if prefix != "" && strings.HasPrefix(number, prefix) {
+			p.NationalNumber = number[len(prefix):]
+		}
+	}
+
+	// E.164 numbers have at most 15 digits
+	if len(p.NationalNumber) < 4 || len(p.CallingCode)+len(p.NationalNumber) > 15 {
+		return PhoneParts{}, validationError("Phone", ReasonLength, -1)
+	}
+
+	if plans := phonePlansByCode[p.CallingCode]; len(plans) > 0 {
+		for _, plan := range plans {
+			if !plan.hasAreaCode(p.NationalNumber) {
+				continue
+			}
+			if typ, ok := plan.match(p.NationalNumber); ok {
+				p.Region, p.Type = plan.region, typ
+				return p, nil
+			}
+			if len(plan.areaCodes) > 0 {
+				// area codes belong to a single region
+				break
+			}
+		}
+		return PhoneParts{}, validationError("Phone", ReasonReserved, -1)
+	}
+
+	p.Region = phoneRegionsByCode[p.CallingCode][0]
+	for _, r := range phoneRegionsByCode[p.CallingCode] {
+		if r == defaultRegion {
+			p.Region = r
+		}
+	}
+
+	return p, nil
+}
+
+// hasAreaCode reports whether the national number belongs to the area codes of the plan.
+// Plans without area codes cover all numbers.
+func (p *phonePlan) hasAreaCode(number string) bool {
+	if len(p.areaCodes) == 0 {
+		return true
+	}
+
+	for _, a := range p.areaCodes {
+		if strings.HasPrefix(number, a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match returns the type of the first mask the national number matches.
+func (p *phonePlan) match(number string) (PhoneType, bool) {
+	for _, pattern := range p.patterns {
+		for _, mask := range pattern.masks {
+			if phoneMaskMatch(mask, number) {
+				return pattern.typ, true
+			}
+		}
+	}
+
+	return PhoneUnknown, false
+}
+
+// phoneMaskMatch check if the string of digits matches the mask, see phoneMetadata for the syntax.
+func phoneMaskMatch(mask, s string) bool {
+	i := 0
+	for j := 0; j < len(mask); j++ {
+		if mask[j] == '?' {
+			// optional digits are only allowed at the end of the mask
+			return len(s)-i <= len(mask)-j
+		}
+		if i == len(s) {
+			return false
+		}
+
+		switch c := s[i]; mask[j] {
+		case '#':
+		case '[':
+			end := j + strings.IndexByte(mask[j:], ']')
+			if !phoneDigitInSet(mask[j+1:end], c) {
+				return false
+			}
+			j = end
+		default:
+			if mask[j] != c {
+				return false
+			}
+		}
+		i++
+	}
+
+	return i == len(s)
+}
+
+// phoneDigitInSet check if the digit is in the set of digits and ranges, e.g. "1-57-9".
+func phoneDigitInSet(set string, c byte) bool {
+	for k := 0; k < len(set); k++ {
+		if k+2 < len(set) && set[k+1] == '-' {
+			if set[k] <= c && c <= set[k+2] {
+				return true
+			}
+			k += 2
+			continue
+		}
+		if set[k] == c {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,288 @@
+package is
+
+// phoneMetadata describes the numbering plan of a region.
+// Number masks match whole national significant numbers, i.e. numbers
+// without the country calling code and the national (trunk) prefix.
+// Masks are separated by spaces, in a mask "#" stands for a digit, "?" for an optional
+// trailing digit, "[...]" for a digit from the set, e.g. "[1-57-9]", and digits must be present as is.
+// Empty mask list means that the region has no numbers of the type.
+type phoneMetadata struct {
+	region      string
+	callingCode string
+	// internationalPrefix is dialled before the calling code of another country.
+	internationalPrefix string
+	// nationalPrefix is dialled before national numbers within the region.
+	nationalPrefix string
+	// areaCodes are space separated prefixes all numbers of the region start with.
+	// They tell apart regions sharing a calling code, e.g. in the North American Numbering Plan.
+	areaCodes string
+	tollFree  string
+	mobile    string
+	fixedLine string
+	// fixedLineOrMobile is used where fixed-line and mobile numbers share the same ranges.
+	fixedLineOrMobile string
+}
+
+// nanpNumber is the number format of the North American Numbering Plan:
+// three digit area code followed by seven digit subscriber number not starting with 0 or 1.
+const nanpNumber = "###[2-9]######"
+
+// phoneMetadataList holds numbering plans of the embedded regions.
+// Regions sharing a calling code are tried in order, so more specific plans go first.
+// Numbers from other regions are checked against E.164 length limits only.
+// Based on national numbering plans published by ITU-T:
+// https://www.itu.int/oth/T0202.aspx?parent=T0202
+var phoneMetadataList = []phoneMetadata{
+	{
+		region: "CA", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes:         "204 226 236 249 250 263 289 306 343 354 365 367 368 382 403 416 418 428 431 437 438 450 468 474 506 514 519 548 579 581 584 587 604 613 639 647 672 683 705 709 742 753 778 780 782 807 819 825 867 873 879 902 905",
+		fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "AG", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "268", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "AI", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "264", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "AS", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "684", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "BB", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "246", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "BM", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "441", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "BS", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "242", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "DM", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "767", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "DO", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "809 829 849", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "GD", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "473", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "GU", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "671", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "JM", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "876 658", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "KN", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "869", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "KY", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "345", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "LC", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "758", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "MP", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "670", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "MS", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "664", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "PR", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "787 939", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "SX", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "721", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "TC", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "649", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "TT", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "868", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "VC", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "784", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "VG", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "284", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		region: "VI", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		areaCodes: "340", fixedLineOrMobile: nanpNumber,
+	},
+	{
+		// area codes of other NANP regions are matched by plans above,
+		// N11 service codes, N9X expansion codes and reserved 37X and 96X codes are excluded
+		region: "US", callingCode: "1", internationalPrefix: "011", nationalPrefix: "1",
+		tollFree:          "800[2-9]###### 833[2-9]###### 844[2-9]###### 855[2-9]###### 866[2-9]###### 877[2-9]###### 888[2-9]######",
+		fixedLineOrMobile: "[24-8][02-8]#[2-9]###### [24-8]1[02-9][2-9]###### 3[02-68]#[2-9]###### 31[02-9][2-9]###### 9[02-578]#[2-9]###### 91[02-9][2-9]######",
+	},
+	{
+		region: "RU", callingCode: "7", internationalPrefix: "810", nationalPrefix: "8",
+		tollFree:  "80[04]#######",
+		mobile:    "9#########",
+		fixedLine: "[348]#########",
+	},
+	{
+		region: "KZ", callingCode: "7", internationalPrefix: "810", nationalPrefix: "8",
+		tollFree:  "800#######",
+		mobile:    "70[0-8]####### 747####### 77[178]#######",
+		fixedLine: "7[12]########",
+	},
+	{
+		region: "ZA", callingCode: "27", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800######",
+		mobile:    "6######## 7[1-46-9]####### 8[1-4]#######",
+		fixedLine: "[1-5]########",
+	},
+	{
+		region: "NL", callingCode: "31", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800####???",
+		mobile:    "6[1-58]#######",
+		fixedLine: "[1-57]########",
+	},
+	{
+		region: "BE", callingCode: "32", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800#####",
+		mobile:    "4[5-9]#######",
+		fixedLine: "[1-9]#######",
+	},
+	{
+		region: "FR", callingCode: "33", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "80[05]######",
+		mobile:    "[67]########",
+		fixedLine: "[1-59]########",
+	},
+	{
+		region: "ES", callingCode: "34", internationalPrefix: "00",
+		tollFree:  "[89]00######",
+		mobile:    "6######## 7[1-9]#######",
+		fixedLine: "[89][1-8]#######",
+	},
+	{
+		region: "IT", callingCode: "39", internationalPrefix: "00",
+		tollFree:  "800###??? 803###",
+		mobile:    "3########?",
+		fixedLine: "0#####?????",
+	},
+	{
+		region: "CH", callingCode: "41", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800######",
+		mobile:    "7[5-9]#######",
+		fixedLine: "2[12467]####### 3[1-4]####### 4[134]####### 5[256]####### 6[12]####### [7-9]1#######",
+	},
+	{
+		region: "GB", callingCode: "44", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800######? 808#######",
+		mobile:    "7[1-57-9]########",
+		fixedLine: "[12]########?",
+	},
+	{
+		region: "DK", callingCode: "45", internationalPrefix: "00",
+		tollFree:          "80######",
+		fixedLineOrMobile: "[2-9]#######",
+	},
+	{
+		region: "SE", callingCode: "46", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "20####???",
+		mobile:    "7[02369]#######",
+		fixedLine: "[1-689]######??",
+	},
+	{
+		region: "NO", callingCode: "47", internationalPrefix: "00",
+		tollFree:  "80[01]#####",
+		mobile:    "4####### 9#######",
+		fixedLine: "[235-7]#######",
+	},
+	{
+		region: "PL", callingCode: "48", internationalPrefix: "00",
+		tollFree:  "800######",
+		mobile:    "45####### 5[0137]####### 6[069]####### 7[2389]####### 88#######",
+		fixedLine: "1[2-8]####### 2[2-69]####### 3[2-4]####### 4[1-468]####### 5[24-689]####### 6[1-3578]####### 7[14-7]####### 8[1-79]####### 9[145]#######",
+	},
+	{
+		region: "DE", callingCode: "49", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800#######??",
+		mobile:    "15[0-25-9]######## 16[023]#######? 17########",
+		fixedLine: "[2-9]#####?????",
+	},
+	{
+		region: "MX", callingCode: "52", internationalPrefix: "00",
+		tollFree:          "800#######",
+		fixedLineOrMobile: "[2-9]#########",
+	},
+	{
+		region: "BR", callingCode: "55", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "800######?",
+		mobile:    "[1-9][1-9]9########",
+		fixedLine: "[1-9][1-9][2-5]#######",
+	},
+	{
+		region: "AU", callingCode: "61", internationalPrefix: "0011", nationalPrefix: "0",
+		tollFree:  "1800###### 180####",
+		mobile:    "4########",
+		fixedLine: "[2378]########",
+	},
+	{
+		region: "SG", callingCode: "65", internationalPrefix: "000",
+		tollFree:  "1800#######",
+		mobile:    "[89]#######",
+		fixedLine: "6#######",
+	},
+	{
+		region: "JP", callingCode: "81", internationalPrefix: "010", nationalPrefix: "0",
+		tollFree:  "120###### 800#######",
+		mobile:    "[7-9]0########",
+		fixedLine: "[1-9]########",
+	},
+	{
+		region: "KR", callingCode: "82", internationalPrefix: "001", nationalPrefix: "0",
+		tollFree:  "80#######",
+		mobile:    "10######## 1[16-9]#######?",
+		fixedLine: "2#######? [3-6][1-5]#######?",
+	},
+	{
+		region: "CN", callingCode: "86", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "[48]00#######",
+		mobile:    "13######### 14[5-9]######## 15[0-35-9]######## 16[2567]######## 17[0-8]######## 18######### 19[0-35-9]########",
+		fixedLine: "10######?? 2#######?? [3-9]########??",
+	},
+	{
+		region: "IN", callingCode: "91", internationalPrefix: "00", nationalPrefix: "0",
+		tollFree:  "1800######?",
+		mobile:    "[6-9]#########",
+		fixedLine: "1[1-9]######## [2-5]#########",
+	},
+	{
+		region: "PT", callingCode: "351", internationalPrefix: "00",
+		tollFree:  "800######",
+		mobile:    "9[1236]#######",
+		fixedLine: "2########",
+	},
+	{
+		region: "HK", callingCode: "852", internationalPrefix: "001",
+		tollFree:  "800######",
+		mobile:    "[4-79]#######",
+		fixedLine: "[23]#######",
+	},
+}
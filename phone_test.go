@@ -0,0 +1,116 @@
+package is
+
+import "testing"
+
+func TestPhone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		region   string
+		expected bool
+	}{
+		{"", "", false},
+		{"+44 20 7946 0958", "", true},
+		{"+44 (20) 7946-0958", "US", true},
+		{"020 7946 0958", "GB", true},
+		{"020 7946 0958", "", false},
+		{"020 7946 0958", "ZZZ", false},
+		{"+1 415-555-0100", "", true},
+		{"(415) 555-0100", "US", true},
+		{"1 415 555 0100", "US", true},
+		{"011 44 7700 900123", "US", true},
+		{"0049 30 901820", "FR", true},
+		{"+49 1512 3456789", "", true},
+		{"+33 6 12 34 56 78", "", true},
+		{"06.12.34.56.78", "FR", true},
+		{"+39 06 6982 1234", "", true},
+		{"+86 138 0013 8000", "", true},
+		{"+91 98765 43210", "", true},
+		{"+81 90-1234-5678", "", true},
+		{"+61 4 1234 5678", "", true},
+		{"+55 11 91234-5678", "", true},
+		{"+7 701 123 4567", "", true},
+		{"+372 5123 4567", "", true},
+		{"5123 4567", "EE", true},
+		{"+1 115 555 0100", "", false},
+		{"+1 876 123 4567", "", false},
+		{"+1 372 555 0100", "", false},
+		{"+1 911 555 0100", "", false},
+		{"+27 82 123 4567", "", true},
+		{"+351 912 345 678", "", true},
+		{"+82 10-1234-5678", "", true},
+		{"6123 4567", "SG", true},
+		{"+44 20 7946 09", "", false},
+		{"+33 0 12 34 56 78", "", false},
+		{"+999 1234 5678", "", false},
+		{"+372 12", "", false},
+		{"+372 1234 5678 9012 3456", "", false},
+		{"+44 20 7946 0958 ext 1", "", false},
+		{"44+20 7946 0958", "GB", false},
+		{"++44 20 7946 0958", "", false},
+		{"+", "", false},
+	}
+	for _, test := range tests {
+		actual := Phone(test.param, test.region)
+		if actual != test.expected {
+			t.Errorf("Expected Phone(%q, %q) to be %v, got %v", test.param, test.region, test.expected, actual)
+		}
+	}
+}
+
+func TestParsePhone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		region   string
+		expected PhoneParts
+	}{
+		{"020 7946 0958", "GB", PhoneParts{"44", "2079460958", "GB", PhoneFixedLine}},
+		{"+44 7700 900123", "", PhoneParts{"44", "7700900123", "GB", PhoneMobile}},
+		{"0800 123 4567", "GB", PhoneParts{"44", "8001234567", "GB", PhoneTollFree}},
+		{"+1 416 555 0100", "US", PhoneParts{"1", "4165550100", "CA", PhoneFixedLineOrMobile}},
+		{"1-800-555-0100", "CA", PhoneParts{"1", "8005550100", "US", PhoneTollFree}},
+		{"+1 876 555 0100", "", PhoneParts{"1", "8765550100", "JM", PhoneFixedLineOrMobile}},
+		{"(658) 555-0100", "US", PhoneParts{"1", "6585550100", "JM", PhoneFixedLineOrMobile}},
+		{"+1 242 555 0100", "", PhoneParts{"1", "2425550100", "BS", PhoneFixedLineOrMobile}},
+		{"+1 809 555 0100", "", PhoneParts{"1", "8095550100", "DO", PhoneFixedLineOrMobile}},
+		{"+1 787 555 0100", "", PhoneParts{"1", "7875550100", "PR", PhoneFixedLineOrMobile}},
+		{"+7 495 123 4567", "", PhoneParts{"7", "4951234567", "RU", PhoneFixedLine}},
+		{"+7 701 123 4567", "", PhoneParts{"7", "7011234567", "KZ", PhoneMobile}},
+		{"+39 3123456789", "", PhoneParts{"39", "3123456789", "IT", PhoneMobile}},
+		{"+32 470 12 34 56", "", PhoneParts{"32", "470123456", "BE", PhoneMobile}},
+		{"+45 80 12 34 56", "", PhoneParts{"45", "80123456", "DK", PhoneTollFree}},
+		{"02-123-4567", "KR", PhoneParts{"82", "21234567", "KR", PhoneFixedLine}},
+		{"+372 5123 4567", "", PhoneParts{"372", "51234567", "EE", PhoneUnknown}},
+	}
+	for _, test := range tests {
+		actual, err := ParsePhone(test.param, test.region)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParsePhone(%q, %q) to be %+v, got %+v, %v", test.param, test.region, test.expected, actual, err)
+		}
+	}
+}
+
+func TestNormalizePhoneE164(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		region   string
+		expected string
+	}{
+		{"020 7946 0958", "GB", "+442079460958"},
+		{"(415) 555-0100", "US", "+14155550100"},
+		{"0049 (0)30 901820", "DE", ""},
+		{"0049 30 901820", "DE", "+4930901820"},
+		{"foo", "DE", ""},
+	}
+	for _, test := range tests {
+		actual, err := NormalizePhoneE164(test.param, test.region)
+		if actual != test.expected || (err == nil) != (test.expected != "") {
+			t.Errorf("Expected NormalizePhoneE164(%q, %q) to be %q, got %q, %v", test.param, test.region, test.expected, actual, err)
+		}
+	}
+}
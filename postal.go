@@ -0,0 +1,116 @@
+package is
+
+import "strings"
+
+// postalCodeChecks hold rules which can not be expressed by postalCodeMasks.
+// They get upper-cased codes without spaces which already matched a mask.
+var postalCodeChecks = map[string]func(code string) bool{
+	"CA": canadianPostalCode,
+	"ES": func(code string) bool { return "01" <= code[:2] && code[:2] <= "52" },
+	"GB": britishPostalCode,
+	"GG": britishPostalCode,
+	"IM": britishPostalCode,
+	"JE": britishPostalCode,
+	"NL": func(code string) bool {
+		return code[0] != '0' && code[4:] != "SA" && code[4:] != "SD" && code[4:] != "SS"
+	},
+	"US": func(code string) bool { return code[:5] != "00000" },
+}
+
+// PostalCode check if the string is a postal code of the country given by its ISO 3166-1 alpha-2 code,
+// e.g. "SW1A 1AA" for "GB", "K1A 0B1" for "CA", "12345-6789" for "US" or "1234 AB" for "NL".
+// Letters are case-insensitive and spaces between parts of a code may be omitted.
+// For countries which do not use postal codes only empty code is accepted,
+// see CountryHasPostalCodes. Unknown countries are rejected.
+func PostalCode(countryAlpha2, code string) bool {
+	masks, ok := postalCodeMasks[countryAlpha2]
+	if !ok {
+		return false
+	}
+
+	if masks == nil {
+		return code == ""
+	}
+
+	code = strings.ToUpper(code)
+	for _, mask := range masks {
+		if postalCodeMatch(code, mask) {
+			check := postalCodeChecks[countryAlpha2]
+			return check == nil || check(strings.Replace(code, " ", "", -1))
+		}
+	}
+
+	return false
+}
+
+// CountryHasPostalCodes reports whether the country given by its ISO 3166-1 alpha-2 code uses postal codes.
+func CountryHasPostalCodes(countryAlpha2 string) bool {
+	return postalCodeMasks[countryAlpha2] != nil
+}
+
+// postalCodeMatch checks the code against the mask described in postalCodeMasks.
+func postalCodeMatch(code, mask string) bool {
+	i := 0
+	for j := 0; j < len(mask); j++ {
+		if mask[j] == ' ' {
+			if i < len(code) && code[i] == ' ' {
+				i++
+			}
+			continue
+		}
+
+		if i == len(code) {
+			return false
+		}
+
+		c := code[i]
+		digit := '0' <= c && c <= '9'
+		letter := 'A' <= c && c <= 'Z'
+		switch mask[j] {
+		case '#':
+			if !digit {
+				return false
+			}
+		case '@':
+			if !letter {
+				return false
+			}
+		case '*':
+			if !digit && !letter {
+				return false
+			}
+		default:
+			if c != mask[j] {
+				return false
+			}
+		}
+		i++
+	}
+
+	return i == len(code)
+}
+
+// canadianPostalCode checks letters of "A1A1A1" code: D, F, I, O, Q and U are never used,
+// W and Z do not start codes.
+func canadianPostalCode(code string) bool {
+	if code[0] == 'W' || code[0] == 'Z' {
+		return false
+	}
+
+	return !strings.ContainsAny(code, "DFIOQU")
+}
+
+// britishPostalCode checks letters of the outward code: Q, V and X are never the first
+// and I, J and Z never the second character. C, I, K, M, O and V are never used in unit
+// letters of the inward code (the last three characters).
+func britishPostalCode(code string) bool {
+	if code == "GIR0AA" {
+		return true
+	}
+
+	if strings.IndexByte("QVX", code[0]) >= 0 || strings.IndexByte("IJZ", code[1]) >= 0 {
+		return false
+	}
+
+	return !strings.ContainsAny(code[len(code)-2:], "CIKMOV")
+}
@@ -0,0 +1,259 @@
+package is
+
+// postalCodeMasks maps ISO 3166-1 alpha-2 codes to accepted postal code formats.
+// In masks "#" stands for a digit, "@" for a letter and "*" for a letter or a digit,
+// a space is optional and any other character must be present as is.
+// Countries which do not use postal codes map to nil.
+// Based on Universal Postal Union addressing data:
+// https://www.upu.int/en/Postal-Solutions/Programmes-Services/Addressing-Solutions
+var postalCodeMasks = map[string][]string{
+	"AD": {"AD###"},
+	"AE": nil,
+	"AF": {"####"},
+	"AG": nil,
+	"AI": {"AI-2640"},
+	"AL": {"####"},
+	"AM": {"####"},
+	"AO": nil,
+	"AQ": {"BIQQ 1ZZ"},
+	"AR": {"####", "@####@@@"},
+	"AS": {"96799", "96799-####"},
+	"AT": {"####"},
+	"AU": {"####"},
+	"AW": nil,
+	"AX": {"22###", "AX-22###"},
+	"AZ": {"AZ ####", "AZ-####"},
+	"BA": {"#####"},
+	"BB": {"BB#####"},
+	"BD": {"####"},
+	"BE": {"####"},
+	"BF": nil,
+	"BG": {"####"},
+	"BH": {"###", "####"},
+	"BI": nil,
+	"BJ": nil,
+	"BL": {"97133"},
+	"BM": {"@@ ##", "@@ @@"},
+	"BN": {"@@####"},
+	"BO": nil,
+	"BQ": nil,
+	"BR": {"#####-###", "########"},
+	"BS": nil,
+	"BT": {"#####"},
+	"BV": nil,
+	"BW": nil,
+	"BY": {"######"},
+	"BZ": nil,
+	"CA": {"@#@ #@#"},
+	"CC": {"6799"},
+	"CD": nil,
+	"CF": nil,
+	"CG": nil,
+	"CH": {"####"},
+	"CI": nil,
+	"CK": nil,
+	"CL": {"#######", "###-####"},
+	"CM": nil,
+	"CN": {"######"},
+	"CO": {"######"},
+	"CR": {"#####", "#####-####"},
+	"CU": {"#####"},
+	"CV": {"####"},
+	"CW": nil,
+	"CX": {"6798"},
+	"CY": {"####"},
+	"CZ": {"### ##"},
+	"DE": {"#####"},
+	"DJ": nil,
+	"DK": {"####"},
+	"DM": nil,
+	"DO": {"#####"},
+	"DZ": {"#####"},
+	"EC": {"######"},
+	"EE": {"#####"},
+	"EG": {"#####"},
+	"EH": nil,
+	"ER": nil,
+	"ES": {"#####"},
+	"ET": {"####"},
+	"FI": {"#####"},
+	"FJ": nil,
+	"FK": {"FIQQ 1ZZ"},
+	"FM": {"969##", "969##-####"},
+	"FO": {"###", "FO-###"},
+	"FR": {"#####"},
+	"GA": nil,
+	"GB": {"GIR 0AA", "@# #@@", "@## #@@", "@#@ #@@", "@@# #@@", "@@## #@@", "@@#@ #@@"},
+	"GD": nil,
+	"GE": {"####"},
+	"GF": {"973##"},
+	"GG": {"GY# #@@", "GY## #@@"},
+	"GH": nil,
+	"GI": {"GX11 1AA"},
+	"GL": {"39##"},
+	"GM": nil,
+	"GN": {"###"},
+	"GP": {"971##"},
+	"GQ": nil,
+	"GR": {"### ##"},
+	"GS": {"SIQQ 1ZZ"},
+	"GT": {"#####"},
+	"GU": {"969##", "969##-####"},
+	"GW": {"####"},
+	"GY": nil,
+	"HK": nil,
+	"HM": {"7151"},
+	"HN": {"#####"},
+	"HR": {"#####"},
+	"HT": {"####", "HT####"},
+	"HU": {"####"},
+	"ID": {"#####"},
+	"IE": {"@## ****", "D6W ****"},
+	"IL": {"#######"},
+	"IM": {"IM# #@@", "IM## #@@"},
+	"IN": {"### ###"},
+	"IO": {"BBND 1ZZ"},
+	"IQ": {"#####"},
+	"IR": {"#####-#####", "##########"},
+	"IS": {"###"},
+	"IT": {"#####"},
+	"JE": {"JE# #@@", "JE## #@@"},
+	"JM": nil,
+	"JO": {"#####"},
+	"JP": {"###-####", "#######"},
+	"KE": {"#####"},
+	"KG": {"######"},
+	"KH": {"#####", "######"},
+	"KI": nil,
+	"KM": nil,
+	"KN": nil,
+	"KP": nil,
+	"KR": {"#####"},
+	"KW": {"#####"},
+	"KY": {"KY#-####"},
+	"KZ": {"######", "@##@#@#"},
+	"LA": {"#####"},
+	"LB": {"#####", "#### ####"},
+	"LC": {"LC## ###"},
+	"LI": {"94##"},
+	"LK": {"#####"},
+	"LR": {"####"},
+	"LS": {"###"},
+	"LT": {"#####", "LT-#####"},
+	"LU": {"####", "L-####"},
+	"LV": {"LV-####"},
+	"LY": nil,
+	"MA": {"#####"},
+	"MC": {"980##"},
+	"MD": {"MD####", "MD-####"},
+	"ME": {"#####"},
+	"MF": {"97150"},
+	"MG": {"###"},
+	"MH": {"969##", "969##-####"},
+	"MK": {"####"},
+	"ML": nil,
+	"MM": {"#####"},
+	"MN": {"#####"},
+	"MO": nil,
+	"MP": {"969##", "969##-####"},
+	"MQ": {"972##"},
+	"MR": nil,
+	"MS": {"MSR ####"},
+	"MT": {"@@@ ####"},
+	"MU": {"#####"},
+	"MV": {"#####"},
+	"MW": nil,
+	"MX": {"#####"},
+	"MY": {"#####"},
+	"MZ": {"####"},
+	"NA": {"#####"},
+	"NC": {"988##"},
+	"NE": {"####"},
+	"NF": {"2899"},
+	"NG": {"######"},
+	"NI": {"#####"},
+	"NL": {"#### @@"},
+	"NO": {"####"},
+	"NP": {"#####"},
+	"NR": nil,
+	"NU": nil,
+	"NZ": {"####"},
+	"OM": {"###"},
+	"PA": {"####"},
+	"PE": {"#####"},
+	"PF": {"987##"},
+	"PG": {"###"},
+	"PH": {"####"},
+	"PK": {"#####"},
+	"PL": {"##-###"},
+	"PM": {"97500"},
+	"PN": {"PCRN 1ZZ"},
+	"PR": {"00###", "00###-####"},
+	"PS": {"###"},
+	"PT": {"####-###"},
+	"PW": {"96940", "96940-####"},
+	"PY": {"####"},
+	"QA": nil,
+	"RE": {"974##"},
+	"RO": {"######"},
+	"RS": {"#####"},
+	"RU": {"######"},
+	"RW": nil,
+	"SA": {"#####", "#####-####"},
+	"SB": nil,
+	"SC": nil,
+	"SD": {"#####"},
+	"SE": {"### ##"},
+	"SG": {"######"},
+	"SH": {"STHL 1ZZ", "ASCN 1ZZ", "TDCU 1ZZ"},
+	"SI": {"####", "SI-####"},
+	"SJ": {"####"},
+	"SK": {"### ##"},
+	"SL": nil,
+	"SM": {"4789#"},
+	"SN": {"#####"},
+	"SO": {"@@ #####"},
+	"SR": nil,
+	"SS": nil,
+	"ST": nil,
+	"SV": {"####"},
+	"SX": nil,
+	"SY": nil,
+	"SZ": {"@###"},
+	"TC": {"TKCA 1ZZ"},
+	"TD": nil,
+	"TF": nil,
+	"TG": nil,
+	"TH": {"#####"},
+	"TJ": {"######"},
+	"TK": nil,
+	"TL": nil,
+	"TM": {"######"},
+	"TN": {"####"},
+	"TO": nil,
+	"TR": {"#####"},
+	"TT": {"######"},
+	"TV": nil,
+	"TW": {"###", "###-##", "###-###", "#####", "######"},
+	"TZ": {"#####"},
+	"UA": {"#####"},
+	"UG": nil,
+	"UM": {"96898"},
+	"US": {"#####", "#####-####"},
+	"UY": {"#####"},
+	"UZ": {"######"},
+	"VA": {"00120"},
+	"VC": {"VC####"},
+	"VE": {"####", "####-@"},
+	"VG": {"VG11##"},
+	"VI": {"008##", "008##-####"},
+	"VN": {"#####", "######"},
+	"VU": nil,
+	"WF": {"986##"},
+	"WS": nil,
+	"YE": nil,
+	"YT": {"976##"},
+	"ZA": {"####"},
+	"ZM": {"#####"},
+	"ZW": nil,
+}
@@ -0,0 +1,87 @@
+package is
+
+import "testing"
+
+func TestPostalCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		country  string
+		param    string
+		expected bool
+	}{
+		{"GB", "SW1A 1AA", true},
+		{"GB", "sw1a1aa", true},
+		{"GB", "M1 1AE", true},
+		{"GB", "B33 8TH", true},
+		{"GB", "CR2 6XH", true},
+		{"GB", "DN55 1PT", true},
+		{"GB", "GIR 0AA", true},
+		{"GB", "SW1A 1AC", false},
+		{"GB", "SW1A  1AA", false},
+		{"GB", "SW1A1", false},
+		{"GB", "1AA 1AA", false},
+		{"GB", "QQ1 1AA", false},
+		{"GB", "VA1 1AA", false},
+		{"GB", "AZ1 1AA", false},
+		{"GB", "EJ1 1AA", false},
+		{"GG", "GY1 1AA", true},
+		{"GG", "JE1 1AA", false},
+		{"CA", "K1A 0B1", true},
+		{"CA", "k1a0b1", true},
+		{"CA", "W1A 0B1", false},
+		{"CA", "K1A 0D1", false},
+		{"CA", "K1A-0B1", false},
+		{"US", "12345", true},
+		{"US", "12345-6789", true},
+		{"US", "00000", false},
+		{"US", "1234", false},
+		{"US", "123456789", false},
+		{"NL", "1234 AB", true},
+		{"NL", "1234AB", true},
+		{"NL", "0123 AB", false},
+		{"NL", "1234 SS", false},
+		{"BR", "01310-200", true},
+		{"BR", "01310200", true},
+		{"BR", "01310 200", false},
+		{"ES", "28013", true},
+		{"ES", "53013", false},
+		{"DE", "10115", true},
+		{"DE", "1011", false},
+		{"JP", "100-0001", true},
+		{"PL", "00-950", true},
+		{"PL", "00950", false},
+		{"IE", "D02 X285", true},
+		{"IE", "D6W 1234", true},
+		{"AQ", "BIQQ 1ZZ", true},
+		{"AI", "AI-2640", true},
+		{"AE", "", true},
+		{"AE", "12345", false},
+		{"XX", "", false},
+		{"XX", "12345", false},
+		{"gb", "SW1A 1AA", false},
+	}
+	for _, test := range tests {
+		actual := PostalCode(test.country, test.param)
+		if actual != test.expected {
+			t.Errorf("Expected PostalCode(%q, %q) to be %v, got %v", test.country, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestPostalCodeCoverage(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range ISO3166List {
+		if _, ok := postalCodeMasks[c.Alpha2Code]; !ok {
+			t.Errorf("Expected postal code rules for %s", c.Alpha2Code)
+		}
+	}
+	if len(postalCodeMasks) != len(ISO3166List) {
+		t.Errorf("Expected postal code rules for %d countries, got %d", len(ISO3166List), len(postalCodeMasks))
+	}
+
+	if !CountryHasPostalCodes("GB") || CountryHasPostalCodes("HK") || CountryHasPostalCodes("XX") {
+		t.Errorf("Expected only GB to have postal codes")
+	}
+}
@@ -0,0 +1,167 @@
+package is
+
+// Status is the assignment status of an ISO 3166-1 code.
+type Status int
+
+// Statuses of ISO 3166-1 codes returned by CountryCodeStatus
+const (
+	// StatusUnassigned is a code which is free for future assignment.
+	StatusUnassigned Status = iota
+	// StatusOfficiallyAssigned is a code of a country listed in ISO3166List.
+	StatusOfficiallyAssigned
+	// StatusUserAssigned is a code which ISO will never assign and users may use freely, e.g. "XK" for Kosovo.
+	StatusUserAssigned
+	// StatusExceptionallyReserved is a code reserved on request of a national body or organization, e.g. "UK" or "EU".
+	StatusExceptionallyReserved
+	// StatusTransitionallyReserved is a code of a deleted country reserved for a transitional period, e.g. "YU".
+	StatusTransitionallyReserved
+	// StatusIndeterminatelyReserved is a code used in other coding systems, e.g. vehicle registration plates.
+	StatusIndeterminatelyReserved
+	// StatusFormerlyUsed is a code of a deleted country which is no longer reserved.
+	StatusFormerlyUsed
+)
+
+// ISO31663Entry stores a country code deleted from ISO 3166-1.
+type ISO31663Entry struct {
+	EnglishShortName string
+	Alpha2Code       string
+	Alpha3Code       string
+	// Withdrawn is the year the code was deleted.
+	Withdrawn int
+	// Successors are two-letter codes of countries which took over the territory.
+	Successors []string
+}
+
+// ISO31663List based on ISO 3166-3 "Code for formerly used names of countries".
+var ISO31663List = []ISO31663Entry{
+	{"British Antarctic Territory", "BQ", "ATB", 1979, []string{"AQ"}},
+	{"Burma", "BU", "BUR", 1989, []string{"MM"}},
+	{"Byelorussian SSR", "BY", "BYS", 1992, []string{"BY"}},
+	{"Canton and Enderbury Islands", "CT", "CTE", 1984, []string{"KI"}},
+	{"Czechoslovakia", "CS", "CSK", 1993, []string{"CZ", "SK"}},
+	{"Dahomey", "DY", "DHY", 1977, []string{"BJ"}},
+	{"Dronning Maud Land", "NQ", "ATN", 1983, []string{"AQ"}},
+	{"East Timor", "TP", "TMP", 2002, []string{"TL"}},
+	{"France, Metropolitan", "FX", "FXX", 1997, []string{"FR"}},
+	{"French Afars and Issas", "AI", "AFI", 1977, []string{"DJ"}},
+	{"French Southern and Antarctic Territories", "FQ", "ATF", 1979, []string{"AQ", "TF"}},
+	{"German Democratic Republic", "DD", "DDR", 1990, []string{"DE"}},
+	{"Gilbert and Ellice Islands", "GE", "GEL", 1979, []string{"KI", "TV"}},
+	{"Johnston Island", "JT", "JTN", 1986, []string{"UM"}},
+	{"Midway Islands", "MI", "MID", 1986, []string{"UM"}},
+	{"Netherlands Antilles", "AN", "ANT", 2010, []string{"BQ", "CW", "SX"}},
+	{"Neutral Zone", "NT", "NTZ", 1993, []string{"IQ", "SA"}},
+	{"New Hebrides", "NH", "NHB", 1980, []string{"VU"}},
+	{"Pacific Islands (Trust Territory)", "PC", "PCI", 1986, []string{"FM", "MH", "MP", "PW"}},
+	{"Panama Canal Zone", "PZ", "PCZ", 1980, []string{"PA"}},
+	{"Serbia and Montenegro", "CS", "SCG", 2006, []string{"RS", "ME"}},
+	{"Sikkim", "SK", "SKM", 1975, []string{"IN"}},
+	{"Southern Rhodesia", "RH", "RHO", 1980, []string{"ZW"}},
+	{"United States Miscellaneous Pacific Islands", "PU", "PUS", 1986, []string{"UM"}},
+	{"Upper Volta", "HV", "HVO", 1984, []string{"BF"}},
+	{"USSR", "SU", "SUN", 1992, []string{"AM", "AZ", "BY", "EE", "GE", "KG", "KZ", "LT", "LV", "MD", "RU", "TJ", "TM", "UA", "UZ"}},
+	{"Viet-Nam, Democratic Republic of", "VD", "VDR", 1977, []string{"VN"}},
+	{"Wake Island", "WK", "WAK", 1986, []string{"UM"}},
+	{"Yemen, Democratic", "YD", "YMD", 1990, []string{"YE"}},
+	{"Yugoslavia", "YU", "YUG", 2003, []string{"CS"}},
+	{"Zaire", "ZR", "ZAR", 1997, []string{"CD"}},
+}
+
+// Reserved ISO 3166-1 codes, see: https://www.iso.org/glossary-for-iso-3166.html
+var (
+	iso3166Exceptional = map[string]bool{
+		"AC": true, "CP": true, "CQ": true, "DG": true, "EA": true, "EU": true, "EZ": true,
+		"FX": true, "IC": true, "SU": true, "TA": true, "UK": true, "UN": true,
+		"ASC": true, "CPT": true, "DGA": true, "FXX": true, "SUN": true, "TAA": true,
+	}
+	iso3166Transitional = map[string]bool{
+		"AN": true, "BU": true, "CS": true, "NT": true, "SF": true, "TP": true, "YU": true, "ZR": true,
+		"ANT": true, "BUR": true, "BYS": true, "CSK": true, "NTZ": true, "ROM": true, "SCG": true,
+		"TMP": true, "YUG": true, "ZAR": true,
+	}
+	iso3166Indeterminate = map[string]bool{
+		"DY": true, "EW": true, "FL": true, "JA": true, "LF": true, "PI": true, "RA": true,
+		"RB": true, "RC": true, "RH": true, "RI": true, "RL": true, "RM": true, "RN": true,
+		"RP": true, "WG": true, "WL": true, "WV": true, "YV": true,
+	}
+)
+
+// CountryCodeStatus returns the assignment status of two or three-letter ISO 3166-1 code.
+// Codes which are not made of two or three upper case letters are StatusUnassigned.
+func CountryCodeStatus(code string) Status {
+	if (len(code) != 2 && len(code) != 3) || !UpperCase(code) || !Alpha(code) {
+		return StatusUnassigned
+	}
+
+	switch {
+	case len(code) == 2 && ISO3166Alpha2(code), len(code) == 3 && ISO3166Alpha3(code):
+		return StatusOfficiallyAssigned
+	case userAssignedCountryCode(code):
+		return StatusUserAssigned
+	case iso3166Exceptional[code]:
+		return StatusExceptionallyReserved
+	case iso3166Transitional[code]:
+		return StatusTransitionallyReserved
+	case iso3166Indeterminate[code]:
+		return StatusIndeterminatelyReserved
+	case len(DeletedCountryCodes(code)) > 0:
+		return StatusFormerlyUsed
+	}
+
+	return StatusUnassigned
+}
+
+// userAssignedCountryCode checks if the code belongs to user-assigned ranges:
+// AA, QM-QZ, XA-XZ and ZZ for alpha-2 codes,
+// AAA-AAZ, QMA-QZZ, XAA-XZZ and ZZA-ZZZ for alpha-3 codes.
+func userAssignedCountryCode(code string) bool {
+	switch code[0] {
+	case 'A':
+		return code[1] == 'A'
+	case 'Q':
+		return code[1] >= 'M'
+	case 'X':
+		return true
+	case 'Z':
+		return code[1] == 'Z'
+	}
+
+	return false
+}
+
+// DeletedCountryCodes returns ISO31663List entries of countries which used the two or three-letter code.
+// The result is sorted by withdrawal year. A code may have been deleted more than once,
+// e.g. "CS" stood for Czechoslovakia and later for Serbia and Montenegro.
+// Like CountryCodeStatus, it only matches upper case codes.
+func DeletedCountryCodes(code string) []ISO31663Entry {
+	var res []ISO31663Entry
+	for _, e := range ISO31663List {
+		if e.Alpha2Code == code || e.Alpha3Code == code {
+			res = append(res, e)
+		}
+	}
+
+	for i := 1; i < len(res); i++ {
+		for j := i; j > 0 && res[j].Withdrawn < res[j-1].Withdrawn; j-- {
+			res[j], res[j-1] = res[j-1], res[j]
+		}
+	}
+
+	return res
+}
+
+// acceptCountryCode checks code status against the additionally accepted statuses.
+func acceptCountryCode(code string, accept []Status) bool {
+	if len(accept) == 0 {
+		return false
+	}
+
+	status := CountryCodeStatus(code)
+	for _, s := range accept {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
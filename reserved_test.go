@@ -0,0 +1,111 @@
+package is
+
+import "testing"
+
+func TestCountryCodeStatus(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected Status
+	}{
+		{"", StatusUnassigned},
+		{"de", StatusUnassigned},
+		{"D1", StatusUnassigned},
+		{"DEUT", StatusUnassigned},
+		{"DE", StatusOfficiallyAssigned},
+		{"DEU", StatusOfficiallyAssigned},
+		{"XK", StatusUserAssigned},
+		{"AA", StatusUserAssigned},
+		{"QM", StatusUserAssigned},
+		{"QL", StatusUnassigned},
+		{"ZZ", StatusUserAssigned},
+		{"XKX", StatusUserAssigned},
+		{"UK", StatusExceptionallyReserved},
+		{"EU", StatusExceptionallyReserved},
+		{"UN", StatusExceptionallyReserved},
+		{"AN", StatusTransitionallyReserved},
+		{"YU", StatusTransitionallyReserved},
+		{"CS", StatusTransitionallyReserved},
+		{"YUG", StatusTransitionallyReserved},
+		{"RH", StatusIndeterminatelyReserved},
+		{"DD", StatusFormerlyUsed},
+		{"DDR", StatusFormerlyUsed},
+		{"BY", StatusOfficiallyAssigned},
+	}
+	for _, test := range tests {
+		actual := CountryCodeStatus(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected CountryCodeStatus(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestISO3166Accept(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		accept   []Status
+		expected bool
+	}{
+		{"XK", nil, false},
+		{"XK", []Status{StatusUserAssigned}, true},
+		{"XKX", []Status{StatusUserAssigned}, false},
+		{"UK", []Status{StatusUserAssigned}, false},
+		{"UK", []Status{StatusUserAssigned, StatusExceptionallyReserved}, true},
+		{"YU", []Status{StatusTransitionallyReserved}, true},
+		{"DE", []Status{StatusUserAssigned}, true},
+	}
+	for _, test := range tests {
+		actual := ISO3166Alpha2Status(test.param, test.accept...)
+		if actual != test.expected {
+			t.Errorf("Expected ISO3166Alpha2Status(%q, %v) to be %v, got %v", test.param, test.accept, test.expected, actual)
+		}
+	}
+
+	if !ISO3166Alpha3Status("YUG", StatusTransitionallyReserved) || ISO3166Alpha3("YUG") || ISO3166Alpha3Status("YU", StatusTransitionallyReserved) {
+		t.Errorf("Expected ISO3166Alpha3Status to accept YUG only as transitionally reserved code")
+	}
+}
+
+func TestDeletedCountryCodes(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected []string
+	}{
+		{"", nil},
+		{"DE", nil},
+		{"DD", []string{"DE"}},
+		{"DDR", []string{"DE"}},
+		{"ddr", nil},
+		{"AN", []string{"BQ", "CW", "SX"}},
+		{"CS", []string{"CZ", "SK", "RS", "ME"}},
+	}
+	for _, test := range tests {
+		var actual []string
+		for _, e := range DeletedCountryCodes(test.param) {
+			actual = append(actual, e.Successors...)
+		}
+		if len(actual) != len(test.expected) {
+			t.Errorf("Expected DeletedCountryCodes(%q) successors to be %v, got %v", test.param, test.expected, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != test.expected[i] {
+				t.Errorf("Expected DeletedCountryCodes(%q) successors to be %v, got %v", test.param, test.expected, actual)
+				break
+			}
+		}
+	}
+
+	for _, e := range ISO31663List {
+		for _, s := range e.Successors {
+			if !ISO3166Alpha2(s) && s != "CS" {
+				t.Errorf("Expected successor %q of %q to be ISO 3166-1 alpha-2 code", s, e.Alpha2Code)
+			}
+		}
+	}
+}
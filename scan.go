@@ -0,0 +1,305 @@
+package is
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Kind is a kind of value searched for in free text.
+type Kind string
+
+// Kinds of values found by FindAll and Scanner
+const (
+	KindEmail      Kind = "email"
+	KindURL        Kind = "url"
+	KindIP         Kind = "ip"
+	KindCreditCard Kind = "creditcard"
+	KindSSN        Kind = "ssn"
+)
+
+// Match is a value found in free text.
+type Match struct {
+	Kind Kind
+	// Value is the matched text as is, including separators, e.g. "4111 1111 1111 1111".
+	Value string
+	// Start and End are byte offsets of the value, text[Start:End] == Value.
+	Start, End int
+}
+
+// finder locates candidates of a kind and confirms them with the validator.
+type finder struct {
+	kind Kind
+	rx   *regexp.Regexp
+	// boundary lists characters, besides letters and digits, which may not surround the value.
+	boundary string
+	// trim lists trailing characters which are likely punctuation rather than part of the value.
+	trim string
+	// shrink retries rejected candidates without their last separated group,
+	// e.g. a card number followed by an unrelated number.
+	shrink bool
+	valid  func(string) bool
+}
+
+var finders = []finder{
+	{
+		kind:     KindEmail,
+		rx:       regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`),
+		boundary: "._%+-@",
+		valid:    Email,
+	},
+	{
+		kind:  KindURL,
+		rx:    regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s<>"'` + "`" + `]+`),
+		trim:  `.,;:!?'")]}`,
+		valid: URL,
+	},
+	{
+		kind:     KindIP,
+		rx:       regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`),
+		boundary: ".",
+		valid:    IPv4,
+	},
+	// the form with embedded IPv4 address goes first, so it is preferred
+	{
+		kind:     KindIP,
+		rx:       regexp.MustCompile(`(?i)(?:[0-9a-f]{1,4})?(?::[0-9a-f]{0,4}){1,6}:\d{1,3}(?:\.\d{1,3}){3}|(?:[0-9a-f]{1,4})?(?::[0-9a-f]{0,4}){2,7}`),
+		boundary: ":.",
+		valid:    IPv6,
+	},
+	{
+		kind:     KindCreditCard,
+		rx:       regexp.MustCompile(`\b\d(?:[ \-]?\d){11,18}\b`),
+		boundary: "-",
+		shrink:   true,
+		valid:    scanCard,
+	},
+	{
+		kind:     KindSSN,
+		rx:       regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+		boundary: "-",
+		valid:    SSN,
+	},
+}
+
+// scanCard confirms a card number candidate: it must pass the Luhn check
+// and belong to a known brand, which rules out most random digit runs.
+func scanCard(s string) bool {
+	if !CreditCard(s) {
+		return false
+	}
+
+	_, ok := CardBrand(s)
+	return ok
+}
+
+// FindAll returns values of the given kinds found in the text, ordered by their offsets.
+// If no kinds are given, all kinds are searched for.
+// Candidates are located with patterns and confirmed with Email, URL, IP, CreditCard
+// (Luhn check and a known brand) and SSN (hyphenated form only), so digit runs
+// and dotted numbers rarely produce false positives.
+// Matches of different kinds may overlap, e.g. an IP address inside a URL.
+// Values never span line breaks.
+func FindAll(text string, kinds ...Kind) []Match {
+	var matches []Match
+	for i := range finders {
+		f := &finders[i]
+		if !scanKind(f.kind, kinds) {
+			continue
+		}
+
+		for _, loc := range f.rx.FindAllStringIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if f.trim != "" {
+				end = start + len(trimURL(text[start:end], f.trim))
+			}
+			if end = f.confirm(text, start, end); end < 0 {
+				continue
+			}
+			matches = append(matches, Match{Kind: f.kind, Value: text[start:end], Start: start, End: end})
+		}
+	}
+
+	sort.Stable(byPosition(matches))
+
+	// drop values within longer ones of the same kind, e.g. IPv4 part of an IPv6 address
+	n := 0
+	ends := make(map[Kind]int)
+	for _, m := range matches {
+		if end, ok := ends[m.Kind]; ok && end >= m.End {
+			continue
+		}
+		ends[m.Kind] = m.End
+		matches[n] = m
+		n++
+	}
+
+	return matches[:n]
+}
+
+// byPosition orders matches by their start, longer ones first.
+type byPosition []Match
+
+func (m byPosition) Len() int      { return len(m) }
+func (m byPosition) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m byPosition) Less(i, j int) bool {
+	if m[i].Start != m[j].Start {
+		return m[i].Start < m[j].Start
+	}
+	return m[i].End > m[j].End
+}
+
+// scanKind reports whether the kind is requested.
+func scanKind(kind Kind, kinds []Kind) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// confirm returns the end of the value confirmed by the validator at start,
+// or -1 if there is none.
+func (f *finder) confirm(text string, start, end int) int {
+	for {
+		if f.bounded(text, start, end) && f.valid(text[start:end]) {
+			return end
+		}
+
+		i := strings.LastIndexAny(text[start:end], " -")
+		if !f.shrink || i < 0 {
+			return -1
+		}
+		end = start + i
+	}
+}
+
+// bounded reports whether text[start:end] is not a part of a longer value:
+// it is surrounded by characters other than letters, digits and f.boundary.
+// A boundary character which is not followed by a letter or a digit
+// is punctuation, e.g. a full stop after an IP address.
+func (f *finder) bounded(text string, start, end int) bool {
+	if start > 0 && (scanAlphanumeric(text[start-1]) || strings.IndexByte(f.boundary, text[start-1]) >= 0) {
+		return false
+	}
+
+	if end == len(text) || !scanAlphanumeric(text[end]) && strings.IndexByte(f.boundary, text[end]) < 0 {
+		return true
+	}
+
+	return !scanAlphanumeric(text[end]) && (end+1 == len(text) || !scanAlphanumeric(text[end+1]))
+}
+
+// scanAlphanumeric reports whether c is an ASCII letter or digit.
+func scanAlphanumeric(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// trimURL strips trailing punctuation from the URL candidate.
+// Closing parenthesis is kept if the URL has an opening one, as in Wikipedia links.
+func trimURL(s, trim string) string {
+	for len(s) > 0 && strings.IndexByte(trim, s[len(s)-1]) >= 0 {
+		if s[len(s)-1] == ')' && strings.Count(s, "(") >= strings.Count(s, ")") {
+			break
+		}
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+// scannerMaxLine is the length after which lines are split by Scanner.
+const scannerMaxLine = 64 * 1024
+
+// Scanner finds values in a stream, line by line, without reading it into memory as a whole.
+// Lines longer than 64 KiB are split at the last space or tab, or at 64 KiB
+// if there is none, so values around such splits may be missed.
+//
+//	s := is.NewScanner(f, is.KindEmail, is.KindCreditCard)
+//	for s.Scan() {
+//		m := s.Match()
+//		...
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+type Scanner struct {
+	r     *bufio.Reader
+	kinds []Kind
+	// pending holds the rest of the line which did not fit into the buffer.
+	pending []byte
+	// offset is the byte offset of the next line in the stream.
+	offset  int
+	matches []Match
+	match   Match
+	err     error
+	done    bool
+}
+
+// NewScanner returns a Scanner searching the reader for values of the given kinds.
+// If no kinds are given, all kinds are searched for. See FindAll for details.
+func NewScanner(r io.Reader, kinds ...Kind) *Scanner {
+	return &Scanner{r: bufio.NewReaderSize(r, scannerMaxLine), kinds: kinds}
+}
+
+// Scan advances the Scanner to the next match, which will then be available through Match.
+// It returns false when the stream is exhausted or a read error occurs.
+func (s *Scanner) Scan() bool {
+	for len(s.matches) == 0 {
+		if s.done {
+			return false
+		}
+		s.scanLine()
+	}
+
+	s.match, s.matches = s.matches[0], s.matches[1:]
+	return true
+}
+
+// Match returns the most recent match found by Scan.
+// Offsets are relative to the start of the stream.
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first non-EOF error encountered while reading.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// scanLine reads the next line and finds matches in it.
+func (s *Scanner) scanLine() {
+	line, err := s.r.ReadSlice('\n')
+	line = append(s.pending, line...)
+	s.pending = nil
+
+	switch err {
+	case nil:
+	case bufio.ErrBufferFull:
+		// keep the tail after the last blank for the next chunk
+		if i := bytes.LastIndexAny(line, " \t"); i >= 0 {
+			s.pending = append([]byte(nil), line[i+1:]...)
+			line = line[:i+1]
+		}
+	case io.EOF:
+		s.done = true
+	default:
+		s.err, s.done = err, true
+	}
+
+	for _, m := range FindAll(string(line), s.kinds...) {
+		m.Start += s.offset
+		m.End += s.offset
+		s.matches = append(s.matches, m)
+	}
+	s.offset += len(line)
+}
@@ -0,0 +1,157 @@
+package is
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		text     string
+		kinds    []Kind
+		expected []Match
+	}{
+		{"", nil, nil},
+		{"nothing to see here", nil, nil},
+		{
+			"contact jane.doe@example.com or visit https://example.com/help.",
+			nil,
+			[]Match{
+				{KindEmail, "jane.doe@example.com", 8, 28},
+				{KindURL, "https://example.com/help", 38, 62},
+			},
+		},
+		{
+			"mail a@b.c and a@b.co.",
+			[]Kind{KindEmail},
+			[]Match{{KindEmail, "a@b.co", 15, 21}},
+		},
+		{
+			"see (https://en.wikipedia.org/wiki/Go_(programming_language))",
+			[]Kind{KindURL},
+			[]Match{{KindURL, "https://en.wikipedia.org/wiki/Go_(programming_language)", 5, 60}},
+		},
+		{
+			"from 192.0.2.1, 2001:db8::1 and ::ffff:192.0.2.7 at 12:30:45",
+			[]Kind{KindIP},
+			[]Match{
+				{KindIP, "192.0.2.1", 5, 14},
+				{KindIP, "2001:db8::1", 16, 27},
+				{KindIP, "::ffff:192.0.2.7", 32, 48},
+			},
+		},
+		{
+			"version 1.2.3.4.5 and 300.1.1.1 are not addresses, 10.0.0.1. is",
+			[]Kind{KindIP},
+			[]Match{{KindIP, "10.0.0.1", 51, 59}},
+		},
+		{
+			"card 4111 1111 1111 1111 exp 12/30, amex 3782-822463-10005",
+			nil,
+			[]Match{
+				{KindCreditCard, "4111 1111 1111 1111", 5, 24},
+				{KindCreditCard, "3782-822463-10005", 41, 58},
+			},
+		},
+		{
+			"paid with 4111111111111111 12 times",
+			nil,
+			[]Match{{KindCreditCard, "4111111111111111", 10, 26}},
+		},
+		{
+			"order 4111111111111112 and id 79927398713 and 41111111111111111111",
+			[]Kind{KindCreditCard},
+			nil,
+		},
+		{
+			"ssn 078-05-1120, not 000-12-3456 or 078051120",
+			nil,
+			[]Match{{KindSSN, "078-05-1120", 4, 15}},
+		},
+		{
+			"ping http://192.0.2.1/x",
+			nil,
+			[]Match{
+				{KindURL, "http://192.0.2.1/x", 5, 23},
+				{KindIP, "192.0.2.1", 12, 21},
+			},
+		},
+		{
+			"ping http://192.0.2.1/x",
+			[]Kind{KindSSN},
+			nil,
+		},
+	}
+	for _, test := range tests {
+		actual := FindAll(test.text, test.kinds...)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected FindAll(%q, %v) to be %v, got %v", test.text, test.kinds, test.expected, actual)
+		}
+		for _, m := range actual {
+			if test.text[m.Start:m.End] != m.Value {
+				t.Errorf("Expected %q at offsets %d:%d of %q, got %q", m.Value, m.Start, m.End, test.text, test.text[m.Start:m.End])
+			}
+		}
+	}
+}
+
+func TestScanner(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	var expected []Match
+	for i := 0; i < 2000; i++ {
+		b.WriteString("line without values\n")
+		if i%100 == 0 {
+			b.WriteString("user jane@example.com paid with ")
+			expected = append(expected,
+				Match{KindEmail, "jane@example.com", b.Len() - 27, b.Len() - 11},
+				Match{KindCreditCard, "4111 1111 1111 1111", b.Len(), b.Len() + 19},
+			)
+			b.WriteString("4111 1111 1111 1111\n")
+		}
+	}
+	// a line longer than the buffer is split at a blank
+	b.WriteString(strings.Repeat("x ", scannerMaxLine))
+	expected = append(expected, Match{KindSSN, "078-05-1120", b.Len(), b.Len() + 11})
+	b.WriteString("078-05-1120")
+
+	s := NewScanner(strings.NewReader(b.String()))
+	var actual []Match
+	for s.Scan() {
+		actual = append(actual, s.Match())
+	}
+	if s.Err() != nil {
+		t.Errorf("Expected Scanner to succeed, got %v", s.Err())
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected Scanner to find %d matches, got %d: %v", len(expected), len(actual), actual)
+	}
+
+	if !reflect.DeepEqual(FindAll(b.String()), expected) {
+		t.Error("Expected Scanner to find the same matches as FindAll")
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestScannerError(t *testing.T) {
+	t.Parallel()
+
+	s := NewScanner(errReader{})
+	if s.Scan() {
+		t.Error("Expected Scan to be false on read error")
+	}
+	if s.Err() == nil || s.Err().Error() != "read failed" {
+		t.Errorf("Expected Err to be the read error, got %v", s.Err())
+	}
+}
@@ -0,0 +1,5147 @@
+package is
+
+// Subdivision stores ISO 3166-2 country subdivision.
+type Subdivision struct {
+	// Code is the full subdivision code, e.g. "US-CA".
+	Code string
+	// Name is the subdivision name in the local language as listed by ISO.
+	Name string
+	// Category is the kind of subdivision, e.g. "state" or "province".
+	Category string
+	// Parent is the code of the enclosing subdivision, e.g. "FR-ARA" for "FR-01".
+	// It is empty for first-level subdivisions.
+	Parent string
+}
+
+// ISO31662List based on https://www.iso.org/obp/ui/#search/code/
+// It covers subdivisions of all levels as published in ISO 3166-2,
+// names are in the local language or its romanization.
+var ISO31662List = []Subdivision{
+	{"AD-02", "Canillo", "parish", ""},
+	{"AD-03", "Encamp", "parish", ""},
+	{"AD-04", "La Massana", "parish", ""},
+	{"AD-05", "Ordino", "parish", ""},
+	{"AD-06", "Sant Julià de Lòria", "parish", ""},
+	{"AD-07", "Andorra la Vella", "parish", ""},
+	{"AD-08", "Escaldes-Engordany", "parish", ""},
+	{"AE-AJ", "‘Ajmān", "emirate", ""},
+	{"AE-AZ", "Abū Z̧aby", "emirate", ""},
+	{"AE-DU", "Dubayy", "emirate", ""},
+	{"AE-FU", "Al Fujayrah", "emirate", ""},
+	{"AE-RK", "Ra’s al Khaymah", "emirate", ""},
+	{"AE-SH", "Ash Shāriqah", "emirate", ""},
+	{"AE-UQ", "Umm al Qaywayn", "emirate", ""},
+	{"AF-BAL", "Balkh", "province", ""},
+	{"AF-BAM", "Bāmyān", "province", ""},
+	{"AF-BDG", "Bādghīs", "province", ""},
+	{"AF-BDS", "Badakhshān", "province", ""},
+	{"AF-BGL", "Baghlān", "province", ""},
+	{"AF-DAY", "Dāykundī", "province", ""},
+	{"AF-FRA", "Farāh", "province", ""},
+	{"AF-FYB", "Fāryāb", "province", ""},
+	{"AF-GHA", "Ghaznī", "province", ""},
+	{"AF-GHO", "Ghōr", "province", ""},
+	{"AF-HEL", "Helmand", "province", ""},
+	{"AF-HER", "Herāt", "province", ""},
+	{"AF-JOW", "Jowzjān", "province", ""},
+	{"AF-KAB", "Kābul", "province", ""},
+	{"AF-KAN", "Kandahār", "province", ""},
+	{"AF-KAP", "Kāpīsā", "province", ""},
+	{"AF-KDZ", "Kunduz", "province", ""},
+	{"AF-KHO", "Khōst", "province", ""},
+	{"AF-KNR", "Kunaṟ", "province", ""},
+	{"AF-LAG", "Laghmān", "province", ""},
+	{"AF-LOG", "Lōgar", "province", ""},
+	{"AF-NAN", "Nangarhār", "province", ""},
+	{"AF-NIM", "Nīmrōz", "province", ""},
+	{"AF-NUR", "Nūristān", "province", ""},
+	{"AF-PAN", "Panjshayr", "province", ""},
+	{"AF-PAR", "Parwān", "province", ""},
+	{"AF-PIA", "Paktiyā", "province", ""},
+	{"AF-PKA", "Paktīkā", "province", ""},
+	{"AF-SAM", "Samangān", "province", ""},
+	{"AF-SAR", "Sar-e Pul", "province", ""},
+	{"AF-TAK", "Takhār", "province", ""},
+	{"AF-URU", "Uruzgān", "province", ""},
+	{"AF-WAR", "Wardak", "province", ""},
+	{"AF-ZAB", "Zābul", "province", ""},
+	{"AG-03", "Saint George", "parish", ""},
+	{"AG-04", "Saint John", "parish", ""},
+	{"AG-05", "Saint Mary", "parish", ""},
+	{"AG-06", "Saint Paul", "parish", ""},
+	{"AG-07", "Saint Peter", "parish", ""},
+	{"AG-08", "Saint Philip", "parish", ""},
+	{"AG-10", "Barbuda", "dependency", ""},
+	{"AG-11", "Redonda", "dependency", ""},
+	{"AL-01", "Berat", "county", ""},
+	{"AL-02", "Durrës", "county", ""},
+	{"AL-03", "Elbasan", "county", ""},
+	{"AL-04", "Fier", "county", ""},
+	{"AL-05", "Gjirokastër", "county", ""},
+	{"AL-06", "Korçë", "county", ""},
+	{"AL-07", "Kukës", "county", ""},
+	{"AL-08", "Lezhë", "county", ""},
+	{"AL-09", "Dibër", "county", ""},
+	{"AL-10", "Shkodër", "county", ""},
+	{"AL-11", "Tiranë", "county", ""},
+	{"AL-12", "Vlorë", "county", ""},
+	{"AM-AG", "Aragac̣otn", "region", ""},
+	{"AM-AR", "Ararat", "region", ""},
+	{"AM-AV", "Armavir", "region", ""},
+	{"AM-ER", "Erevan", "city", ""},
+	{"AM-GR", "Geġark'unik'", "region", ""},
+	{"AM-KT", "Kotayk'", "region", ""},
+	{"AM-LO", "Loṙi", "region", ""},
+	{"AM-SH", "Širak", "region", ""},
+	{"AM-SU", "Syunik'", "region", ""},
+	{"AM-TV", "Tavuš", "region", ""},
+	{"AM-VD", "Vayoć Jor", "region", ""},
+	{"AO-BGO", "Bengo", "province", ""},
+	{"AO-BGU", "Benguela", "province", ""},
+	{"AO-BIE", "Bié", "province", ""},
+	{"AO-CAB", "Cabinda", "province", ""},
+	{"AO-CCU", "Cuando Cubango", "province", ""},
+	{"AO-CNN", "Cunene", "province", ""},
+	{"AO-CNO", "Cuanza-Norte", "province", ""},
+	{"AO-CUS", "Cuanza-Sul", "province", ""},
+	{"AO-HUA", "Huambo", "province", ""},
+	{"AO-HUI", "Huíla", "province", ""},
+	{"AO-LNO", "Lunda-Norte", "province", ""},
+	{"AO-LSU", "Lunda-Sul", "province", ""},
+	{"AO-LUA", "Luanda", "province", ""},
+	{"AO-MAL", "Malange", "province", ""},
+	{"AO-MOX", "Moxico", "province", ""},
+	{"AO-NAM", "Namibe", "province", ""},
+	{"AO-UIG", "Uíge", "province", ""},
+	{"AO-ZAI", "Zaire", "province", ""},
+	{"AR-A", "Salta", "province", ""},
+	{"AR-B", "Buenos Aires", "province", ""},
+	{"AR-C", "Ciudad Autónoma de Buenos Aires", "city", ""},
+	{"AR-D", "San Luis", "province", ""},
+	{"AR-E", "Entre Ríos", "province", ""},
+	{"AR-F", "La Rioja", "province", ""},
+	{"AR-G", "Santiago del Estero", "province", ""},
+	{"AR-H", "Chaco", "province", ""},
+	{"AR-J", "San Juan", "province", ""},
+	{"AR-K", "Catamarca", "province", ""},
+	{"AR-L", "La Pampa", "province", ""},
+	{"AR-M", "Mendoza", "province", ""},
+	{"AR-N", "Misiones", "province", ""},
+	{"AR-P", "Formosa", "province", ""},
+	{"AR-Q", "Neuquén", "province", ""},
+	{"AR-R", "Río Negro", "province", ""},
+	{"AR-S", "Santa Fe", "province", ""},
+	{"AR-T", "Tucumán", "province", ""},
+	{"AR-U", "Chubut", "province", ""},
+	{"AR-V", "Tierra del Fuego", "province", ""},
+	{"AR-W", "Corrientes", "province", ""},
+	{"AR-X", "Córdoba", "province", ""},
+	{"AR-Y", "Jujuy", "province", ""},
+	{"AR-Z", "Santa Cruz", "province", ""},
+	{"AT-1", "Burgenland", "state", ""},
+	{"AT-2", "Kärnten", "state", ""},
+	{"AT-3", "Niederösterreich", "state", ""},
+	{"AT-4", "Oberösterreich", "state", ""},
+	{"AT-5", "Salzburg", "state", ""},
+	{"AT-6", "Steiermark", "state", ""},
+	{"AT-7", "Tirol", "state", ""},
+	{"AT-8", "Vorarlberg", "state", ""},
+	{"AT-9", "Wien", "state", ""},
+	{"AU-ACT", "Australian Capital Territory", "territory", ""},
+	{"AU-NSW", "New South Wales", "state", ""},
+	{"AU-NT", "Northern Territory", "territory", ""},
+	{"AU-QLD", "Queensland", "state", ""},
+	{"AU-SA", "South Australia", "state", ""},
+	{"AU-TAS", "Tasmania", "state", ""},
+	{"AU-VIC", "Victoria", "state", ""},
+	{"AU-WA", "Western Australia", "state", ""},
+	{"AZ-ABS", "Abşeron", "rayon", ""},
+	{"AZ-AGA", "Ağstafa", "rayon", ""},
+	{"AZ-AGC", "Ağcabədi", "rayon", ""},
+	{"AZ-AGM", "Ağdam", "rayon", ""},
+	{"AZ-AGS", "Ağdaş", "rayon", ""},
+	{"AZ-AGU", "Ağsu", "rayon", ""},
+	{"AZ-AST", "Astara", "rayon", ""},
+	{"AZ-BA", "Bakı", "municipality", ""},
+	{"AZ-BAB", "Babək", "rayon", "AZ-NX"},
+	{"AZ-BAL", "Balakən", "rayon", ""},
+	{"AZ-BAR", "Bərdə", "rayon", ""},
+	{"AZ-BEY", "Beyləqan", "rayon", ""},
+	{"AZ-BIL", "Biləsuvar", "rayon", ""},
+	{"AZ-CAB", "Cəbrayıl", "rayon", ""},
+	{"AZ-CAL", "Cəlilabad", "rayon", ""},
+	{"AZ-CUL", "Culfa", "rayon", "AZ-NX"},
+	{"AZ-DAS", "Daşkəsən", "rayon", ""},
+	{"AZ-FUZ", "Füzuli", "rayon", ""},
+	{"AZ-GA", "Gəncə", "municipality", ""},
+	{"AZ-GAD", "Gədəbəy", "rayon", ""},
+	{"AZ-GOR", "Goranboy", "rayon", ""},
+	{"AZ-GOY", "Göyçay", "rayon", ""},
+	{"AZ-GYG", "Göygöl", "rayon", ""},
+	{"AZ-HAC", "Hacıqabul", "rayon", ""},
+	{"AZ-IMI", "İmişli", "rayon", ""},
+	{"AZ-ISM", "İsmayıllı", "rayon", ""},
+	{"AZ-KAL", "Kəlbəcər", "rayon", ""},
+	{"AZ-KAN", "Kǝngǝrli", "rayon", "AZ-NX"},
+	{"AZ-KUR", "Kürdəmir", "rayon", ""},
+	{"AZ-LA", "Lənkəran", "municipality", ""},
+	{"AZ-LAC", "Laçın", "rayon", ""},
+	{"AZ-LAN", "Lənkəran", "rayon", ""},
+	{"AZ-LER", "Lerik", "rayon", ""},
+	{"AZ-MAS", "Masallı", "rayon", ""},
+	{"AZ-MI", "Mingəçevir", "municipality", ""},
+	{"AZ-NA", "Naftalan", "municipality", ""},
+	{"AZ-NEF", "Neftçala", "rayon", ""},
+	{"AZ-NV", "Naxçıvan", "municipality", "AZ-NX"},
+	{"AZ-NX", "Naxçıvan", "autonomous republic", ""},
+	{"AZ-OGU", "Oğuz", "rayon", ""},
+	{"AZ-ORD", "Ordubad", "rayon", "AZ-NX"},
+	{"AZ-QAB", "Qəbələ", "rayon", ""},
+	{"AZ-QAX", "Qax", "rayon", ""},
+	{"AZ-QAZ", "Qazax", "rayon", ""},
+	{"AZ-QBA", "Quba", "rayon", ""},
+	{"AZ-QBI", "Qubadlı", "rayon", ""},
+	{"AZ-QOB", "Qobustan", "rayon", ""},
+	{"AZ-QUS", "Qusar", "rayon", ""},
+	{"AZ-SA", "Şəki", "municipality", ""},
+	{"AZ-SAB", "Sabirabad", "rayon", ""},
+	{"AZ-SAD", "Sədərək", "rayon", "AZ-NX"},
+	{"AZ-SAH", "Şahbuz", "rayon", "AZ-NX"},
+	{"AZ-SAK", "Şəki", "rayon", ""},
+	{"AZ-SAL", "Salyan", "rayon", ""},
+	{"AZ-SAR", "Şərur", "rayon", "AZ-NX"},
+	{"AZ-SAT", "Saatlı", "rayon", ""},
+	{"AZ-SBN", "Şabran", "rayon", ""},
+	{"AZ-SIY", "Siyəzən", "rayon", ""},
+	{"AZ-SKR", "Şəmkir", "rayon", ""},
+	{"AZ-SM", "Sumqayıt", "municipality", ""},
+	{"AZ-SMI", "Şamaxı", "rayon", ""},
+	{"AZ-SMX", "Samux", "rayon", ""},
+	{"AZ-SR", "Şirvan", "municipality", ""},
+	{"AZ-SUS", "Şuşa", "rayon", ""},
+	{"AZ-TAR", "Tərtər", "rayon", ""},
+	{"AZ-TOV", "Tovuz", "rayon", ""},
+	{"AZ-UCA", "Ucar", "rayon", ""},
+	{"AZ-XA", "Xankəndi", "municipality", ""},
+	{"AZ-XAC", "Xaçmaz", "rayon", ""},
+	{"AZ-XCI", "Xocalı", "rayon", ""},
+	{"AZ-XIZ", "Xızı", "rayon", ""},
+	{"AZ-XVD", "Xocavənd", "rayon", ""},
+	{"AZ-YAR", "Yardımlı", "rayon", ""},
+	{"AZ-YE", "Yevlax", "municipality", ""},
+	{"AZ-YEV", "Yevlax", "rayon", ""},
+	{"AZ-ZAN", "Zəngilan", "rayon", ""},
+	{"AZ-ZAQ", "Zaqatala", "rayon", ""},
+	{"AZ-ZAR", "Zərdab", "rayon", ""},
+	{"BA-BIH", "Federacija Bosne i Hercegovine", "entity", ""},
+	{"BA-BRC", "Brčko distrikt", "district with special status", ""},
+	{"BA-SRP", "Republika Srpska", "entity", ""},
+	{"BB-01", "Christ Church", "parish", ""},
+	{"BB-02", "Saint Andrew", "parish", ""},
+	{"BB-03", "Saint George", "parish", ""},
+	{"BB-04", "Saint James", "parish", ""},
+	{"BB-05", "Saint John", "parish", ""},
+	{"BB-06", "Saint Joseph", "parish", ""},
+	{"BB-07", "Saint Lucy", "parish", ""},
+	{"BB-08", "Saint Michael", "parish", ""},
+	{"BB-09", "Saint Peter", "parish", ""},
+	{"BB-10", "Saint Philip", "parish", ""},
+	{"BB-11", "Saint Thomas", "parish", ""},
+	{"BD-01", "Bandarban", "district", "BD-B"},
+	{"BD-02", "Barguna", "district", "BD-A"},
+	{"BD-03", "Bogura", "district", "BD-E"},
+	{"BD-04", "Brahmanbaria", "district", "BD-B"},
+	{"BD-05", "Bagerhat", "district", "BD-D"},
+	{"BD-06", "Barishal", "district", "BD-A"},
+	{"BD-07", "Bhola", "district", "BD-A"},
+	{"BD-08", "Cumilla", "district", "BD-B"},
+	{"BD-09", "Chandpur", "district", "BD-B"},
+	{"BD-10", "Chattogram", "district", "BD-B"},
+	{"BD-11", "Cox's Bazar", "district", "BD-B"},
+	{"BD-12", "Chuadanga", "district", "BD-D"},
+	{"BD-13", "Dhaka", "district", "BD-C"},
+	{"BD-14", "Dinajpur", "district", "BD-F"},
+	{"BD-15", "Faridpur", "district", "BD-C"},
+	{"BD-16", "Feni", "district", "BD-B"},
+	{"BD-17", "Gopalganj", "district", "BD-C"},
+	{"BD-18", "Gazipur", "district", "BD-C"},
+	{"BD-19", "Gaibandha", "district", "BD-F"},
+	{"BD-20", "Habiganj", "district", "BD-G"},
+	{"BD-21", "Jamalpur", "district", "BD-H"},
+	{"BD-22", "Jashore", "district", "BD-D"},
+	{"BD-23", "Jhenaidah", "district", "BD-D"},
+	{"BD-24", "Joypurhat", "district", "BD-E"},
+	{"BD-25", "Jhalakathi", "district", "BD-A"},
+	{"BD-26", "Kishoreganj", "district", "BD-C"},
+	{"BD-27", "Khulna", "district", "BD-D"},
+	{"BD-28", "Kurigram", "district", "BD-F"},
+	{"BD-29", "Khagrachhari", "district", "BD-B"},
+	{"BD-30", "Kushtia", "district", "BD-D"},
+	{"BD-31", "Lakshmipur", "district", "BD-B"},
+	{"BD-32", "Lalmonirhat", "district", "BD-F"},
+	{"BD-33", "Manikganj", "district", "BD-C"},
+	{"BD-34", "Mymensingh", "district", "BD-H"},
+	{"BD-35", "Munshiganj", "district", "BD-C"},
+	{"BD-36", "Madaripur", "district", "BD-C"},
+	{"BD-37", "Magura", "district", "BD-D"},
+	{"BD-38", "Moulvibazar", "district", "BD-G"},
+	{"BD-39", "Meherpur", "district", "BD-D"},
+	{"BD-40", "Narayanganj", "district", "BD-C"},
+	{"BD-41", "Netrakona", "district", "BD-H"},
+	{"BD-42", "Narsingdi", "district", "BD-C"},
+	{"BD-43", "Narail", "district", "BD-D"},
+	{"BD-44", "Natore", "district", "BD-E"},
+	{"BD-45", "Chapai Nawabganj", "district", "BD-E"},
+	{"BD-46", "Nilphamari", "district", "BD-F"},
+	{"BD-47", "Noakhali", "district", "BD-B"},
+	{"BD-48", "Naogaon", "district", "BD-E"},
+	{"BD-49", "Pabna", "district", "BD-E"},
+	{"BD-50", "Pirojpur", "district", "BD-A"},
+	{"BD-51", "Patuakhali", "district", "BD-A"},
+	{"BD-52", "Panchagarh", "district", "BD-F"},
+	{"BD-53", "Rajbari", "district", "BD-C"},
+	{"BD-54", "Rajshahi", "district", "BD-E"},
+	{"BD-55", "Rangpur", "district", "BD-F"},
+	{"BD-56", "Rangamati", "district", "BD-B"},
+	{"BD-57", "Sherpur", "district", "BD-H"},
+	{"BD-58", "Satkhira", "district", "BD-D"},
+	{"BD-59", "Sirajganj", "district", "BD-E"},
+	{"BD-60", "Sylhet", "district", "BD-G"},
+	{"BD-61", "Sunamganj", "district", "BD-G"},
+	{"BD-62", "Shariatpur", "district", "BD-C"},
+	{"BD-63", "Tangail", "district", "BD-C"},
+	{"BD-64", "Thakurgaon", "district", "BD-F"},
+	{"BD-A", "Barishal", "division", ""},
+	{"BD-B", "Chattogram", "division", ""},
+	{"BD-C", "Dhaka", "division", ""},
+	{"BD-D", "Khulna", "division", ""},
+	{"BD-E", "Rajshahi", "division", ""},
+	{"BD-F", "Rangpur", "division", ""},
+	{"BD-G", "Sylhet", "division", ""},
+	{"BD-H", "Mymensingh", "division", ""},
+	{"BE-BRU", "Brussels Hoofdstedelijk Gewest", "region", ""},
+	{"BE-VAN", "Antwerpen", "province", ""},
+	{"BE-VBR", "Vlaams-Brabant", "province", ""},
+	{"BE-VLG", "Vlaams Gewest", "region", ""},
+	{"BE-VLI", "Limburg", "province", ""},
+	{"BE-VOV", "Oost-Vlaanderen", "province", ""},
+	{"BE-VWV", "West-Vlaanderen", "province", ""},
+	{"BE-WAL", "Wallonne, Région", "region", ""},
+	{"BE-WBR", "Brabant wallon", "province", ""},
+	{"BE-WHT", "Hainaut", "province", ""},
+	{"BE-WLG", "Liège", "province", ""},
+	{"BE-WLX", "Luxembourg", "province", ""},
+	{"BE-WNA", "Namur", "province", ""},
+	{"BF-01", "Boucle du Mouhoun", "region", ""},
+	{"BF-02", "Cascades", "region", ""},
+	{"BF-03", "Centre", "region", ""},
+	{"BF-04", "Centre-Est", "region", ""},
+	{"BF-05", "Centre-Nord", "region", ""},
+	{"BF-06", "Centre-Ouest", "region", ""},
+	{"BF-07", "Centre-Sud", "region", ""},
+	{"BF-08", "Est", "region", ""},
+	{"BF-09", "Hauts-Bassins", "region", ""},
+	{"BF-10", "Nord", "region", ""},
+	{"BF-11", "Plateau-Central", "region", ""},
+	{"BF-12", "Sahel", "region", ""},
+	{"BF-13", "Sud-Ouest", "region", ""},
+	{"BF-BAL", "Balé", "province", "BF-01"},
+	{"BF-BAM", "Bam", "province", "BF-05"},
+	{"BF-BAN", "Banwa", "province", "BF-01"},
+	{"BF-BAZ", "Bazèga", "province", "BF-07"},
+	{"BF-BGR", "Bougouriba", "province", "BF-13"},
+	{"BF-BLG", "Boulgou", "province", "BF-04"},
+	{"BF-BLK", "Boulkiemdé", "province", "BF-06"},
+	{"BF-COM", "Comoé", "province", "BF-02"},
+	{"BF-GAN", "Ganzourgou", "province", "BF-11"},
+	{"BF-GNA", "Gnagna", "province", "BF-08"},
+	{"BF-GOU", "Gourma", "province", "BF-08"},
+	{"BF-HOU", "Houet", "province", "BF-09"},
+	{"BF-IOB", "Ioba", "province", "BF-13"},
+	{"BF-KAD", "Kadiogo", "province", "BF-03"},
+	{"BF-KEN", "Kénédougou", "province", "BF-09"},
+	{"BF-KMD", "Komondjari", "province", "BF-08"},
+	{"BF-KMP", "Kompienga", "province", "BF-08"},
+	{"BF-KOP", "Koulpélogo", "province", "BF-04"},
+	{"BF-KOS", "Kossi", "province", "BF-01"},
+	{"BF-KOT", "Kouritenga", "province", "BF-04"},
+	{"BF-KOW", "Kourwéogo", "province", "BF-11"},
+	{"BF-LER", "Léraba", "province", "BF-02"},
+	{"BF-LOR", "Loroum", "province", "BF-10"},
+	{"BF-MOU", "Mouhoun", "province", "BF-01"},
+	{"BF-NAM", "Namentenga", "province", "BF-05"},
+	{"BF-NAO", "Nahouri", "province", "BF-07"},
+	{"BF-NAY", "Nayala", "province", "BF-01"},
+	{"BF-NOU", "Noumbiel", "province", "BF-13"},
+	{"BF-OUB", "Oubritenga", "province", "BF-11"},
+	{"BF-OUD", "Oudalan", "province", "BF-12"},
+	{"BF-PAS", "Passoré", "province", "BF-10"},
+	{"BF-PON", "Poni", "province", "BF-13"},
+	{"BF-SEN", "Séno", "province", "BF-12"},
+	{"BF-SIS", "Sissili", "province", "BF-06"},
+	{"BF-SMT", "Sanmatenga", "province", "BF-05"},
+	{"BF-SNG", "Sanguié", "province", "BF-06"},
+	{"BF-SOM", "Soum", "province", "BF-12"},
+	{"BF-SOR", "Sourou", "province", "BF-01"},
+	{"BF-TAP", "Tapoa", "province", "BF-08"},
+	{"BF-TUI", "Tuy", "province", "BF-09"},
+	{"BF-YAG", "Yagha", "province", "BF-12"},
+	{"BF-YAT", "Yatenga", "province", "BF-10"},
+	{"BF-ZIR", "Ziro", "province", "BF-06"},
+	{"BF-ZON", "Zondoma", "province", "BF-10"},
+	{"BF-ZOU", "Zoundwéogo", "province", "BF-07"},
+	{"BG-01", "Blagoevgrad", "district", ""},
+	{"BG-02", "Burgas", "district", ""},
+	{"BG-03", "Varna", "district", ""},
+	{"BG-04", "Veliko Tarnovo", "district", ""},
+	{"BG-05", "Vidin", "district", ""},
+	{"BG-06", "Vratsa", "district", ""},
+	{"BG-07", "Gabrovo", "district", ""},
+	{"BG-08", "Dobrich", "district", ""},
+	{"BG-09", "Kardzhali", "district", ""},
+	{"BG-10", "Kyustendil", "district", ""},
+	{"BG-11", "Lovech", "district", ""},
+	{"BG-12", "Montana", "district", ""},
+	{"BG-13", "Pazardzhik", "district", ""},
+	{"BG-14", "Pernik", "district", ""},
+	{"BG-15", "Pleven", "district", ""},
+	{"BG-16", "Plovdiv", "district", ""},
+	{"BG-17", "Razgrad", "district", ""},
+	{"BG-18", "Ruse", "district", ""},
+	{"BG-19", "Silistra", "district", ""},
+	{"BG-20", "Sliven", "district", ""},
+	{"BG-21", "Smolyan", "district", ""},
+	{"BG-22", "Sofia (stolitsa)", "district", ""},
+	{"BG-23", "Sofia", "district", ""},
+	{"BG-24", "Stara Zagora", "district", ""},
+	{"BG-25", "Targovishte", "district", ""},
+	{"BG-26", "Haskovo", "district", ""},
+	{"BG-27", "Shumen", "district", ""},
+	{"BG-28", "Yambol", "district", ""},
+	{"BH-13", "Al ‘Āşimah", "governorate", ""},
+	{"BH-14", "Al Janūbīyah", "governorate", ""},
+	{"BH-15", "Al Muḩarraq", "governorate", ""},
+	{"BH-17", "Ash Shamālīyah", "governorate", ""},
+	{"BI-BB", "Bubanza", "province", ""},
+	{"BI-BL", "Bujumbura Rural", "province", ""},
+	{"BI-BM", "Bujumbura Mairie", "province", ""},
+	{"BI-BR", "Bururi", "province", ""},
+	{"BI-CA", "Cankuzo", "province", ""},
+	{"BI-CI", "Cibitoke", "province", ""},
+	{"BI-GI", "Gitega", "province", ""},
+	{"BI-KI", "Kirundo", "province", ""},
+	{"BI-KR", "Karuzi", "province", ""},
+	{"BI-KY", "Kayanza", "province", ""},
+	{"BI-MA", "Makamba", "province", ""},
+	{"BI-MU", "Muramvya", "province", ""},
+	{"BI-MW", "Mwaro", "province", ""},
+	{"BI-MY", "Muyinga", "province", ""},
+	{"BI-NG", "Ngozi", "province", ""},
+	{"BI-RM", "Rumonge", "province", ""},
+	{"BI-RT", "Rutana", "province", ""},
+	{"BI-RY", "Ruyigi", "province", ""},
+	{"BJ-AK", "Atacora", "department", ""},
+	{"BJ-AL", "Alibori", "department", ""},
+	{"BJ-AQ", "Atlantique", "department", ""},
+	{"BJ-BO", "Borgou", "department", ""},
+	{"BJ-CO", "Collines", "department", ""},
+	{"BJ-DO", "Donga", "department", ""},
+	{"BJ-KO", "Couffo", "department", ""},
+	{"BJ-LI", "Littoral", "department", ""},
+	{"BJ-MO", "Mono", "department", ""},
+	{"BJ-OU", "Ouémé", "department", ""},
+	{"BJ-PL", "Plateau", "department", ""},
+	{"BJ-ZO", "Zou", "department", ""},
+	{"BN-BE", "Belait", "district", ""},
+	{"BN-BM", "Brunei-Muara", "district", ""},
+	{"BN-TE", "Temburong", "district", ""},
+	{"BN-TU", "Tutong", "district", ""},
+	{"BO-B", "El Beni", "department", ""},
+	{"BO-C", "Cochabamba", "department", ""},
+	{"BO-H", "Chuquisaca", "department", ""},
+	{"BO-L", "La Paz", "department", ""},
+	{"BO-N", "Pando", "department", ""},
+	{"BO-O", "Oruro", "department", ""},
+	{"BO-P", "Potosí", "department", ""},
+	{"BO-S", "Santa Cruz", "department", ""},
+	{"BO-T", "Tarija", "department", ""},
+	{"BQ-BO", "Bonaire", "special municipality", ""},
+	{"BQ-SA", "Saba", "special municipality", ""},
+	{"BQ-SE", "Sint Eustatius", "special municipality", ""},
+	{"BR-AC", "Acre", "state", ""},
+	{"BR-AL", "Alagoas", "state", ""},
+	{"BR-AM", "Amazonas", "state", ""},
+	{"BR-AP", "Amapá", "state", ""},
+	{"BR-BA", "Bahia", "state", ""},
+	{"BR-CE", "Ceará", "state", ""},
+	{"BR-DF", "Distrito Federal", "federal district", ""},
+	{"BR-ES", "Espírito Santo", "state", ""},
+	{"BR-GO", "Goiás", "state", ""},
+	{"BR-MA", "Maranhão", "state", ""},
+	{"BR-MG", "Minas Gerais", "state", ""},
+	{"BR-MS", "Mato Grosso do Sul", "state", ""},
+	{"BR-MT", "Mato Grosso", "state", ""},
+	{"BR-PA", "Pará", "state", ""},
+	{"BR-PB", "Paraíba", "state", ""},
+	{"BR-PE", "Pernambuco", "state", ""},
+	{"BR-PI", "Piauí", "state", ""},
+	{"BR-PR", "Paraná", "state", ""},
+	{"BR-RJ", "Rio de Janeiro", "state", ""},
+	{"BR-RN", "Rio Grande do Norte", "state", ""},
+	{"BR-RO", "Rondônia", "state", ""},
+	{"BR-RR", "Roraima", "state", ""},
+	{"BR-RS", "Rio Grande do Sul", "state", ""},
+	{"BR-SC", "Santa Catarina", "state", ""},
+	{"BR-SE", "Sergipe", "state", ""},
+	{"BR-SP", "São Paulo", "state", ""},
+	{"BR-TO", "Tocantins", "state", ""},
+	{"BS-AK", "Acklins", "district", ""},
+	{"BS-BI", "Bimini", "district", ""},
+	{"BS-BP", "Black Point", "district", ""},
+	{"BS-BY", "Berry Islands", "district", ""},
+	{"BS-CE", "Central Eleuthera", "district", ""},
+	{"BS-CI", "Cat Island", "district", ""},
+	{"BS-CK", "Crooked Island and Long Cay", "district", ""},
+	{"BS-CO", "Central Abaco", "district", ""},
+	{"BS-CS", "Central Andros", "district", ""},
+	{"BS-EG", "East Grand Bahama", "district", ""},
+	{"BS-EX", "Exuma", "district", ""},
+	{"BS-FP", "City of Freeport", "district", ""},
+	{"BS-GC", "Grand Cay", "district", ""},
+	{"BS-HI", "Harbour Island", "district", ""},
+	{"BS-HT", "Hope Town", "district", ""},
+	{"BS-IN", "Inagua", "district", ""},
+	{"BS-LI", "Long Island", "district", ""},
+	{"BS-MC", "Mangrove Cay", "district", ""},
+	{"BS-MG", "Mayaguana", "district", ""},
+	{"BS-MI", "Moore's Island", "district", ""},
+	{"BS-NE", "North Eleuthera", "district", ""},
+	{"BS-NO", "North Abaco", "district", ""},
+	{"BS-NP", "New Providence", "island", ""},
+	{"BS-NS", "North Andros", "district", ""},
+	{"BS-RC", "Rum Cay", "district", ""},
+	{"BS-RI", "Ragged Island", "district", ""},
+	{"BS-SA", "South Andros", "district", ""},
+	{"BS-SE", "South Eleuthera", "district", ""},
+	{"BS-SO", "South Abaco", "district", ""},
+	{"BS-SS", "San Salvador", "district", ""},
+	{"BS-SW", "Spanish Wells", "district", ""},
+	{"BS-WG", "West Grand Bahama", "district", ""},
+	{"BT-11", "Paro", "district", ""},
+	{"BT-12", "Chhukha", "district", ""},
+	{"BT-13", "Haa", "district", ""},
+	{"BT-14", "Samtse", "district", ""},
+	{"BT-15", "Thimphu", "district", ""},
+	{"BT-21", "Tsirang", "district", ""},
+	{"BT-22", "Dagana", "district", ""},
+	{"BT-23", "Punakha", "district", ""},
+	{"BT-24", "Wangdue Phodrang", "district", ""},
+	{"BT-31", "Sarpang", "district", ""},
+	{"BT-32", "Trongsa", "district", ""},
+	{"BT-33", "Bumthang", "district", ""},
+	{"BT-34", "Zhemgang", "district", ""},
+	{"BT-41", "Trashigang", "district", ""},
+	{"BT-42", "Monggar", "district", ""},
+	{"BT-43", "Pema Gatshel", "district", ""},
+	{"BT-44", "Lhuentse", "district", ""},
+	{"BT-45", "Samdrup Jongkhar", "district", ""},
+	{"BT-GA", "Gasa", "district", ""},
+	{"BT-TY", "Trashi Yangtse", "district", ""},
+	{"BW-CE", "Central", "district", ""},
+	{"BW-CH", "Chobe", "district", ""},
+	{"BW-FR", "Francistown", "city", ""},
+	{"BW-GA", "Gaborone", "city", ""},
+	{"BW-GH", "Ghanzi", "district", ""},
+	{"BW-JW", "Jwaneng", "town", ""},
+	{"BW-KG", "Kgalagadi", "district", ""},
+	{"BW-KL", "Kgatleng", "district", ""},
+	{"BW-KW", "Kweneng", "district", ""},
+	{"BW-LO", "Lobatse", "town", ""},
+	{"BW-NE", "North East", "district", ""},
+	{"BW-NW", "North West", "district", ""},
+	{"BW-SE", "South East", "district", ""},
+	{"BW-SO", "Southern", "district", ""},
+	{"BW-SP", "Selibe Phikwe", "town", ""},
+	{"BW-ST", "Sowa Town", "town", ""},
+	{"BY-BR", "Bresckaja voblasć", "oblast", ""},
+	{"BY-HM", "Gorod Minsk", "city", ""},
+	{"BY-HO", "Gomel'skaja oblast'", "oblast", ""},
+	{"BY-HR", "Grodnenskaja oblast'", "oblast", ""},
+	{"BY-MA", "Mahilioŭskaja voblasć", "oblast", ""},
+	{"BY-MI", "Minskaja oblast'", "oblast", ""},
+	{"BY-VI", "Viciebskaja voblasć", "oblast", ""},
+	{"BZ-BZ", "Belize", "district", ""},
+	{"BZ-CY", "Cayo", "district", ""},
+	{"BZ-CZL", "Corozal", "district", ""},
+	{"BZ-OW", "Orange Walk", "district", ""},
+	{"BZ-SC", "Stann Creek", "district", ""},
+	{"BZ-TOL", "Toledo", "district", ""},
+	{"CA-AB", "Alberta", "province", ""},
+	{"CA-BC", "British Columbia", "province", ""},
+	{"CA-MB", "Manitoba", "province", ""},
+	{"CA-NB", "New Brunswick", "province", ""},
+	{"CA-NL", "Newfoundland and Labrador", "province", ""},
+	{"CA-NS", "Nova Scotia", "province", ""},
+	{"CA-NT", "Northwest Territories", "territory", ""},
+	{"CA-NU", "Nunavut", "territory", ""},
+	{"CA-ON", "Ontario", "province", ""},
+	{"CA-PE", "Prince Edward Island", "province", ""},
+	{"CA-QC", "Quebec", "province", ""},
+	{"CA-SK", "Saskatchewan", "province", ""},
+	{"CA-YT", "Yukon", "territory", ""},
+	{"CD-BC", "Kongo Central", "province", ""},
+	{"CD-BU", "Bas-Uélé", "province", ""},
+	{"CD-EQ", "Équateur", "province", ""},
+	{"CD-HK", "Haut-Katanga", "province", ""},
+	{"CD-HL", "Haut-Lomami", "province", ""},
+	{"CD-HU", "Haut-Uélé", "province", ""},
+	{"CD-IT", "Ituri", "province", ""},
+	{"CD-KC", "Kasaï Central", "province", ""},
+	{"CD-KE", "Kasaï Oriental", "province", ""},
+	{"CD-KG", "Kwango", "province", ""},
+	{"CD-KL", "Kwilu", "province", ""},
+	{"CD-KN", "Kinshasa", "city", ""},
+	{"CD-KS", "Kasaï", "province", ""},
+	{"CD-LO", "Lomami", "province", ""},
+	{"CD-LU", "Lualaba", "province", ""},
+	{"CD-MA", "Maniema", "province", ""},
+	{"CD-MN", "Mai-Ndombe", "province", ""},
+	{"CD-MO", "Mongala", "province", ""},
+	{"CD-NK", "Nord-Kivu", "province", ""},
+	{"CD-NU", "Nord-Ubangi", "province", ""},
+	{"CD-SA", "Sankuru", "province", ""},
+	{"CD-SK", "Sud-Kivu", "province", ""},
+	{"CD-SU", "Sud-Ubangi", "province", ""},
+	{"CD-TA", "Tanganyika", "province", ""},
+	{"CD-TO", "Tshopo", "province", ""},
+	{"CD-TU", "Tshuapa", "province", ""},
+	{"CF-AC", "Ouham", "prefecture", ""},
+	{"CF-BB", "Bamingui-Bangoran", "prefecture", ""},
+	{"CF-BGF", "Bangui", "commune", ""},
+	{"CF-BK", "Basse-Kotto", "prefecture", ""},
+	{"CF-HK", "Haute-Kotto", "prefecture", ""},
+	{"CF-HM", "Haut-Mbomou", "prefecture", ""},
+	{"CF-HS", "Haute-Sangha / Mambéré-Kadéï", "prefecture", ""},
+	{"CF-KB", "Gribingui", "economic prefecture", ""},
+	{"CF-KG", "Kemö-Gïrïbïngï", "prefecture", ""},
+	{"CF-LB", "Lobaye", "prefecture", ""},
+	{"CF-MB", "Mbomou", "prefecture", ""},
+	{"CF-MP", "Ombella-Mpoko", "prefecture", ""},
+	{"CF-NM", "Nana-Mambéré", "prefecture", ""},
+	{"CF-OP", "Ouham-Pendé", "prefecture", ""},
+	{"CF-SE", "Sangha", "economic prefecture", ""},
+	{"CF-UK", "Ouaka", "prefecture", ""},
+	{"CF-VK", "Vakaga", "prefecture", ""},
+	{"CG-11", "Bouenza", "department", ""},
+	{"CG-12", "Pool", "department", ""},
+	{"CG-13", "Sangha", "department", ""},
+	{"CG-14", "Plateaux", "department", ""},
+	{"CG-15", "Cuvette-Ouest", "department", ""},
+	{"CG-16", "Pointe-Noire", "department", ""},
+	{"CG-2", "Lékoumou", "department", ""},
+	{"CG-5", "Kouilou", "department", ""},
+	{"CG-7", "Likouala", "department", ""},
+	{"CG-8", "Cuvette", "department", ""},
+	{"CG-9", "Niari", "department", ""},
+	{"CG-BZV", "Brazzaville", "department", ""},
+	{"CH-AG", "Aargau", "canton", ""},
+	{"CH-AI", "Appenzell Innerrhoden", "canton", ""},
+	{"CH-AR", "Appenzell Ausserrhoden", "canton", ""},
+	{"CH-BE", "Bern", "canton", ""},
+	{"CH-BL", "Basel-Landschaft", "canton", ""},
+	{"CH-BS", "Basel-Stadt", "canton", ""},
+	{"CH-FR", "Fribourg", "canton", ""},
+	{"CH-GE", "Genève", "canton", ""},
+	{"CH-GL", "Glarus", "canton", ""},
+	{"CH-GR", "Graubünden", "canton", ""},
+	{"CH-JU", "Jura", "canton", ""},
+	{"CH-LU", "Luzern", "canton", ""},
+	{"CH-NE", "Neuchâtel", "canton", ""},
+	{"CH-NW", "Nidwalden", "canton", ""},
+	{"CH-OW", "Obwalden", "canton", ""},
+	{"CH-SG", "Sankt Gallen", "canton", ""},
+	{"CH-SH", "Schaffhausen", "canton", ""},
+	{"CH-SO", "Solothurn", "canton", ""},
+	{"CH-SZ", "Schwyz", "canton", ""},
+	{"CH-TG", "Thurgau", "canton", ""},
+	{"CH-TI", "Ticino", "canton", ""},
+	{"CH-UR", "Uri", "canton", ""},
+	{"CH-VD", "Vaud", "canton", ""},
+	{"CH-VS", "Valais", "canton", ""},
+	{"CH-ZG", "Zug", "canton", ""},
+	{"CH-ZH", "Zürich", "canton", ""},
+	{"CI-AB", "Abidjan", "autonomous district", ""},
+	{"CI-BS", "Bas-Sassandra", "district", ""},
+	{"CI-CM", "Comoé", "district", ""},
+	{"CI-DN", "Denguélé", "district", ""},
+	{"CI-GD", "Gôh-Djiboua", "district", ""},
+	{"CI-LC", "Lacs", "district", ""},
+	{"CI-LG", "Lagunes", "district", ""},
+	{"CI-MG", "Montagnes", "district", ""},
+	{"CI-SM", "Sassandra-Marahoué", "district", ""},
+	{"CI-SV", "Savanes", "district", ""},
+	{"CI-VB", "Vallée du Bandama", "district", ""},
+	{"CI-WR", "Woroba", "district", ""},
+	{"CI-YM", "Yamoussoukro", "autonomous district", ""},
+	{"CI-ZZ", "Zanzan", "district", ""},
+	{"CL-AI", "Aisén del General Carlos Ibañez del Campo", "region", ""},
+	{"CL-AN", "Antofagasta", "region", ""},
+	{"CL-AP", "Arica y Parinacota", "region", ""},
+	{"CL-AR", "La Araucanía", "region", ""},
+	{"CL-AT", "Atacama", "region", ""},
+	{"CL-BI", "Biobío", "region", ""},
+	{"CL-CO", "Coquimbo", "region", ""},
+	{"CL-LI", "Libertador General Bernardo O'Higgins", "region", ""},
+	{"CL-LL", "Los Lagos", "region", ""},
+	{"CL-LR", "Los Ríos", "region", ""},
+	{"CL-MA", "Magallanes", "region", ""},
+	{"CL-ML", "Maule", "region", ""},
+	{"CL-NB", "Ñuble", "region", ""},
+	{"CL-RM", "Región Metropolitana de Santiago", "region", ""},
+	{"CL-TA", "Tarapacá", "region", ""},
+	{"CL-VS", "Valparaíso", "region", ""},
+	{"CM-AD", "Adamaoua", "region", ""},
+	{"CM-CE", "Centre", "region", ""},
+	{"CM-EN", "Far North", "region", ""},
+	{"CM-ES", "East", "region", ""},
+	{"CM-LT", "Littoral", "region", ""},
+	{"CM-NO", "North", "region", ""},
+	{"CM-NW", "North-West", "region", ""},
+	{"CM-OU", "West", "region", ""},
+	{"CM-SU", "South", "region", ""},
+	{"CM-SW", "South-West", "region", ""},
+	{"CN-AH", "Anhui", "province", ""},
+	{"CN-BJ", "Beijing", "municipality", ""},
+	{"CN-CQ", "Chongqing", "municipality", ""},
+	{"CN-FJ", "Fujian", "province", ""},
+	{"CN-GD", "Guangdong", "province", ""},
+	{"CN-GS", "Gansu", "province", ""},
+	{"CN-GX", "Guangxi Zhuangzu Zizhiqu", "autonomous region", ""},
+	{"CN-GZ", "Guizhou", "province", ""},
+	{"CN-HA", "Henan", "province", ""},
+	{"CN-HB", "Hubei", "province", ""},
+	{"CN-HE", "Hebei", "province", ""},
+	{"CN-HI", "Hainan", "province", ""},
+	{"CN-HK", "Hong Kong SAR", "special administrative region", ""},
+	{"CN-HL", "Heilongjiang", "province", ""},
+	{"CN-HN", "Hunan", "province", ""},
+	{"CN-JL", "Jilin", "province", ""},
+	{"CN-JS", "Jiangsu", "province", ""},
+	{"CN-JX", "Jiangxi", "province", ""},
+	{"CN-LN", "Liaoning", "province", ""},
+	{"CN-MO", "Macao SAR", "special administrative region", ""},
+	{"CN-NM", "Nei Mongol Zizhiqu", "autonomous region", ""},
+	{"CN-NX", "Ningxia Huizu Zizhiqu", "autonomous region", ""},
+	{"CN-QH", "Qinghai", "province", ""},
+	{"CN-SC", "Sichuan", "province", ""},
+	{"CN-SD", "Shandong", "province", ""},
+	{"CN-SH", "Shanghai", "municipality", ""},
+	{"CN-SN", "Shaanxi", "province", ""},
+	{"CN-SX", "Shanxi", "province", ""},
+	{"CN-TJ", "Tianjin", "municipality", ""},
+	{"CN-TW", "Taiwan", "province", ""},
+	{"CN-XJ", "Xinjiang Uygur Zizhiqu", "autonomous region", ""},
+	{"CN-XZ", "Xizang Zizhiqu", "autonomous region", ""},
+	{"CN-YN", "Yunnan", "province", ""},
+	{"CN-ZJ", "Zhejiang", "province", ""},
+	{"CO-AMA", "Amazonas", "department", ""},
+	{"CO-ANT", "Antioquia", "department", ""},
+	{"CO-ARA", "Arauca", "department", ""},
+	{"CO-ATL", "Atlántico", "department", ""},
+	{"CO-BOL", "Bolívar", "department", ""},
+	{"CO-BOY", "Boyacá", "department", ""},
+	{"CO-CAL", "Caldas", "department", ""},
+	{"CO-CAQ", "Caquetá", "department", ""},
+	{"CO-CAS", "Casanare", "department", ""},
+	{"CO-CAU", "Cauca", "department", ""},
+	{"CO-CES", "Cesar", "department", ""},
+	{"CO-CHO", "Chocó", "department", ""},
+	{"CO-COR", "Córdoba", "department", ""},
+	{"CO-CUN", "Cundinamarca", "department", ""},
+	{"CO-DC", "Distrito Capital de Bogotá", "capital district", ""},
+	{"CO-GUA", "Guainía", "department", ""},
+	{"CO-GUV", "Guaviare", "department", ""},
+	{"CO-HUI", "Huila", "department", ""},
+	{"CO-LAG", "La Guajira", "department", ""},
+	{"CO-MAG", "Magdalena", "department", ""},
+	{"CO-MET", "Meta", "department", ""},
+	{"CO-NAR", "Nariño", "department", ""},
+	{"CO-NSA", "Norte de Santander", "department", ""},
+	{"CO-PUT", "Putumayo", "department", ""},
+	{"CO-QUI", "Quindío", "department", ""},
+	{"CO-RIS", "Risaralda", "department", ""},
+	{"CO-SAN", "Santander", "department", ""},
+	{"CO-SAP", "San Andrés, Providencia y Santa Catalina", "department", ""},
+	{"CO-SUC", "Sucre", "department", ""},
+	{"CO-TOL", "Tolima", "department", ""},
+	{"CO-VAC", "Valle del Cauca", "department", ""},
+	{"CO-VAU", "Vaupés", "department", ""},
+	{"CO-VID", "Vichada", "department", ""},
+	{"CR-A", "Alajuela", "province", ""},
+	{"CR-C", "Cartago", "province", ""},
+	{"CR-G", "Guanacaste", "province", ""},
+	{"CR-H", "Heredia", "province", ""},
+	{"CR-L", "Limón", "province", ""},
+	{"CR-P", "Puntarenas", "province", ""},
+	{"CR-SJ", "San José", "province", ""},
+	{"CU-01", "Pinar del Río", "province", ""},
+	{"CU-03", "La Habana", "province", ""},
+	{"CU-04", "Matanzas", "province", ""},
+	{"CU-05", "Villa Clara", "province", ""},
+	{"CU-06", "Cienfuegos", "province", ""},
+	{"CU-07", "Sancti Spíritus", "province", ""},
+	{"CU-08", "Ciego de Ávila", "province", ""},
+	{"CU-09", "Camagüey", "province", ""},
+	{"CU-10", "Las Tunas", "province", ""},
+	{"CU-11", "Holguín", "province", ""},
+	{"CU-12", "Granma", "province", ""},
+	{"CU-13", "Santiago de Cuba", "province", ""},
+	{"CU-14", "Guantánamo", "province", ""},
+	{"CU-15", "Artemisa", "province", ""},
+	{"CU-16", "Mayabeque", "province", ""},
+	{"CU-99", "Isla de la Juventud", "special municipality", ""},
+	{"CV-B", "Ilhas de Barlavento", "geographical region", ""},
+	{"CV-BR", "Brava", "municipality", "CV-S"},
+	{"CV-BV", "Boa Vista", "municipality", "CV-B"},
+	{"CV-CA", "Santa Catarina", "municipality", "CV-S"},
+	{"CV-CF", "Santa Catarina do Fogo", "municipality", "CV-S"},
+	{"CV-CR", "Santa Cruz", "municipality", "CV-S"},
+	{"CV-MA", "Maio", "municipality", "CV-S"},
+	{"CV-MO", "Mosteiros", "municipality", "CV-S"},
+	{"CV-PA", "Paul", "municipality", "CV-B"},
+	{"CV-PN", "Porto Novo", "municipality", "CV-B"},
+	{"CV-PR", "Praia", "municipality", "CV-S"},
+	{"CV-RB", "Ribeira Brava", "municipality", "CV-B"},
+	{"CV-RG", "Ribeira Grande", "municipality", "CV-B"},
+	{"CV-RS", "Ribeira Grande de Santiago", "municipality", "CV-S"},
+	{"CV-S", "Ilhas de Sotavento", "geographical region", ""},
+	{"CV-SD", "São Domingos", "municipality", "CV-S"},
+	{"CV-SF", "São Filipe", "municipality", "CV-S"},
+	{"CV-SL", "Sal", "municipality", "CV-B"},
+	{"CV-SM", "São Miguel", "municipality", "CV-S"},
+	{"CV-SO", "São Lourenço dos Órgãos", "municipality", "CV-S"},
+	{"CV-SS", "São Salvador do Mundo", "municipality", "CV-S"},
+	{"CV-SV", "São Vicente", "municipality", "CV-B"},
+	{"CV-TA", "Tarrafal", "municipality", "CV-S"},
+	{"CV-TS", "Tarrafal de São Nicolau", "municipality", "CV-B"},
+	{"CY-01", "Lefkosia", "district", ""},
+	{"CY-02", "Lemesos", "district", ""},
+	{"CY-03", "Larnaka", "district", ""},
+	{"CY-04", "Ammochostos", "district", ""},
+	{"CY-05", "Baf", "district", ""},
+	{"CY-06", "Girne", "district", ""},
+	{"CZ-10", "Praha, Hlavní město", "capital city", ""},
+	{"CZ-20", "Středočeský kraj", "region", ""},
+	{"CZ-201", "Benešov", "district", "CZ-20"},
+	{"CZ-202", "Beroun", "district", "CZ-20"},
+	{"CZ-203", "Kladno", "district", "CZ-20"},
+	{"CZ-204", "Kolín", "district", "CZ-20"},
+	{"CZ-205", "Kutná Hora", "district", "CZ-20"},
+	{"CZ-206", "Mělník", "district", "CZ-20"},
+	{"CZ-207", "Mladá Boleslav", "district", "CZ-20"},
+	{"CZ-208", "Nymburk", "district", "CZ-20"},
+	{"CZ-209", "Praha-východ", "district", "CZ-20"},
+	{"CZ-20A", "Praha-západ", "district", "CZ-20"},
+	{"CZ-20B", "Příbram", "district", "CZ-20"},
+	{"CZ-20C", "Rakovník", "district", "CZ-20"},
+	{"CZ-31", "Jihočeský kraj", "region", ""},
+	{"CZ-311", "České Budějovice", "district", "CZ-31"},
+	{"CZ-312", "Český Krumlov", "district", "CZ-31"},
+	{"CZ-313", "Jindřichův Hradec", "district", "CZ-31"},
+	{"CZ-314", "Písek", "district", "CZ-31"},
+	{"CZ-315", "Prachatice", "district", "CZ-31"},
+	{"CZ-316", "Strakonice", "district", "CZ-31"},
+	{"CZ-317", "Tábor", "district", "CZ-31"},
+	{"CZ-32", "Plzeňský kraj", "region", ""},
+	{"CZ-321", "Domažlice", "district", "CZ-32"},
+	{"CZ-322", "Klatovy", "district", "CZ-32"},
+	{"CZ-323", "Plzeň-město", "district", "CZ-32"},
+	{"CZ-324", "Plzeň-jih", "district", "CZ-32"},
+	{"CZ-325", "Plzeň-sever", "district", "CZ-32"},
+	{"CZ-326", "Rokycany", "district", "CZ-32"},
+	{"CZ-327", "Tachov", "district", "CZ-32"},
+	{"CZ-41", "Karlovarský kraj", "region", ""},
+	{"CZ-411", "Cheb", "district", "CZ-41"},
+	{"CZ-412", "Karlovy Vary", "district", "CZ-41"},
+	{"CZ-413", "Sokolov", "district", "CZ-41"},
+	{"CZ-42", "Ústecký kraj", "region", ""},
+	{"CZ-421", "Děčín", "district", "CZ-42"},
+	{"CZ-422", "Chomutov", "district", "CZ-42"},
+	{"CZ-423", "Litoměřice", "district", "CZ-42"},
+	{"CZ-424", "Louny", "district", "CZ-42"},
+	{"CZ-425", "Most", "district", "CZ-42"},
+	{"CZ-426", "Teplice", "district", "CZ-42"},
+	{"CZ-427", "Ústí nad Labem", "district", "CZ-42"},
+	{"CZ-51", "Liberecký kraj", "region", ""},
+	{"CZ-511", "Česká Lípa", "district", "CZ-51"},
+	{"CZ-512", "Jablonec nad Nisou", "district", "CZ-51"},
+	{"CZ-513", "Liberec", "district", "CZ-51"},
+	{"CZ-514", "Semily", "district", "CZ-51"},
+	{"CZ-52", "Královéhradecký kraj", "region", ""},
+	{"CZ-521", "Hradec Králové", "district", "CZ-52"},
+	{"CZ-522", "Jičín", "district", "CZ-52"},
+	{"CZ-523", "Náchod", "district", "CZ-52"},
+	{"CZ-524", "Rychnov nad Kněžnou", "district", "CZ-52"},
+	{"CZ-525", "Trutnov", "district", "CZ-52"},
+	{"CZ-53", "Pardubický kraj", "region", ""},
+	{"CZ-531", "Chrudim", "district", "CZ-53"},
+	{"CZ-532", "Pardubice", "district", "CZ-53"},
+	{"CZ-533", "Svitavy", "district", "CZ-53"},
+	{"CZ-534", "Ústí nad Orlicí", "district", "CZ-53"},
+	{"CZ-63", "Kraj Vysočina", "region", ""},
+	{"CZ-631", "Havlíčkův Brod", "district", "CZ-63"},
+	{"CZ-632", "Jihlava", "district", "CZ-63"},
+	{"CZ-633", "Pelhřimov", "district", "CZ-63"},
+	{"CZ-634", "Třebíč", "district", "CZ-63"},
+	{"CZ-635", "Žďár nad Sázavou", "district", "CZ-63"},
+	{"CZ-64", "Jihomoravský kraj", "region", ""},
+	{"CZ-641", "Blansko", "district", "CZ-64"},
+	{"CZ-642", "Brno-město", "district", "CZ-64"},
+	{"CZ-643", "Brno-venkov", "district", "CZ-64"},
+	{"CZ-644", "Břeclav", "district", "CZ-64"},
+	{"CZ-645", "Hodonín", "district", "CZ-64"},
+	{"CZ-646", "Vyškov", "district", "CZ-64"},
+	{"CZ-647", "Znojmo", "district", "CZ-64"},
+	{"CZ-71", "Olomoucký kraj", "region", ""},
+	{"CZ-711", "Jeseník", "district", "CZ-71"},
+	{"CZ-712", "Olomouc", "district", "CZ-71"},
+	{"CZ-713", "Prostějov", "district", "CZ-71"},
+	{"CZ-714", "Přerov", "district", "CZ-71"},
+	{"CZ-715", "Šumperk", "district", "CZ-71"},
+	{"CZ-72", "Zlínský kraj", "region", ""},
+	{"CZ-721", "Kroměříž", "district", "CZ-72"},
+	{"CZ-722", "Uherské Hradiště", "district", "CZ-72"},
+	{"CZ-723", "Vsetín", "district", "CZ-72"},
+	{"CZ-724", "Zlín", "district", "CZ-72"},
+	{"CZ-80", "Moravskoslezský kraj", "region", ""},
+	{"CZ-801", "Bruntál", "district", "CZ-80"},
+	{"CZ-802", "Frýdek-Místek", "district", "CZ-80"},
+	{"CZ-803", "Karviná", "district", "CZ-80"},
+	{"CZ-804", "Nový Jičín", "district", "CZ-80"},
+	{"CZ-805", "Opava", "district", "CZ-80"},
+	{"CZ-806", "Ostrava-město", "district", "CZ-80"},
+	{"DE-BB", "Brandenburg", "land", ""},
+	{"DE-BE", "Berlin", "land", ""},
+	{"DE-BW", "Baden-Württemberg", "land", ""},
+	{"DE-BY", "Bayern", "land", ""},
+	{"DE-HB", "Bremen", "land", ""},
+	{"DE-HE", "Hessen", "land", ""},
+	{"DE-HH", "Hamburg", "land", ""},
+	{"DE-MV", "Mecklenburg-Vorpommern", "land", ""},
+	{"DE-NI", "Niedersachsen", "land", ""},
+	{"DE-NW", "Nordrhein-Westfalen", "land", ""},
+	{"DE-RP", "Rheinland-Pfalz", "land", ""},
+	{"DE-SH", "Schleswig-Holstein", "land", ""},
+	{"DE-SL", "Saarland", "land", ""},
+	{"DE-SN", "Sachsen", "land", ""},
+	{"DE-ST", "Sachsen-Anhalt", "land", ""},
+	{"DE-TH", "Thüringen", "land", ""},
+	{"DJ-AR", "Arta", "region", ""},
+	{"DJ-AS", "Ali Sabieh", "region", ""},
+	{"DJ-DI", "Dikhil", "region", ""},
+	{"DJ-DJ", "Djibouti", "city", ""},
+	{"DJ-OB", "Awbūk", "region", ""},
+	{"DJ-TA", "Tadjourah", "region", ""},
+	{"DK-81", "Nordjylland", "region", ""},
+	{"DK-82", "Midtjylland", "region", ""},
+	{"DK-83", "Syddanmark", "region", ""},
+	{"DK-84", "Hovedstaden", "region", ""},
+	{"DK-85", "Sjælland", "region", ""},
+	{"DM-02", "Saint Andrew", "parish", ""},
+	{"DM-03", "Saint David", "parish", ""},
+	{"DM-04", "Saint George", "parish", ""},
+	{"DM-05", "Saint John", "parish", ""},
+	{"DM-06", "Saint Joseph", "parish", ""},
+	{"DM-07", "Saint Luke", "parish", ""},
+	{"DM-08", "Saint Mark", "parish", ""},
+	{"DM-09", "Saint Patrick", "parish", ""},
+	{"DM-10", "Saint Paul", "parish", ""},
+	{"DM-11", "Saint Peter", "parish", ""},
+	{"DO-01", "Distrito Nacional (Santo Domingo)", "district", "DO-40"},
+	{"DO-02", "Azua", "province", "DO-41"},
+	{"DO-03", "Baoruco", "province", "DO-38"},
+	{"DO-04", "Barahona", "province", "DO-38"},
+	{"DO-05", "Dajabón", "province", "DO-34"},
+	{"DO-06", "Duarte", "province", "DO-33"},
+	{"DO-07", "Elías Piña", "province", "DO-37"},
+	{"DO-08", "El Seibo", "province", "DO-42"},
+	{"DO-09", "Espaillat", "province", "DO-35"},
+	{"DO-10", "Independencia", "province", "DO-38"},
+	{"DO-11", "La Altagracia", "province", "DO-42"},
+	{"DO-12", "La Romana", "province", "DO-42"},
+	{"DO-13", "La Vega", "province", "DO-36"},
+	{"DO-14", "María Trinidad Sánchez", "province", "DO-33"},
+	{"DO-15", "Monte Cristi", "province", "DO-34"},
+	{"DO-16", "Pedernales", "province", "DO-38"},
+	{"DO-17", "Peravia", "province", "DO-41"},
+	{"DO-18", "Puerto Plata", "province", "DO-35"},
+	{"DO-19", "Hermanas Mirabal", "province", "DO-33"},
+	{"DO-20", "Samaná", "province", "DO-33"},
+	{"DO-21", "San Cristóbal", "province", "DO-41"},
+	{"DO-22", "San Juan", "province", "DO-37"},
+	{"DO-23", "San Pedro de Macorís", "province", "DO-39"},
+	{"DO-24", "Sánchez Ramírez", "province", "DO-36"},
+	{"DO-25", "Santiago", "province", "DO-35"},
+	{"DO-26", "Santiago Rodríguez", "province", "DO-34"},
+	{"DO-27", "Valverde", "province", "DO-34"},
+	{"DO-28", "Monseñor Nouel", "province", "DO-36"},
+	{"DO-29", "Monte Plata", "province", "DO-39"},
+	{"DO-30", "Hato Mayor", "province", "DO-39"},
+	{"DO-31", "San José de Ocoa", "province", "DO-41"},
+	{"DO-32", "Santo Domingo", "province", "DO-40"},
+	{"DO-33", "Cibao Nordeste", "region", ""},
+	{"DO-34", "Cibao Noroeste", "region", ""},
+	{"DO-35", "Cibao Norte", "region", ""},
+	{"DO-36", "Cibao Sur", "region", ""},
+	{"DO-37", "El Valle", "region", ""},
+	{"DO-38", "Enriquillo", "region", ""},
+	{"DO-39", "Higuamo", "region", ""},
+	{"DO-40", "Ozama", "region", ""},
+	{"DO-41", "Valdesia", "region", ""},
+	{"DO-42", "Yuma", "region", ""},
+	{"DZ-01", "Adrar", "province", ""},
+	{"DZ-02", "Chlef", "province", ""},
+	{"DZ-03", "Laghouat", "province", ""},
+	{"DZ-04", "Oum el Bouaghi", "province", ""},
+	{"DZ-05", "Batna", "province", ""},
+	{"DZ-06", "Béjaïa", "province", ""},
+	{"DZ-07", "Biskra", "province", ""},
+	{"DZ-08", "Béchar", "province", ""},
+	{"DZ-09", "Blida", "province", ""},
+	{"DZ-10", "Bouira", "province", ""},
+	{"DZ-11", "Tamanrasset", "province", ""},
+	{"DZ-12", "Tébessa", "province", ""},
+	{"DZ-13", "Tlemcen", "province", ""},
+	{"DZ-14", "Tiaret", "province", ""},
+	{"DZ-15", "Tizi Ouzou", "province", ""},
+	{"DZ-16", "Alger", "province", ""},
+	{"DZ-17", "Djelfa", "province", ""},
+	{"DZ-18", "Jijel", "province", ""},
+	{"DZ-19", "Sétif", "province", ""},
+	{"DZ-20", "Saïda", "province", ""},
+	{"DZ-21", "Skikda", "province", ""},
+	{"DZ-22", "Sidi Bel Abbès", "province", ""},
+	{"DZ-23", "Annaba", "province", ""},
+	{"DZ-24", "Guelma", "province", ""},
+	{"DZ-25", "Constantine", "province", ""},
+	{"DZ-26", "Médéa", "province", ""},
+	{"DZ-27", "Mostaganem", "province", ""},
+	{"DZ-28", "M'sila", "province", ""},
+	{"DZ-29", "Mascara", "province", ""},
+	{"DZ-30", "Ouargla", "province", ""},
+	{"DZ-31", "Oran", "province", ""},
+	{"DZ-32", "El Bayadh", "province", ""},
+	{"DZ-33", "Illizi", "province", ""},
+	{"DZ-34", "Bordj Bou Arréridj", "province", ""},
+	{"DZ-35", "Boumerdès", "province", ""},
+	{"DZ-36", "El Tarf", "province", ""},
+	{"DZ-37", "Tindouf", "province", ""},
+	{"DZ-38", "Tissemsilt", "province", ""},
+	{"DZ-39", "El Oued", "province", ""},
+	{"DZ-40", "Khenchela", "province", ""},
+	{"DZ-41", "Souk Ahras", "province", ""},
+	{"DZ-42", "Tipaza", "province", ""},
+	{"DZ-43", "Mila", "province", ""},
+	{"DZ-44", "Aïn Defla", "province", ""},
+	{"DZ-45", "Naama", "province", ""},
+	{"DZ-46", "Aïn Témouchent", "province", ""},
+	{"DZ-47", "Ghardaïa", "province", ""},
+	{"DZ-48", "Relizane", "province", ""},
+	{"EC-A", "Azuay", "province", ""},
+	{"EC-B", "Bolívar", "province", ""},
+	{"EC-C", "Carchi", "province", ""},
+	{"EC-D", "Orellana", "province", ""},
+	{"EC-E", "Esmeraldas", "province", ""},
+	{"EC-F", "Cañar", "province", ""},
+	{"EC-G", "Guayas", "province", ""},
+	{"EC-H", "Chimborazo", "province", ""},
+	{"EC-I", "Imbabura", "province", ""},
+	{"EC-L", "Loja", "province", ""},
+	{"EC-M", "Manabí", "province", ""},
+	{"EC-N", "Napo", "province", ""},
+	{"EC-O", "El Oro", "province", ""},
+	{"EC-P", "Pichincha", "province", ""},
+	{"EC-R", "Los Ríos", "province", ""},
+	{"EC-S", "Morona Santiago", "province", ""},
+	{"EC-SD", "Santo Domingo de los Tsáchilas", "province", ""},
+	{"EC-SE", "Santa Elena", "province", ""},
+	{"EC-T", "Tungurahua", "province", ""},
+	{"EC-U", "Sucumbíos", "province", ""},
+	{"EC-W", "Galápagos", "province", ""},
+	{"EC-X", "Cotopaxi", "province", ""},
+	{"EC-Y", "Pastaza", "province", ""},
+	{"EC-Z", "Zamora Chinchipe", "province", ""},
+	{"EE-130", "Alutaguse", "rural municipality", "EE-45"},
+	{"EE-141", "Anija", "rural municipality", "EE-37"},
+	{"EE-142", "Antsla", "rural municipality", "EE-87"},
+	{"EE-171", "Elva", "rural municipality", "EE-79"},
+	{"EE-184", "Haapsalu", "urban municipality", "EE-56"},
+	{"EE-191", "Haljala", "rural municipality", "EE-60"},
+	{"EE-198", "Harku", "rural municipality", "EE-37"},
+	{"EE-205", "Hiiumaa", "rural municipality", "EE-39"},
+	{"EE-214", "Häädemeeste", "rural municipality", "EE-68"},
+	{"EE-245", "Jõelähtme", "rural municipality", "EE-37"},
+	{"EE-247", "Jõgeva", "rural municipality", "EE-50"},
+	{"EE-251", "Jõhvi", "rural municipality", "EE-45"},
+	{"EE-255", "Järva", "rural municipality", "EE-52"},
+	{"EE-272", "Kadrina", "rural municipality", "EE-60"},
+	{"EE-283", "Kambja", "rural municipality", "EE-79"},
+	{"EE-284", "Kanepi", "rural municipality", "EE-64"},
+	{"EE-291", "Kastre", "rural municipality", "EE-79"},
+	{"EE-293", "Kehtna", "rural municipality", "EE-71"},
+	{"EE-296", "Keila", "urban municipality", "EE-37"},
+	{"EE-303", "Kihnu", "rural municipality", "EE-68"},
+	{"EE-305", "Kiili", "rural municipality", "EE-37"},
+	{"EE-317", "Kohila", "rural municipality", "EE-71"},
+	{"EE-321", "Kohtla-Järve", "urban municipality", "EE-45"},
+	{"EE-338", "Kose", "rural municipality", "EE-37"},
+	{"EE-353", "Kuusalu", "rural municipality", "EE-37"},
+	{"EE-37", "Harjumaa", "county", ""},
+	{"EE-39", "Hiiumaa", "county", ""},
+	{"EE-424", "Loksa", "urban municipality", "EE-37"},
+	{"EE-430", "Lääneranna", "rural municipality", "EE-68"},
+	{"EE-431", "Lääne-Harju", "rural municipality", "EE-37"},
+	{"EE-432", "Luunja", "rural municipality", "EE-79"},
+	{"EE-441", "Lääne-Nigula", "rural municipality", "EE-56"},
+	{"EE-442", "Lüganuse", "rural municipality", "EE-45"},
+	{"EE-446", "Maardu", "urban municipality", "EE-37"},
+	{"EE-45", "Ida-Virumaa", "county", ""},
+	{"EE-478", "Muhu", "rural municipality", "EE-74"},
+	{"EE-480", "Mulgi", "rural municipality", "EE-84"},
+	{"EE-486", "Mustvee", "rural municipality", "EE-50"},
+	{"EE-50", "Jõgevamaa", "county", ""},
+	{"EE-503", "Märjamaa", "rural municipality", "EE-71"},
+	{"EE-511", "Narva", "urban municipality", "EE-45"},
+	{"EE-514", "Narva-Jõesuu", "urban municipality", "EE-45"},
+	{"EE-52", "Järvamaa", "county", ""},
+	{"EE-528", "Nõo", "rural municipality", "EE-79"},
+	{"EE-557", "Otepää", "rural municipality", "EE-81"},
+	{"EE-56", "Läänemaa", "county", ""},
+	{"EE-567", "Paide", "urban municipality", "EE-52"},
+	{"EE-586", "Peipsiääre", "rural municipality", "EE-79"},
+	{"EE-60", "Lääne-Virumaa", "county", ""},
+	{"EE-615", "Põhja-Sakala", "rural municipality", "EE-84"},
+	{"EE-618", "Põltsamaa", "rural municipality", "EE-50"},
+	{"EE-622", "Põlva", "rural municipality", "EE-64"},
+	{"EE-624", "Pärnu", "urban municipality", "EE-68"},
+	{"EE-638", "Põhja-Pärnumaa", "rural municipality", "EE-68"},
+	{"EE-64", "Põlvamaa", "county", ""},
+	{"EE-651", "Raasiku", "rural municipality", "EE-37"},
+	{"EE-653", "Rae", "rural municipality", "EE-37"},
+	{"EE-661", "Rakvere", "rural municipality", "EE-60"},
+	{"EE-663", "Rakvere", "urban municipality", "EE-60"},
+	{"EE-668", "Rapla", "rural municipality", "EE-71"},
+	{"EE-68", "Pärnumaa", "county", ""},
+	{"EE-689", "Ruhnu", "rural municipality", "EE-74"},
+	{"EE-698", "Rõuge", "rural municipality", "EE-87"},
+	{"EE-708", "Räpina", "rural municipality", "EE-64"},
+	{"EE-71", "Raplamaa", "county", ""},
+	{"EE-712", "Saarde", "rural municipality", "EE-68"},
+	{"EE-714", "Saaremaa", "rural municipality", "EE-74"},
+	{"EE-719", "Saku", "rural municipality", "EE-37"},
+	{"EE-726", "Saue", "rural municipality", "EE-37"},
+	{"EE-732", "Setomaa", "rural municipality", "EE-87"},
+	{"EE-735", "Sillamäe", "urban municipality", "EE-45"},
+	{"EE-74", "Saaremaa", "county", ""},
+	{"EE-784", "Tallinn", "urban municipality", "EE-37"},
+	{"EE-79", "Tartumaa", "county", ""},
+	{"EE-792", "Tapa", "rural municipality", "EE-60"},
+	{"EE-793", "Tartu", "urban municipality", "EE-79"},
+	{"EE-796", "Tartu", "rural municipality", "EE-79"},
+	{"EE-803", "Toila", "rural municipality", "EE-45"},
+	{"EE-809", "Tori", "rural municipality", "EE-68"},
+	{"EE-81", "Valgamaa", "county", ""},
+	{"EE-824", "Tõrva", "rural municipality", "EE-81"},
+	{"EE-834", "Türi", "rural municipality", "EE-52"},
+	{"EE-84", "Viljandimaa", "county", ""},
+	{"EE-855", "Valga", "rural municipality", "EE-81"},
+	{"EE-87", "Võrumaa", "county", ""},
+	{"EE-890", "Viimsi", "rural municipality", "EE-37"},
+	{"EE-897", "Viljandi", "urban municipality", "EE-84"},
+	{"EE-899", "Viljandi", "rural municipality", "EE-84"},
+	{"EE-901", "Vinni", "rural municipality", "EE-60"},
+	{"EE-903", "Viru-Nigula", "rural municipality", "EE-60"},
+	{"EE-907", "Vormsi", "rural municipality", "EE-56"},
+	{"EE-917", "Võru", "rural municipality", "EE-87"},
+	{"EE-919", "Võru", "urban municipality", "EE-87"},
+	{"EE-928", "Väike-Maarja", "rural municipality", "EE-60"},
+	{"EG-ALX", "Al Iskandarīyah", "governorate", ""},
+	{"EG-ASN", "Aswān", "governorate", ""},
+	{"EG-AST", "Asyūţ", "governorate", ""},
+	{"EG-BA", "Al Baḩr al Aḩmar", "governorate", ""},
+	{"EG-BH", "Al Buḩayrah", "governorate", ""},
+	{"EG-BNS", "Banī Suwayf", "governorate", ""},
+	{"EG-C", "Al Qāhirah", "governorate", ""},
+	{"EG-DK", "Ad Daqahlīyah", "governorate", ""},
+	{"EG-DT", "Dumyāţ", "governorate", ""},
+	{"EG-FYM", "Al Fayyūm", "governorate", ""},
+	{"EG-GH", "Al Gharbīyah", "governorate", ""},
+	{"EG-GZ", "Al Jīzah", "governorate", ""},
+	{"EG-IS", "Al Ismā'īlīyah", "governorate", ""},
+	{"EG-JS", "Janūb Sīnā'", "governorate", ""},
+	{"EG-KB", "Al Qalyūbīyah", "governorate", ""},
+	{"EG-KFS", "Kafr ash Shaykh", "governorate", ""},
+	{"EG-KN", "Qinā", "governorate", ""},
+	{"EG-LX", "Al Uqşur", "governorate", ""},
+	{"EG-MN", "Al Minyā", "governorate", ""},
+	{"EG-MNF", "Al Minūfīyah", "governorate", ""},
+	{"EG-MT", "Maţrūḩ", "governorate", ""},
+	{"EG-PTS", "Būr Sa‘īd", "governorate", ""},
+	{"EG-SHG", "Sūhāj", "governorate", ""},
+	{"EG-SHR", "Ash Sharqīyah", "governorate", ""},
+	{"EG-SIN", "Shamāl Sīnā'", "governorate", ""},
+	{"EG-SUZ", "As Suways", "governorate", ""},
+	{"EG-WAD", "Al Wādī al Jadīd", "governorate", ""},
+	{"ER-AN", "Ansabā", "region", ""},
+	{"ER-DK", "Debubawi K’eyyĭḥ Baḥri", "region", ""},
+	{"ER-DU", "Al Janūbī", "region", ""},
+	{"ER-GB", "Gash-Barka", "region", ""},
+	{"ER-MA", "Al Awsaţ", "region", ""},
+	{"ER-SK", "Semienawi K’eyyĭḥ Baḥri", "region", ""},
+	{"ES-A", "Alacant*", "province", "ES-VC"},
+	{"ES-AB", "Albacete", "province", "ES-CM"},
+	{"ES-AL", "Almería", "province", "ES-AN"},
+	{"ES-AN", "Andalucía", "autonomous community", ""},
+	{"ES-AR", "Aragón", "autonomous community", ""},
+	{"ES-AS", "Asturias, Principado de", "autonomous community", ""},
+	{"ES-AV", "Ávila", "province", "ES-CL"},
+	{"ES-B", "Barcelona [Barcelona]", "province", "ES-CT"},
+	{"ES-BA", "Badajoz", "province", "ES-EX"},
+	{"ES-BI", "Bizkaia", "province", "ES-PV"},
+	{"ES-BU", "Burgos", "province", "ES-CL"},
+	{"ES-C", "A Coruña [La Coruña]", "province", "ES-GA"},
+	{"ES-CA", "Cádiz", "province", "ES-AN"},
+	{"ES-CB", "Cantabria", "autonomous community", ""},
+	{"ES-CC", "Cáceres", "province", "ES-EX"},
+	{"ES-CE", "Ceuta", "autonomous city in North Africa", ""},
+	{"ES-CL", "Castilla y León", "autonomous community", ""},
+	{"ES-CM", "Castilla-La Mancha", "autonomous community", ""},
+	{"ES-CN", "Canarias", "autonomous community", ""},
+	{"ES-CO", "Córdoba", "province", "ES-AN"},
+	{"ES-CR", "Ciudad Real", "province", "ES-CM"},
+	{"ES-CS", "Castelló*", "province", "ES-VC"},
+	{"ES-CT", "Catalunya", "autonomous community", ""},
+	{"ES-CU", "Cuenca", "province", "ES-CM"},
+	{"ES-EX", "Extremadura", "autonomous community", ""},
+	{"ES-GA", "Galicia", "autonomous community", ""},
+	{"ES-GC", "Las Palmas", "province", "ES-CN"},
+	{"ES-GI", "Girona [Gerona]", "province", "ES-CT"},
+	{"ES-GR", "Granada", "province", "ES-AN"},
+	{"ES-GU", "Guadalajara", "province", "ES-CM"},
+	{"ES-H", "Huelva", "province", "ES-AN"},
+	{"ES-HU", "Huesca", "province", "ES-AR"},
+	{"ES-IB", "Illes Balears", "autonomous community", ""},
+	{"ES-J", "Jaén", "province", "ES-AN"},
+	{"ES-L", "Lleida [Lérida]", "province", "ES-CT"},
+	{"ES-LE", "León", "province", "ES-CL"},
+	{"ES-LO", "La Rioja", "province", "ES-RI"},
+	{"ES-LU", "Lugo [Lugo]", "province", "ES-GA"},
+	{"ES-M", "Madrid", "province", "ES-MD"},
+	{"ES-MA", "Málaga", "province", "ES-AN"},
+	{"ES-MC", "Murcia, Región de", "autonomous community", ""},
+	{"ES-MD", "Madrid, Comunidad de", "autonomous community", ""},
+	{"ES-ML", "Melilla", "autonomous city in North Africa", ""},
+	{"ES-MU", "Murcia", "province", "ES-MC"},
+	{"ES-NA", "Nafarroa*", "province", "ES-NC"},
+	{"ES-NC", "Navarra, Comunidad Foral de", "autonomous community", ""},
+	{"ES-O", "Asturias", "province", "ES-AS"},
+	{"ES-OR", "Ourense [Orense]", "province", "ES-GA"},
+	{"ES-P", "Palencia", "province", "ES-CL"},
+	{"ES-PM", "Illes Balears [Islas Baleares]", "province", "ES-IB"},
+	{"ES-PO", "Pontevedra [Pontevedra]", "province", "ES-GA"},
+	{"ES-PV", "País Vasco", "autonomous community", ""},
+	{"ES-RI", "La Rioja", "autonomous community", ""},
+	{"ES-S", "Cantabria", "province", "ES-CB"},
+	{"ES-SA", "Salamanca", "province", "ES-CL"},
+	{"ES-SE", "Sevilla", "province", "ES-AN"},
+	{"ES-SG", "Segovia", "province", "ES-CL"},
+	{"ES-SO", "Soria", "province", "ES-CL"},
+	{"ES-SS", "Gipuzkoa", "province", "ES-PV"},
+	{"ES-T", "Tarragona [Tarragona]", "province", "ES-CT"},
+	{"ES-TE", "Teruel", "province", "ES-AR"},
+	{"ES-TF", "Santa Cruz de Tenerife", "province", "ES-CN"},
+	{"ES-TO", "Toledo", "province", "ES-CM"},
+	{"ES-V", "Valencia", "province", "ES-VC"},
+	{"ES-VA", "Valladolid", "province", "ES-CL"},
+	{"ES-VC", "Valenciana, Comunidad", "autonomous community", ""},
+	{"ES-VI", "Araba*", "province", "ES-PV"},
+	{"ES-Z", "Zaragoza", "province", "ES-AR"},
+	{"ES-ZA", "Zamora", "province", "ES-CL"},
+	{"ET-AA", "Addis Ababa", "administration", ""},
+	{"ET-AF", "Afar", "regional state", ""},
+	{"ET-AM", "Amara", "regional state", ""},
+	{"ET-BE", "Benshangul-Gumaz", "regional state", ""},
+	{"ET-DD", "Dire Dawa", "administration", ""},
+	{"ET-GA", "Gambela Peoples", "regional state", ""},
+	{"ET-HA", "Harari People", "regional state", ""},
+	{"ET-OR", "Oromia", "regional state", ""},
+	{"ET-SN", "Southern Nations, Nationalities and Peoples", "regional state", ""},
+	{"ET-SO", "Somali", "regional state", ""},
+	{"ET-TI", "Tigrai", "regional state", ""},
+	{"FI-01", "Åland", "region", ""},
+	{"FI-02", "Etelä-Karjala", "region", ""},
+	{"FI-03", "Etelä-Pohjanmaa", "region", ""},
+	{"FI-04", "Etelä-Savo", "region", ""},
+	{"FI-05", "Kainuu", "region", ""},
+	{"FI-06", "Kanta-Häme", "region", ""},
+	{"FI-07", "Keski-Pohjanmaa", "region", ""},
+	{"FI-08", "Keski-Suomi", "region", ""},
+	{"FI-09", "Kymenlaakso", "region", ""},
+	{"FI-10", "Lappi", "region", ""},
+	{"FI-11", "Pirkanmaa", "region", ""},
+	{"FI-12", "Pohjanmaa", "region", ""},
+	{"FI-13", "Pohjois-Karjala", "region", ""},
+	{"FI-14", "Pohjois-Pohjanmaa", "region", ""},
+	{"FI-15", "Pohjois-Savo", "region", ""},
+	{"FI-16", "Päijät-Häme", "region", ""},
+	{"FI-17", "Satakunta", "region", ""},
+	{"FI-18", "Uusimaa", "region", ""},
+	{"FI-19", "Varsinais-Suomi", "region", ""},
+	{"FJ-01", "Ba", "province", "FJ-W"},
+	{"FJ-02", "Bua", "province", "FJ-N"},
+	{"FJ-03", "Cakaudrove", "province", "FJ-N"},
+	{"FJ-04", "Kadavu", "province", "FJ-E"},
+	{"FJ-05", "Lau", "province", "FJ-E"},
+	{"FJ-06", "Lomaiviti", "province", "FJ-E"},
+	{"FJ-07", "Macuata", "province", "FJ-N"},
+	{"FJ-08", "Nadroga and Navosa", "province", "FJ-W"},
+	{"FJ-09", "Naitasiri", "province", "FJ-C"},
+	{"FJ-10", "Namosi", "province", "FJ-C"},
+	{"FJ-11", "Ra", "province", "FJ-W"},
+	{"FJ-12", "Rewa", "province", "FJ-C"},
+	{"FJ-13", "Serua", "province", "FJ-C"},
+	{"FJ-14", "Tailevu", "province", "FJ-C"},
+	{"FJ-C", "Central", "division", ""},
+	{"FJ-E", "Eastern", "division", ""},
+	{"FJ-N", "Northern", "division", ""},
+	{"FJ-R", "Rotuma", "dependency", ""},
+	{"FJ-W", "Western", "division", ""},
+	{"FM-KSA", "Kosrae", "state", ""},
+	{"FM-PNI", "Pohnpei", "state", ""},
+	{"FM-TRK", "Chuuk", "state", ""},
+	{"FM-YAP", "Yap", "state", ""},
+	{"FR-01", "Ain", "metropolitan department", "FR-ARA"},
+	{"FR-02", "Aisne", "metropolitan department", "FR-HDF"},
+	{"FR-03", "Allier", "metropolitan department", "FR-ARA"},
+	{"FR-04", "Alpes-de-Haute-Provence", "metropolitan department", "FR-PAC"},
+	{"FR-05", "Hautes-Alpes", "metropolitan department", "FR-PAC"},
+	{"FR-06", "Alpes-Maritimes", "metropolitan department", "FR-PAC"},
+	{"FR-07", "Ardèche", "metropolitan department", "FR-ARA"},
+	{"FR-08", "Ardennes", "metropolitan department", "FR-GES"},
+	{"FR-09", "Ariège", "metropolitan department", "FR-OCC"},
+	{"FR-10", "Aube", "metropolitan department", "FR-GES"},
+	{"FR-11", "Aude", "metropolitan department", "FR-OCC"},
+	{"FR-12", "Aveyron", "metropolitan department", "FR-OCC"},
+	{"FR-13", "Bouches-du-Rhône", "metropolitan department", "FR-PAC"},
+	{"FR-14", "Calvados", "metropolitan department", "FR-NOR"},
+	{"FR-15", "Cantal", "metropolitan department", "FR-ARA"},
+	{"FR-16", "Charente", "metropolitan department", "FR-NAQ"},
+	{"FR-17", "Charente-Maritime", "metropolitan department", "FR-NAQ"},
+	{"FR-18", "Cher", "metropolitan department", "FR-CVL"},
+	{"FR-19", "Corrèze", "metropolitan department", "FR-NAQ"},
+	{"FR-20R", "Corse", "metropolitan collectivity with special status", ""},
+	{"FR-21", "Côte-d'Or", "metropolitan department", "FR-BFC"},
+	{"FR-22", "Côtes-d'Armor", "metropolitan department", "FR-BRE"},
+	{"FR-23", "Creuse", "metropolitan department", "FR-NAQ"},
+	{"FR-24", "Dordogne", "metropolitan department", "FR-NAQ"},
+	{"FR-25", "Doubs", "metropolitan department", "FR-BFC"},
+	{"FR-26", "Drôme", "metropolitan department", "FR-ARA"},
+	{"FR-27", "Eure", "metropolitan department", "FR-NOR"},
+	{"FR-28", "Eure-et-Loir", "metropolitan department", "FR-CVL"},
+	{"FR-29", "Finistère", "metropolitan department", "FR-BRE"},
+	{"FR-2A", "Corse-du-Sud", "metropolitan department", "FR-20R"},
+	{"FR-2B", "Haute-Corse", "metropolitan department", "FR-20R"},
+	{"FR-30", "Gard", "metropolitan department", "FR-OCC"},
+	{"FR-31", "Haute-Garonne", "metropolitan department", "FR-OCC"},
+	{"FR-32", "Gers", "metropolitan department", "FR-OCC"},
+	{"FR-33", "Gironde", "metropolitan department", "FR-NAQ"},
+	{"FR-34", "Hérault", "metropolitan department", "FR-OCC"},
+	{"FR-35", "Ille-et-Vilaine", "metropolitan department", "FR-BRE"},
+	{"FR-36", "Indre", "metropolitan department", "FR-CVL"},
+	{"FR-37", "Indre-et-Loire", "metropolitan department", "FR-CVL"},
+	{"FR-38", "Isère", "metropolitan department", "FR-ARA"},
+	{"FR-39", "Jura", "metropolitan department", "FR-BFC"},
+	{"FR-40", "Landes", "metropolitan department", "FR-NAQ"},
+	{"FR-41", "Loir-et-Cher", "metropolitan department", "FR-CVL"},
+	{"FR-42", "Loire", "metropolitan department", "FR-ARA"},
+	{"FR-43", "Haute-Loire", "metropolitan department", "FR-ARA"},
+	{"FR-44", "Loire-Atlantique", "metropolitan department", "FR-PDL"},
+	{"FR-45", "Loiret", "metropolitan department", "FR-CVL"},
+	{"FR-46", "Lot", "metropolitan department", "FR-OCC"},
+	{"FR-47", "Lot-et-Garonne", "metropolitan department", "FR-NAQ"},
+	{"FR-48", "Lozère", "metropolitan department", "FR-OCC"},
+	{"FR-49", "Maine-et-Loire", "metropolitan department", "FR-PDL"},
+	{"FR-50", "Manche", "metropolitan department", "FR-NOR"},
+	{"FR-51", "Marne", "metropolitan department", "FR-GES"},
+	{"FR-52", "Haute-Marne", "metropolitan department", "FR-GES"},
+	{"FR-53", "Mayenne", "metropolitan department", "FR-PDL"},
+	{"FR-54", "Meurthe-et-Moselle", "metropolitan department", "FR-GES"},
+	{"FR-55", "Meuse", "metropolitan department", "FR-GES"},
+	{"FR-56", "Morbihan", "metropolitan department", "FR-BRE"},
+	{"FR-57", "Moselle", "metropolitan department", "FR-GES"},
+	{"FR-58", "Nièvre", "metropolitan department", "FR-BFC"},
+	{"FR-59", "Nord", "metropolitan department", "FR-HDF"},
+	{"FR-60", "Oise", "metropolitan department", "FR-HDF"},
+	{"FR-61", "Orne", "metropolitan department", "FR-NOR"},
+	{"FR-62", "Pas-de-Calais", "metropolitan department", "FR-HDF"},
+	{"FR-63", "Puy-de-Dôme", "metropolitan department", "FR-ARA"},
+	{"FR-64", "Pyrénées-Atlantiques", "metropolitan department", "FR-NAQ"},
+	{"FR-65", "Hautes-Pyrénées", "metropolitan department", "FR-OCC"},
+	{"FR-66", "Pyrénées-Orientales", "metropolitan department", "FR-OCC"},
+	{"FR-67", "Bas-Rhin", "metropolitan department", "FR-GES"},
+	{"FR-68", "Haut-Rhin", "metropolitan department", "FR-GES"},
+	{"FR-69", "Rhône", "metropolitan department", "FR-ARA"},
+	{"FR-70", "Haute-Saône", "metropolitan department", "FR-BFC"},
+	{"FR-71", "Saône-et-Loire", "metropolitan department", "FR-BFC"},
+	{"FR-72", "Sarthe", "metropolitan department", "FR-PDL"},
+	{"FR-73", "Savoie", "metropolitan department", "FR-ARA"},
+	{"FR-74", "Haute-Savoie", "metropolitan department", "FR-ARA"},
+	{"FR-75", "Paris", "metropolitan department", "FR-IDF"},
+	{"FR-76", "Seine-Maritime", "metropolitan department", "FR-NOR"},
+	{"FR-77", "Seine-et-Marne", "metropolitan department", "FR-IDF"},
+	{"FR-78", "Yvelines", "metropolitan department", "FR-IDF"},
+	{"FR-79", "Deux-Sèvres", "metropolitan department", "FR-NAQ"},
+	{"FR-80", "Somme", "metropolitan department", "FR-HDF"},
+	{"FR-81", "Tarn", "metropolitan department", "FR-OCC"},
+	{"FR-82", "Tarn-et-Garonne", "metropolitan department", "FR-OCC"},
+	{"FR-83", "Var", "metropolitan department", "FR-PAC"},
+	{"FR-84", "Vaucluse", "metropolitan department", "FR-PAC"},
+	{"FR-85", "Vendée", "metropolitan department", "FR-PDL"},
+	{"FR-86", "Vienne", "metropolitan department", "FR-NAQ"},
+	{"FR-87", "Haute-Vienne", "metropolitan department", "FR-NAQ"},
+	{"FR-88", "Vosges", "metropolitan department", "FR-GES"},
+	{"FR-89", "Yonne", "metropolitan department", "FR-BFC"},
+	{"FR-90", "Territoire de Belfort", "metropolitan department", "FR-BFC"},
+	{"FR-91", "Essonne", "metropolitan department", "FR-IDF"},
+	{"FR-92", "Hauts-de-Seine", "metropolitan department", "FR-IDF"},
+	{"FR-93", "Seine-Saint-Denis", "metropolitan department", "FR-IDF"},
+	{"FR-94", "Val-de-Marne", "metropolitan department", "FR-IDF"},
+	{"FR-95", "Val-d'Oise", "metropolitan department", "FR-IDF"},
+	{"FR-971", "Guadeloupe", "overseas department", "FR-GP"},
+	{"FR-972", "Martinique", "overseas department", "FR-MQ"},
+	{"FR-973", "Guyane (française)", "overseas department", "FR-GF"},
+	{"FR-974", "La Réunion", "overseas department", "FR-RE"},
+	{"FR-976", "Mayotte", "overseas department", "FR-YT"},
+	{"FR-ARA", "Auvergne-Rhône-Alpes", "metropolitan region", ""},
+	{"FR-BFC", "Bourgogne-Franche-Comté", "metropolitan region", ""},
+	{"FR-BL", "Saint-Barthélemy", "overseas collectivity", ""},
+	{"FR-BRE", "Bretagne", "metropolitan region", ""},
+	{"FR-CP", "Clipperton", "dependency", ""},
+	{"FR-CVL", "Centre-Val de Loire", "metropolitan region", ""},
+	{"FR-GES", "Grand-Est", "metropolitan region", ""},
+	{"FR-GF", "Guyane (française)", "overseas region", ""},
+	{"FR-GP", "Guadeloupe", "overseas region", ""},
+	{"FR-HDF", "Hauts-de-France", "metropolitan region", ""},
+	{"FR-IDF", "Île-de-France", "metropolitan region", ""},
+	{"FR-MF", "Saint-Martin", "overseas collectivity", ""},
+	{"FR-MQ", "Martinique", "overseas region", ""},
+	{"FR-NAQ", "Nouvelle-Aquitaine", "metropolitan region", ""},
+	{"FR-NC", "Nouvelle-Calédonie", "overseas collectivity with special status", ""},
+	{"FR-NOR", "Normandie", "metropolitan region", ""},
+	{"FR-OCC", "Occitanie", "metropolitan region", ""},
+	{"FR-PAC", "Provence-Alpes-Côte-d'Azur", "metropolitan region", ""},
+	{"FR-PDL", "Pays-de-la-Loire", "metropolitan region", ""},
+	{"FR-PF", "Polynésie française", "overseas collectivity", ""},
+	{"FR-PM", "Saint-Pierre-et-Miquelon", "overseas collectivity", ""},
+	{"FR-RE", "La Réunion", "overseas region", ""},
+	{"FR-TF", "Terres australes françaises", "overseas territory", ""},
+	{"FR-WF", "Wallis-et-Futuna", "overseas collectivity", ""},
+	{"FR-YT", "Mayotte", "overseas region", ""},
+	{"GA-1", "Estuaire", "province", ""},
+	{"GA-2", "Haut-Ogooué", "province", ""},
+	{"GA-3", "Moyen-Ogooué", "province", ""},
+	{"GA-4", "Ngounié", "province", ""},
+	{"GA-5", "Nyanga", "province", ""},
+	{"GA-6", "Ogooué-Ivindo", "province", ""},
+	{"GA-7", "Ogooué-Lolo", "province", ""},
+	{"GA-8", "Ogooué-Maritime", "province", ""},
+	{"GA-9", "Woleu-Ntem", "province", ""},
+	{"GB-ABC", "Armagh City, Banbridge and Craigavon", "district", "GB-NIR"},
+	{"GB-ABD", "Aberdeenshire", "council area", "GB-SCT"},
+	{"GB-ABE", "Aberdeen City", "council area", "GB-SCT"},
+	{"GB-AGB", "Argyll and Bute", "council area", "GB-SCT"},
+	{"GB-AGY", "Isle of Anglesey [Sir Ynys Môn GB-YNM]", "unitary authority", "GB-WLS"},
+	{"GB-AND", "Ards and North Down", "district", "GB-NIR"},
+	{"GB-ANN", "Antrim and Newtownabbey", "district", "GB-NIR"},
+	{"GB-ANS", "Angus", "council area", "GB-SCT"},
+	{"GB-BAS", "Bath and North East Somerset", "unitary authority", "GB-ENG"},
+	{"GB-BBD", "Blackburn with Darwen", "unitary authority", "GB-ENG"},
+	{"GB-BCP", "Bournemouth, Christchurch and Poole", "unitary authority", "GB-ENG"},
+	{"GB-BDF", "Bedford", "unitary authority", "GB-ENG"},
+	{"GB-BDG", "Barking and Dagenham", "london borough", "GB-ENG"},
+	{"GB-BEN", "Brent", "london borough", "GB-ENG"},
+	{"GB-BEX", "Bexley", "london borough", "GB-ENG"},
+	{"GB-BFS", "Belfast City", "district", "GB-NIR"},
+	{"GB-BGE", "Bridgend [Pen-y-bont ar Ogwr GB-POG]", "unitary authority", "GB-WLS"},
+	{"GB-BGW", "Blaenau Gwent", "unitary authority", "GB-WLS"},
+	{"GB-BIR", "Birmingham", "metropolitan district", "GB-ENG"},
+	{"GB-BKM", "Buckinghamshire", "two-tier county", "GB-ENG"},
+	{"GB-BNE", "Barnet", "london borough", "GB-ENG"},
+	{"GB-BNH", "Brighton and Hove", "unitary authority", "GB-ENG"},
+	{"GB-BNS", "Barnsley", "metropolitan district", "GB-ENG"},
+	{"GB-BOL", "Bolton", "metropolitan district", "GB-ENG"},
+	{"GB-BPL", "Blackpool", "unitary authority", "GB-ENG"},
+	{"GB-BRC", "Bracknell Forest", "unitary authority", "GB-ENG"},
+	{"GB-BRD", "Bradford", "metropolitan district", "GB-ENG"},
+	{"GB-BRY", "Bromley", "london borough", "GB-ENG"},
+	{"GB-BST", "Bristol, City of", "unitary authority", "GB-ENG"},
+	{"GB-BUR", "Bury", "metropolitan district", "GB-ENG"},
+	{"GB-CAM", "Cambridgeshire", "two-tier county", "GB-ENG"},
+	{"GB-CAY", "Caerphilly [Caerffili GB-CAF]", "unitary authority", "GB-WLS"},
+	{"GB-CBF", "Central Bedfordshire", "unitary authority", "GB-ENG"},
+	{"GB-CCG", "Causeway Coast and Glens", "district", "GB-NIR"},
+	{"GB-CGN", "Ceredigion [Sir Ceredigion]", "unitary authority", "GB-WLS"},
+	{"GB-CHE", "Cheshire East", "unitary authority", "GB-ENG"},
+	{"GB-CHW", "Cheshire West and Chester", "unitary authority", "GB-ENG"},
+	{"GB-CLD", "Calderdale", "metropolitan district", "GB-ENG"},
+	{"GB-CLK", "Clackmannanshire", "council area", "GB-SCT"},
+	{"GB-CMA", "Cumbria", "two-tier county", "GB-ENG"},
+	{"GB-CMD", "Camden", "london borough", "GB-ENG"},
+	{"GB-CMN", "Carmarthenshire [Sir Gaerfyrddin GB-GFY]", "unitary authority", "GB-WLS"},
+	{"GB-CON", "Cornwall", "unitary authority", "GB-ENG"},
+	{"GB-COV", "Coventry", "metropolitan district", "GB-ENG"},
+	{"GB-CRF", "Cardiff [Caerdydd GB-CRD]", "unitary authority", "GB-WLS"},
+	{"GB-CRY", "Croydon", "london borough", "GB-ENG"},
+	{"GB-CWY", "Conwy", "unitary authority", "GB-WLS"},
+	{"GB-DAL", "Darlington", "unitary authority", "GB-ENG"},
+	{"GB-DBY", "Derbyshire", "two-tier county", "GB-ENG"},
+	{"GB-DEN", "Denbighshire [Sir Ddinbych GB-DDB]", "unitary authority", "GB-WLS"},
+	{"GB-DER", "Derby", "unitary authority", "GB-ENG"},
+	{"GB-DEV", "Devon", "two-tier county", "GB-ENG"},
+	{"GB-DGY", "Dumfries and Galloway", "council area", "GB-SCT"},
+	{"GB-DNC", "Doncaster", "metropolitan district", "GB-ENG"},
+	{"GB-DND", "Dundee City", "council area", "GB-SCT"},
+	{"GB-DOR", "Dorset", "two-tier county", "GB-ENG"},
+	{"GB-DRS", "Derry and Strabane", "district", "GB-NIR"},
+	{"GB-DUD", "Dudley", "metropolitan district", "GB-ENG"},
+	{"GB-DUR", "Durham, County", "unitary authority", "GB-ENG"},
+	{"GB-EAL", "Ealing", "london borough", "GB-ENG"},
+	{"GB-EAY", "East Ayrshire", "council area", "GB-SCT"},
+	{"GB-EDH", "Edinburgh, City of", "council area", "GB-SCT"},
+	{"GB-EDU", "East Dunbartonshire", "council area", "GB-SCT"},
+	{"GB-ELN", "East Lothian", "council area", "GB-SCT"},
+	{"GB-ELS", "Eilean Siar", "council area", "GB-SCT"},
+	{"GB-ENF", "Enfield", "london borough", "GB-ENG"},
+	{"GB-ENG", "England", "country", ""},
+	{"GB-ERW", "East Renfrewshire", "council area", "GB-SCT"},
+	{"GB-ERY", "East Riding of Yorkshire", "unitary authority", "GB-ENG"},
+	{"GB-ESS", "Essex", "two-tier county", "GB-ENG"},
+	{"GB-ESX", "East Sussex", "two-tier county", "GB-ENG"},
+	{"GB-FAL", "Falkirk", "council area", "GB-SCT"},
+	{"GB-FIF", "Fife", "council area", "GB-SCT"},
+	{"GB-FLN", "Flintshire [Sir y Fflint GB-FFL]", "unitary authority", "GB-WLS"},
+	{"GB-FMO", "Fermanagh and Omagh", "district", "GB-NIR"},
+	{"GB-GAT", "Gateshead", "metropolitan district", "GB-ENG"},
+	{"GB-GLG", "Glasgow City", "council area", "GB-SCT"},
+	{"GB-GLS", "Gloucestershire", "two-tier county", "GB-ENG"},
+	{"GB-GRE", "Greenwich", "london borough", "GB-ENG"},
+	{"GB-GWN", "Gwynedd", "unitary authority", "GB-WLS"},
+	{"GB-HAL", "Halton", "unitary authority", "GB-ENG"},
+	{"GB-HAM", "Hampshire", "two-tier county", "GB-ENG"},
+	{"GB-HAV", "Havering", "london borough", "GB-ENG"},
+	{"GB-HCK", "Hackney", "london borough", "GB-ENG"},
+	{"GB-HEF", "Herefordshire", "unitary authority", "GB-ENG"},
+	{"GB-HIL", "Hillingdon", "london borough", "GB-ENG"},
+	{"GB-HLD", "Highland", "council area", "GB-SCT"},
+	{"GB-HMF", "Hammersmith and Fulham", "london borough", "GB-ENG"},
+	{"GB-HNS", "Hounslow", "london borough", "GB-ENG"},
+	{"GB-HPL", "Hartlepool", "unitary authority", "GB-ENG"},
+	{"GB-HRT", "Hertfordshire", "two-tier county", "GB-ENG"},
+	{"GB-HRW", "Harrow", "london borough", "GB-ENG"},
+	{"GB-HRY", "Haringey", "london borough", "GB-ENG"},
+	{"GB-IOS", "Isles of Scilly", "unitary authority", "GB-ENG"},
+	{"GB-IOW", "Isle of Wight", "unitary authority", "GB-ENG"},
+	{"GB-ISL", "Islington", "london borough", "GB-ENG"},
+	{"GB-IVC", "Inverclyde", "council area", "GB-SCT"},
+	{"GB-KEC", "Kensington and Chelsea", "london borough", "GB-ENG"},
+	{"GB-KEN", "Kent", "two-tier county", "GB-ENG"},
+	{"GB-KHL", "Kingston upon Hull", "unitary authority", "GB-ENG"},
+	{"GB-KIR", "Kirklees", "metropolitan district", "GB-ENG"},
+	{"GB-KTT", "Kingston upon Thames", "london borough", "GB-ENG"},
+	{"GB-KWL", "Knowsley", "metropolitan district", "GB-ENG"},
+	{"GB-LAN", "Lancashire", "two-tier county", "GB-ENG"},
+	{"GB-LBC", "Lisburn and Castlereagh", "district", "GB-NIR"},
+	{"GB-LBH", "Lambeth", "london borough", "GB-ENG"},
+	{"GB-LCE", "Leicester", "unitary authority", "GB-ENG"},
+	{"GB-LDS", "Leeds", "metropolitan district", "GB-ENG"},
+	{"GB-LEC", "Leicestershire", "two-tier county", "GB-ENG"},
+	{"GB-LEW", "Lewisham", "london borough", "GB-ENG"},
+	{"GB-LIN", "Lincolnshire", "two-tier county", "GB-ENG"},
+	{"GB-LIV", "Liverpool", "metropolitan district", "GB-ENG"},
+	{"GB-LND", "London, City of", "city corporation", "GB-ENG"},
+	{"GB-LUT", "Luton", "unitary authority", "GB-ENG"},
+	{"GB-MAN", "Manchester", "metropolitan district", "GB-ENG"},
+	{"GB-MDB", "Middlesbrough", "unitary authority", "GB-ENG"},
+	{"GB-MDW", "Medway", "unitary authority", "GB-ENG"},
+	{"GB-MEA", "Mid and East Antrim", "district", "GB-NIR"},
+	{"GB-MIK", "Milton Keynes", "unitary authority", "GB-ENG"},
+	{"GB-MLN", "Midlothian", "council area", "GB-SCT"},
+	{"GB-MON", "Monmouthshire [Sir Fynwy GB-FYN]", "unitary authority", "GB-WLS"},
+	{"GB-MRT", "Merton", "london borough", "GB-ENG"},
+	{"GB-MRY", "Moray", "council area", "GB-SCT"},
+	{"GB-MTY", "Merthyr Tydfil [Merthyr Tudful GB-MTU]", "unitary authority", "GB-WLS"},
+	{"GB-MUL", "Mid-Ulster", "district", "GB-NIR"},
+	{"GB-NAY", "North Ayrshire", "council area", "GB-SCT"},
+	{"GB-NBL", "Northumberland", "unitary authority", "GB-ENG"},
+	{"GB-NEL", "North East Lincolnshire", "unitary authority", "GB-ENG"},
+	{"GB-NET", "Newcastle upon Tyne", "metropolitan district", "GB-ENG"},
+	{"GB-NFK", "Norfolk", "two-tier county", "GB-ENG"},
+	{"GB-NGM", "Nottingham", "unitary authority", "GB-ENG"},
+	{"GB-NIR", "Northern Ireland", "province", ""},
+	{"GB-NLK", "North Lanarkshire", "council area", "GB-SCT"},
+	{"GB-NLN", "North Lincolnshire", "unitary authority", "GB-ENG"},
+	{"GB-NMD", "Newry, Mourne and Down", "district", "GB-NIR"},
+	{"GB-NSM", "North Somerset", "unitary authority", "GB-ENG"},
+	{"GB-NTH", "Northamptonshire", "two-tier county", "GB-ENG"},
+	{"GB-NTL", "Neath Port Talbot [Castell-nedd Port Talbot GB-CTL]", "unitary authority", "GB-WLS"},
+	{"GB-NTT", "Nottinghamshire", "two-tier county", "GB-ENG"},
+	{"GB-NTY", "North Tyneside", "metropolitan district", "GB-ENG"},
+	{"GB-NWM", "Newham", "london borough", "GB-ENG"},
+	{"GB-NWP", "Newport [Casnewydd GB-CNW]", "unitary authority", "GB-WLS"},
+	{"GB-NYK", "North Yorkshire", "two-tier county", "GB-ENG"},
+	{"GB-OLD", "Oldham", "metropolitan district", "GB-ENG"},
+	{"GB-ORK", "Orkney Islands", "council area", "GB-SCT"},
+	{"GB-OXF", "Oxfordshire", "two-tier county", "GB-ENG"},
+	{"GB-PEM", "Pembrokeshire [Sir Benfro GB-BNF]", "unitary authority", "GB-WLS"},
+	{"GB-PKN", "Perth and Kinross", "council area", "GB-SCT"},
+	{"GB-PLY", "Plymouth", "unitary authority", "GB-ENG"},
+	{"GB-POR", "Portsmouth", "unitary authority", "GB-ENG"},
+	{"GB-POW", "Powys", "unitary authority", "GB-WLS"},
+	{"GB-PTE", "Peterborough", "unitary authority", "GB-ENG"},
+	{"GB-RCC", "Redcar and Cleveland", "unitary authority", "GB-ENG"},
+	{"GB-RCH", "Rochdale", "metropolitan district", "GB-ENG"},
+	{"GB-RCT", "Rhondda Cynon Taff [Rhondda CynonTaf]", "unitary authority", "GB-WLS"},
+	{"GB-RDB", "Redbridge", "london borough", "GB-ENG"},
+	{"GB-RDG", "Reading", "unitary authority", "GB-ENG"},
+	{"GB-RFW", "Renfrewshire", "council area", "GB-SCT"},
+	{"GB-RIC", "Richmond upon Thames", "london borough", "GB-ENG"},
+	{"GB-ROT", "Rotherham", "metropolitan district", "GB-ENG"},
+	{"GB-RUT", "Rutland", "unitary authority", "GB-ENG"},
+	{"GB-SAW", "Sandwell", "metropolitan district", "GB-ENG"},
+	{"GB-SAY", "South Ayrshire", "council area", "GB-SCT"},
+	{"GB-SCB", "Scottish Borders", "council area", "GB-SCT"},
+	{"GB-SCT", "Scotland", "country", ""},
+	{"GB-SFK", "Suffolk", "two-tier county", "GB-ENG"},
+	{"GB-SFT", "Sefton", "metropolitan district", "GB-ENG"},
+	{"GB-SGC", "South Gloucestershire", "unitary authority", "GB-ENG"},
+	{"GB-SHF", "Sheffield", "metropolitan district", "GB-ENG"},
+	{"GB-SHN", "St. Helens", "metropolitan district", "GB-ENG"},
+	{"GB-SHR", "Shropshire", "unitary authority", "GB-ENG"},
+	{"GB-SKP", "Stockport", "metropolitan district", "GB-ENG"},
+	{"GB-SLF", "Salford", "metropolitan district", "GB-ENG"},
+	{"GB-SLG", "Slough", "unitary authority", "GB-ENG"},
+	{"GB-SLK", "South Lanarkshire", "council area", "GB-SCT"},
+	{"GB-SND", "Sunderland", "metropolitan district", "GB-ENG"},
+	{"GB-SOL", "Solihull", "metropolitan district", "GB-ENG"},
+	{"GB-SOM", "Somerset", "two-tier county", "GB-ENG"},
+	{"GB-SOS", "Southend-on-Sea", "unitary authority", "GB-ENG"},
+	{"GB-SRY", "Surrey", "two-tier county", "GB-ENG"},
+	{"GB-STE", "Stoke-on-Trent", "unitary authority", "GB-ENG"},
+	{"GB-STG", "Stirling", "council area", "GB-SCT"},
+	{"GB-STH", "Southampton", "unitary authority", "GB-ENG"},
+	{"GB-STN", "Sutton", "london borough", "GB-ENG"},
+	{"GB-STS", "Staffordshire", "two-tier county", "GB-ENG"},
+	{"GB-STT", "Stockton-on-Tees", "unitary authority", "GB-ENG"},
+	{"GB-STY", "South Tyneside", "metropolitan district", "GB-ENG"},
+	{"GB-SWA", "Swansea [Abertawe GB-ATA]", "unitary authority", "GB-WLS"},
+	{"GB-SWD", "Swindon", "unitary authority", "GB-ENG"},
+	{"GB-SWK", "Southwark", "london borough", "GB-ENG"},
+	{"GB-TAM", "Tameside", "metropolitan district", "GB-ENG"},
+	{"GB-TFW", "Telford and Wrekin", "unitary authority", "GB-ENG"},
+	{"GB-THR", "Thurrock", "unitary authority", "GB-ENG"},
+	{"GB-TOB", "Torbay", "unitary authority", "GB-ENG"},
+	{"GB-TOF", "Torfaen [Tor-faen]", "unitary authority", "GB-WLS"},
+	{"GB-TRF", "Trafford", "metropolitan district", "GB-ENG"},
+	{"GB-TWH", "Tower Hamlets", "london borough", "GB-ENG"},
+	{"GB-VGL", "Vale of Glamorgan, The [Bro Morgannwg GB-BMG]", "unitary authority", "GB-WLS"},
+	{"GB-WAR", "Warwickshire", "two-tier county", "GB-ENG"},
+	{"GB-WBK", "West Berkshire", "unitary authority", "GB-ENG"},
+	{"GB-WDU", "West Dunbartonshire", "council area", "GB-SCT"},
+	{"GB-WFT", "Waltham Forest", "london borough", "GB-ENG"},
+	{"GB-WGN", "Wigan", "metropolitan district", "GB-ENG"},
+	{"GB-WIL", "Wiltshire", "unitary authority", "GB-ENG"},
+	{"GB-WKF", "Wakefield", "metropolitan district", "GB-ENG"},
+	{"GB-WLL", "Walsall", "metropolitan district", "GB-ENG"},
+	{"GB-WLN", "West Lothian", "council area", "GB-SCT"},
+	{"GB-WLS", "Wales", "country", ""},
+	{"GB-WLV", "Wolverhampton", "metropolitan district", "GB-ENG"},
+	{"GB-WND", "Wandsworth", "london borough", "GB-ENG"},
+	{"GB-WNM", "Windsor and Maidenhead", "unitary authority", "GB-ENG"},
+	{"GB-WOK", "Wokingham", "unitary authority", "GB-ENG"},
+	{"GB-WOR", "Worcestershire", "two-tier county", "GB-ENG"},
+	{"GB-WRL", "Wirral", "metropolitan district", "GB-ENG"},
+	{"GB-WRT", "Warrington", "unitary authority", "GB-ENG"},
+	{"GB-WRX", "Wrexham [Wrecsam GB-WRC]", "unitary authority", "GB-WLS"},
+	{"GB-WSM", "Westminster", "london borough", "GB-ENG"},
+	{"GB-WSX", "West Sussex", "two-tier county", "GB-ENG"},
+	{"GB-YOR", "York", "unitary authority", "GB-ENG"},
+	{"GB-ZET", "Shetland Islands", "council area", "GB-SCT"},
+	{"GD-01", "Saint Andrew", "parish", ""},
+	{"GD-02", "Saint David", "parish", ""},
+	{"GD-03", "Saint George", "parish", ""},
+	{"GD-04", "Saint John", "parish", ""},
+	{"GD-05", "Saint Mark", "parish", ""},
+	{"GD-06", "Saint Patrick", "parish", ""},
+	{"GD-10", "Southern Grenadine Islands", "dependency", ""},
+	{"GE-AB", "Abkhazia", "autonomous republic", ""},
+	{"GE-AJ", "Ajaria", "autonomous republic", ""},
+	{"GE-GU", "Guria", "region", ""},
+	{"GE-IM", "Imereti", "region", ""},
+	{"GE-KA", "K'akheti", "region", ""},
+	{"GE-KK", "Kvemo Kartli", "region", ""},
+	{"GE-MM", "Mtskheta-Mtianeti", "region", ""},
+	{"GE-RL", "Rach'a-Lechkhumi-Kvemo Svaneti", "region", ""},
+	{"GE-SJ", "Samtskhe-Javakheti", "region", ""},
+	{"GE-SK", "Shida Kartli", "region", ""},
+	{"GE-SZ", "Samegrelo-Zemo Svaneti", "region", ""},
+	{"GE-TB", "Tbilisi", "city", ""},
+	{"GH-AA", "Greater Accra", "region", ""},
+	{"GH-AF", "Ahafo", "region", ""},
+	{"GH-AH", "Ashanti", "region", ""},
+	{"GH-BE", "Bono East", "region", ""},
+	{"GH-BO", "Bono", "region", ""},
+	{"GH-CP", "Central", "region", ""},
+	{"GH-EP", "Eastern", "region", ""},
+	{"GH-NE", "North East", "region", ""},
+	{"GH-NP", "Northern", "region", ""},
+	{"GH-OT", "Oti", "region", ""},
+	{"GH-SV", "Savannah", "region", ""},
+	{"GH-TV", "Volta", "region", ""},
+	{"GH-UE", "Upper East", "region", ""},
+	{"GH-UW", "Upper West", "region", ""},
+	{"GH-WN", "Western North", "region", ""},
+	{"GH-WP", "Western", "region", ""},
+	{"GL-AV", "Avannaata Kommunia", "municipality", ""},
+	{"GL-KU", "Kommune Kujalleq", "municipality", ""},
+	{"GL-QE", "Qeqqata Kommunia", "municipality", ""},
+	{"GL-QT", "Kommune Qeqertalik", "municipality", ""},
+	{"GL-SM", "Kommuneqarfik Sermersooq", "municipality", ""},
+	{"GM-B", "Banjul", "city", ""},
+	{"GM-L", "Lower River", "division", ""},
+	{"GM-M", "Central River", "division", ""},
+	{"GM-N", "North Bank", "division", ""},
+	{"GM-U", "Upper River", "division", ""},
+	{"GM-W", "Western", "division", ""},
+	{"GN-B", "Boké", "administrative region", ""},
+	{"GN-BE", "Beyla", "prefecture", "GN-N"},
+	{"GN-BF", "Boffa", "prefecture", "GN-B"},
+	{"GN-BK", "Boké", "prefecture", "GN-B"},
+	{"GN-C", "Conakry", "governorate", ""},
+	{"GN-CO", "Coyah", "prefecture", "GN-D"},
+	{"GN-D", "Kindia", "administrative region", ""},
+	{"GN-DB", "Dabola", "prefecture", "GN-F"},
+	{"GN-DI", "Dinguiraye", "prefecture", "GN-F"},
+	{"GN-DL", "Dalaba", "prefecture", "GN-M"},
+	{"GN-DU", "Dubréka", "prefecture", "GN-D"},
+	{"GN-F", "Faranah", "administrative region", ""},
+	{"GN-FA", "Faranah", "prefecture", "GN-F"},
+	{"GN-FO", "Forécariah", "prefecture", "GN-D"},
+	{"GN-FR", "Fria", "prefecture", "GN-B"},
+	{"GN-GA", "Gaoual", "prefecture", "GN-B"},
+	{"GN-GU", "Guékédou", "prefecture", "GN-N"},
+	{"GN-K", "Kankan", "administrative region", ""},
+	{"GN-KA", "Kankan", "prefecture", "GN-K"},
+	{"GN-KB", "Koubia", "prefecture", "GN-L"},
+	{"GN-KD", "Kindia", "prefecture", "GN-D"},
+	{"GN-KE", "Kérouané", "prefecture", "GN-K"},
+	{"GN-KN", "Koundara", "prefecture", "GN-B"},
+	{"GN-KO", "Kouroussa", "prefecture", "GN-K"},
+	{"GN-KS", "Kissidougou", "prefecture", "GN-F"},
+	{"GN-L", "Labé", "administrative region", ""},
+	{"GN-LA", "Labé", "prefecture", "GN-L"},
+	{"GN-LE", "Lélouma", "prefecture", "GN-L"},
+	{"GN-LO", "Lola", "prefecture", "GN-N"},
+	{"GN-M", "Mamou", "administrative region", ""},
+	{"GN-MC", "Macenta", "prefecture", "GN-N"},
+	{"GN-MD", "Mandiana", "prefecture", "GN-K"},
+	{"GN-ML", "Mali", "prefecture", "GN-L"},
+	{"GN-MM", "Mamou", "prefecture", "GN-M"},
+	{"GN-N", "Nzérékoré", "administrative region", ""},
+	{"GN-NZ", "Nzérékoré", "prefecture", "GN-N"},
+	{"GN-PI", "Pita", "prefecture", "GN-M"},
+	{"GN-SI", "Siguiri", "prefecture", "GN-K"},
+	{"GN-TE", "Télimélé", "prefecture", "GN-D"},
+	{"GN-TO", "Tougué", "prefecture", "GN-L"},
+	{"GN-YO", "Yomou", "prefecture", "GN-N"},
+	{"GQ-AN", "Annobon", "province", "GQ-I"},
+	{"GQ-BN", "Bioko Nord", "province", "GQ-I"},
+	{"GQ-BS", "Bioko Sud", "province", "GQ-I"},
+	{"GQ-C", "Região Continental", "region", ""},
+	{"GQ-CS", "Centro Sud", "province", "GQ-C"},
+	{"GQ-DJ", "Djibloho", "province", "GQ-C"},
+	{"GQ-I", "Região Insular", "region", ""},
+	{"GQ-KN", "Kié-Ntem", "province", "GQ-C"},
+	{"GQ-LI", "Litoral", "province", "GQ-C"},
+	{"GQ-WN", "Wele-Nzas", "province", "GQ-C"},
+	{"GR-69", "Ágion Óros", "self-governed part", ""},
+	{"GR-A", "Anatolikí Makedonía kai Thráki", "administrative region", ""},
+	{"GR-B", "Kentrikí Makedonía", "administrative region", ""},
+	{"GR-C", "Dytikí Makedonía", "administrative region", ""},
+	{"GR-D", "Ípeiros", "administrative region", ""},
+	{"GR-E", "Thessalía", "administrative region", ""},
+	{"GR-F", "Ionía Nísia", "administrative region", ""},
+	{"GR-G", "Dytikí Elláda", "administrative region", ""},
+	{"GR-H", "Stereá Elláda", "administrative region", ""},
+	{"GR-I", "Attikí", "administrative region", ""},
+	{"GR-J", "Pelopónnisos", "administrative region", ""},
+	{"GR-K", "Vóreio Aigaío", "administrative region", ""},
+	{"GR-L", "Nótio Aigaío", "administrative region", ""},
+	{"GR-M", "Kríti", "administrative region", ""},
+	{"GT-AV", "Alta Verapaz", "department", ""},
+	{"GT-BV", "Baja Verapaz", "department", ""},
+	{"GT-CM", "Chimaltenango", "department", ""},
+	{"GT-CQ", "Chiquimula", "department", ""},
+	{"GT-ES", "Escuintla", "department", ""},
+	{"GT-GU", "Guatemala", "department", ""},
+	{"GT-HU", "Huehuetenango", "department", ""},
+	{"GT-IZ", "Izabal", "department", ""},
+	{"GT-JA", "Jalapa", "department", ""},
+	{"GT-JU", "Jutiapa", "department", ""},
+	{"GT-PE", "Petén", "department", ""},
+	{"GT-PR", "El Progreso", "department", ""},
+	{"GT-QC", "Quiché", "department", ""},
+	{"GT-QZ", "Quetzaltenango", "department", ""},
+	{"GT-RE", "Retalhuleu", "department", ""},
+	{"GT-SA", "Sacatepéquez", "department", ""},
+	{"GT-SM", "San Marcos", "department", ""},
+	{"GT-SO", "Sololá", "department", ""},
+	{"GT-SR", "Santa Rosa", "department", ""},
+	{"GT-SU", "Suchitepéquez", "department", ""},
+	{"GT-TO", "Totonicapán", "department", ""},
+	{"GT-ZA", "Zacapa", "department", ""},
+	{"GW-BA", "Bafatá", "region", "GW-L"},
+	{"GW-BL", "Bolama / Bijagós", "region", "GW-S"},
+	{"GW-BM", "Biombo", "region", "GW-N"},
+	{"GW-BS", "Bissau", "autonomous sector", ""},
+	{"GW-CA", "Cacheu", "region", "GW-N"},
+	{"GW-GA", "Gabú", "region", "GW-L"},
+	{"GW-L", "Leste", "province", ""},
+	{"GW-N", "Norte", "province", ""},
+	{"GW-OI", "Oio", "region", "GW-N"},
+	{"GW-QU", "Quinara", "region", "GW-S"},
+	{"GW-S", "Sul", "province", ""},
+	{"GW-TO", "Tombali", "region", "GW-S"},
+	{"GY-BA", "Barima-Waini", "region", ""},
+	{"GY-CU", "Cuyuni-Mazaruni", "region", ""},
+	{"GY-DE", "Demerara-Mahaica", "region", ""},
+	{"GY-EB", "East Berbice-Corentyne", "region", ""},
+	{"GY-ES", "Essequibo Islands-West Demerara", "region", ""},
+	{"GY-MA", "Mahaica-Berbice", "region", ""},
+	{"GY-PM", "Pomeroon-Supenaam", "region", ""},
+	{"GY-PT", "Potaro-Siparuni", "region", ""},
+	{"GY-UD", "Upper Demerara-Berbice", "region", ""},
+	{"GY-UT", "Upper Takutu-Upper Essequibo", "region", ""},
+	{"HN-AT", "Atlántida", "department", ""},
+	{"HN-CH", "Choluteca", "department", ""},
+	{"HN-CL", "Colón", "department", ""},
+	{"HN-CM", "Comayagua", "department", ""},
+	{"HN-CP", "Copán", "department", ""},
+	{"HN-CR", "Cortés", "department", ""},
+	{"HN-EP", "El Paraíso", "department", ""},
+	{"HN-FM", "Francisco Morazán", "department", ""},
+	{"HN-GD", "Gracias a Dios", "department", ""},
+	{"HN-IB", "Islas de la Bahía", "department", ""},
+	{"HN-IN", "Intibucá", "department", ""},
+	{"HN-LE", "Lempira", "department", ""},
+	{"HN-LP", "La Paz", "department", ""},
+	{"HN-OC", "Ocotepeque", "department", ""},
+	{"HN-OL", "Olancho", "department", ""},
+	{"HN-SB", "Santa Bárbara", "department", ""},
+	{"HN-VA", "Valle", "department", ""},
+	{"HN-YO", "Yoro", "department", ""},
+	{"HR-01", "Zagrebačka županija", "county", ""},
+	{"HR-02", "Krapinsko-zagorska županija", "county", ""},
+	{"HR-03", "Sisačko-moslavačka županija", "county", ""},
+	{"HR-04", "Karlovačka županija", "county", ""},
+	{"HR-05", "Varaždinska županija", "county", ""},
+	{"HR-06", "Koprivničko-križevačka županija", "county", ""},
+	{"HR-07", "Bjelovarsko-bilogorska županija", "county", ""},
+	{"HR-08", "Primorsko-goranska županija", "county", ""},
+	{"HR-09", "Ličko-senjska županija", "county", ""},
+	{"HR-10", "Virovitičko-podravska županija", "county", ""},
+	{"HR-11", "Požeško-slavonska županija", "county", ""},
+	{"HR-12", "Brodsko-posavska županija", "county", ""},
+	{"HR-13", "Zadarska županija", "county", ""},
+	{"HR-14", "Osječko-baranjska županija", "county", ""},
+	{"HR-15", "Šibensko-kninska županija", "county", ""},
+	{"HR-16", "Vukovarsko-srijemska županija", "county", ""},
+	{"HR-17", "Splitsko-dalmatinska županija", "county", ""},
+	{"HR-18", "Istarska županija", "county", ""},
+	{"HR-19", "Dubrovačko-neretvanska županija", "county", ""},
+	{"HR-20", "Međimurska županija", "county", ""},
+	{"HR-21", "Grad Zagreb", "city", ""},
+	{"HT-AR", "Artibonite", "department", ""},
+	{"HT-CE", "Centre", "department", ""},
+	{"HT-GA", "Grandans", "department", ""},
+	{"HT-ND", "Nord", "department", ""},
+	{"HT-NE", "Nord-Est", "department", ""},
+	{"HT-NI", "Nip", "department", ""},
+	{"HT-NO", "Nord-Ouest", "department", ""},
+	{"HT-OU", "Lwès", "department", ""},
+	{"HT-SD", "Sid", "department", ""},
+	{"HT-SE", "Sidès", "department", ""},
+	{"HU-BA", "Baranya", "county", ""},
+	{"HU-BC", "Békéscsaba", "city with county rights", ""},
+	{"HU-BE", "Békés", "county", ""},
+	{"HU-BK", "Bács-Kiskun", "county", ""},
+	{"HU-BU", "Budapest", "capital city", ""},
+	{"HU-BZ", "Borsod-Abaúj-Zemplén", "county", ""},
+	{"HU-CS", "Csongrád", "county", ""},
+	{"HU-DE", "Debrecen", "city with county rights", ""},
+	{"HU-DU", "Dunaújváros", "city with county rights", ""},
+	{"HU-EG", "Eger", "city with county rights", ""},
+	{"HU-ER", "Érd", "city with county rights", ""},
+	{"HU-FE", "Fejér", "county", ""},
+	{"HU-GS", "Győr-Moson-Sopron", "county", ""},
+	{"HU-GY", "Győr", "city with county rights", ""},
+	{"HU-HB", "Hajdú-Bihar", "county", ""},
+	{"HU-HE", "Heves", "county", ""},
+	{"HU-HV", "Hódmezővásárhely", "city with county rights", ""},
+	{"HU-JN", "Jász-Nagykun-Szolnok", "county", ""},
+	{"HU-KE", "Komárom-Esztergom", "county", ""},
+	{"HU-KM", "Kecskemét", "city with county rights", ""},
+	{"HU-KV", "Kaposvár", "city with county rights", ""},
+	{"HU-MI", "Miskolc", "city with county rights", ""},
+	{"HU-NK", "Nagykanizsa", "city with county rights", ""},
+	{"HU-NO", "Nógrád", "county", ""},
+	{"HU-NY", "Nyíregyháza", "city with county rights", ""},
+	{"HU-PE", "Pest", "county", ""},
+	{"HU-PS", "Pécs", "city with county rights", ""},
+	{"HU-SD", "Szeged", "city with county rights", ""},
+	{"HU-SF", "Székesfehérvár", "city with county rights", ""},
+	{"HU-SH", "Szombathely", "city with county rights", ""},
+	{"HU-SK", "Szolnok", "city with county rights", ""},
+	{"HU-SN", "Sopron", "city with county rights", ""},
+	{"HU-SO", "Somogy", "county", ""},
+	{"HU-SS", "Szekszárd", "city with county rights", ""},
+	{"HU-ST", "Salgótarján", "city with county rights", ""},
+	{"HU-SZ", "Szabolcs-Szatmár-Bereg", "county", ""},
+	{"HU-TB", "Tatabánya", "city with county rights", ""},
+	{"HU-TO", "Tolna", "county", ""},
+	{"HU-VA", "Vas", "county", ""},
+	{"HU-VE", "Veszprém", "county", ""},
+	{"HU-VM", "Veszprém", "city with county rights", ""},
+	{"HU-ZA", "Zala", "county", ""},
+	{"HU-ZE", "Zalaegerszeg", "city with county rights", ""},
+	{"ID-AC", "Aceh", "province", "ID-SM"},
+	{"ID-BA", "Bali", "province", "ID-NU"},
+	{"ID-BB", "Kepulauan Bangka Belitung", "province", "ID-SM"},
+	{"ID-BE", "Bengkulu", "province", "ID-SM"},
+	{"ID-BT", "Banten", "province", "ID-JW"},
+	{"ID-GO", "Gorontalo", "province", "ID-SL"},
+	{"ID-JA", "Jambi", "province", "ID-SM"},
+	{"ID-JB", "Jawa Barat", "province", "ID-JW"},
+	{"ID-JI", "Jawa Timur", "province", "ID-JW"},
+	{"ID-JK", "Jakarta Raya", "capital district", "ID-JW"},
+	{"ID-JT", "Jawa Tengah", "province", "ID-JW"},
+	{"ID-JW", "Jawa", "geographical unit", ""},
+	{"ID-KA", "Kalimantan", "geographical unit", ""},
+	{"ID-KB", "Kalimantan Barat", "province", "ID-KA"},
+	{"ID-KI", "Kalimantan Timur", "province", "ID-KA"},
+	{"ID-KR", "Kepulauan Riau", "province", "ID-SM"},
+	{"ID-KS", "Kalimantan Selatan", "province", "ID-KA"},
+	{"ID-KT", "Kalimantan Tengah", "province", "ID-KA"},
+	{"ID-KU", "Kalimantan Utara", "province", "ID-KA"},
+	{"ID-LA", "Lampung", "province", "ID-SM"},
+	{"ID-MA", "Maluku", "province", "ID-ML"},
+	{"ID-ML", "Maluku", "geographical unit", ""},
+	{"ID-MU", "Maluku Utara", "province", "ID-ML"},
+	{"ID-NB", "Nusa Tenggara Barat", "province", "ID-NU"},
+	{"ID-NT", "Nusa Tenggara Timur", "province", "ID-NU"},
+	{"ID-NU", "Nusa Tenggara", "geographical unit", ""},
+	{"ID-PA", "Papua", "province", "ID-PP"},
+	{"ID-PB", "Papua Barat", "province", "ID-PP"},
+	{"ID-PP", "Papua", "geographical unit", ""},
+	{"ID-RI", "Riau", "province", "ID-SM"},
+	{"ID-SA", "Sulawesi Utara", "province", "ID-SL"},
+	{"ID-SB", "Sumatera Barat", "province", "ID-SM"},
+	{"ID-SG", "Sulawesi Tenggara", "province", "ID-SL"},
+	{"ID-SL", "Sulawesi", "geographical unit", ""},
+	{"ID-SM", "Sumatera", "geographical unit", ""},
+	{"ID-SN", "Sulawesi Selatan", "province", "ID-SL"},
+	{"ID-SR", "Sulawesi Barat", "province", "ID-SL"},
+	{"ID-SS", "Sumatera Selatan", "province", "ID-SM"},
+	{"ID-ST", "Sulawesi Tengah", "province", "ID-SL"},
+	{"ID-SU", "Sumatera Utara", "province", "ID-SM"},
+	{"ID-YO", "Yogyakarta", "special region", "ID-JW"},
+	{"IE-C", "Connaught", "province", ""},
+	{"IE-CE", "Clare", "county", "IE-M"},
+	{"IE-CN", "Cavan", "county", "IE-U"},
+	{"IE-CO", "Cork", "county", "IE-M"},
+	{"IE-CW", "Carlow", "county", "IE-L"},
+	{"IE-D", "Dublin", "county", "IE-L"},
+	{"IE-DL", "Donegal", "county", "IE-U"},
+	{"IE-G", "Galway", "county", "IE-C"},
+	{"IE-KE", "Kildare", "county", "IE-L"},
+	{"IE-KK", "Kilkenny", "county", "IE-L"},
+	{"IE-KY", "Kerry", "county", "IE-M"},
+	{"IE-L", "Leinster", "province", ""},
+	{"IE-LD", "Longford", "county", "IE-L"},
+	{"IE-LH", "Louth", "county", "IE-L"},
+	{"IE-LK", "Limerick", "county", "IE-M"},
+	{"IE-LM", "Leitrim", "county", "IE-C"},
+	{"IE-LS", "Laois", "county", "IE-L"},
+	{"IE-M", "Munster", "province", ""},
+	{"IE-MH", "Meath", "county", "IE-L"},
+	{"IE-MN", "Monaghan", "county", "IE-U"},
+	{"IE-MO", "Mayo", "county", "IE-C"},
+	{"IE-OY", "Offaly", "county", "IE-L"},
+	{"IE-RN", "Roscommon", "county", "IE-C"},
+	{"IE-SO", "Sligo", "county", "IE-C"},
+	{"IE-TA", "Tipperary", "county", "IE-M"},
+	{"IE-U", "Ulster", "province", ""},
+	{"IE-WD", "Waterford", "county", "IE-M"},
+	{"IE-WH", "Westmeath", "county", "IE-L"},
+	{"IE-WW", "Wicklow", "county", "IE-L"},
+	{"IE-WX", "Wexford", "county", "IE-L"},
+	{"IL-D", "Al Janūbī", "district", ""},
+	{"IL-HA", "H̱efa", "district", ""},
+	{"IL-JM", "Al Quds", "district", ""},
+	{"IL-M", "Al Awsaţ", "district", ""},
+	{"IL-TA", "Tall Abīb", "district", ""},
+	{"IL-Z", "Ash Shamālī", "district", ""},
+	{"IN-AN", "Andaman and Nicobar Islands", "union territory", ""},
+	{"IN-AP", "Andhra Pradesh", "state", ""},
+	{"IN-AR", "Arunachal Pradesh", "state", ""},
+	{"IN-AS", "Assam", "state", ""},
+	{"IN-BR", "Bihar", "state", ""},
+	{"IN-CG", "Chhattisgarh", "state", ""},
+	{"IN-CH", "Chandigarh", "union territory", ""},
+	{"IN-DH", "Dadra and Nagar Haveli and Daman and Diu", "union territory", ""},
+	{"IN-DL", "Delhi", "union territory", ""},
+	{"IN-GA", "Goa", "state", ""},
+	{"IN-GJ", "Gujarat", "state", ""},
+	{"IN-HP", "Himachal Pradesh", "state", ""},
+	{"IN-HR", "Haryana", "state", ""},
+	{"IN-JH", "Jharkhand", "state", ""},
+	{"IN-JK", "Jammu and Kashmir", "union territory", ""},
+	{"IN-KA", "Karnataka", "state", ""},
+	{"IN-KL", "Kerala", "state", ""},
+	{"IN-LA", "Ladakh", "union territory", ""},
+	{"IN-LD", "Lakshadweep", "union territory", ""},
+	{"IN-MH", "Maharashtra", "state", ""},
+	{"IN-ML", "Meghalaya", "state", ""},
+	{"IN-MN", "Manipur", "state", ""},
+	{"IN-MP", "Madhya Pradesh", "state", ""},
+	{"IN-MZ", "Mizoram", "state", ""},
+	{"IN-NL", "Nagaland", "state", ""},
+	{"IN-OD", "Odisha", "state", ""},
+	{"IN-PB", "Punjab", "state", ""},
+	{"IN-PY", "Puducherry", "union territory", ""},
+	{"IN-RJ", "Rajasthan", "state", ""},
+	{"IN-SK", "Sikkim", "state", ""},
+	{"IN-TN", "Tamil Nadu", "state", ""},
+	{"IN-TR", "Tripura", "state", ""},
+	{"IN-TS", "Telangana", "state", ""},
+	{"IN-UK", "Uttarakhand", "state", ""},
+	{"IN-UP", "Uttar Pradesh", "state", ""},
+	{"IN-WB", "West Bengal", "state", ""},
+	{"IQ-AN", "Al Anbār", "governorate", ""},
+	{"IQ-AR", "Arbīl", "governorate", ""},
+	{"IQ-BA", "Al Başrah", "governorate", ""},
+	{"IQ-BB", "Bābil", "governorate", ""},
+	{"IQ-BG", "Baghdād", "governorate", ""},
+	{"IQ-DA", "Dahūk", "governorate", ""},
+	{"IQ-DI", "Diyālá", "governorate", ""},
+	{"IQ-DQ", "Dhī Qār", "governorate", ""},
+	{"IQ-KA", "Karbalā’", "governorate", ""},
+	{"IQ-KI", "Kirkūk", "governorate", ""},
+	{"IQ-MA", "Maysān", "governorate", ""},
+	{"IQ-MU", "Al Muthanná", "governorate", ""},
+	{"IQ-NA", "An Najaf", "governorate", ""},
+	{"IQ-NI", "Nīnawá", "governorate", ""},
+	{"IQ-QA", "Al Qādisīyah", "governorate", ""},
+	{"IQ-SD", "Şalāḩ ad Dīn", "governorate", ""},
+	{"IQ-SU", "As Sulaymānīyah", "governorate", ""},
+	{"IQ-WA", "Wāsiţ", "governorate", ""},
+	{"IR-00", "Markazī", "province", ""},
+	{"IR-01", "Gīlān", "province", ""},
+	{"IR-02", "Māzandarān", "province", ""},
+	{"IR-03", "Āz̄ārbāyjān-e Shārqī", "province", ""},
+	{"IR-04", "Āz̄ārbāyjān-e Ghārbī", "province", ""},
+	{"IR-05", "Kermānshāh", "province", ""},
+	{"IR-06", "Khūzestān", "province", ""},
+	{"IR-07", "Fārs", "province", ""},
+	{"IR-08", "Kermān", "province", ""},
+	{"IR-09", "Khorāsān-e Raẕavī", "province", ""},
+	{"IR-10", "Eşfahān", "province", ""},
+	{"IR-11", "Sīstān va Balūchestān", "province", ""},
+	{"IR-12", "Kordestān", "province", ""},
+	{"IR-13", "Hamadān", "province", ""},
+	{"IR-14", "Chahār Maḩāl va Bakhtīārī", "province", ""},
+	{"IR-15", "Lorestān", "province", ""},
+	{"IR-16", "Īlām", "province", ""},
+	{"IR-17", "Kohgīlūyeh va Bowyer Aḩmad", "province", ""},
+	{"IR-18", "Būshehr", "province", ""},
+	{"IR-19", "Zanjān", "province", ""},
+	{"IR-20", "Semnān", "province", ""},
+	{"IR-21", "Yazd", "province", ""},
+	{"IR-22", "Hormozgān", "province", ""},
+	{"IR-23", "Tehrān", "province", ""},
+	{"IR-24", "Ardabīl", "province", ""},
+	{"IR-25", "Qom", "province", ""},
+	{"IR-26", "Qazvīn", "province", ""},
+	{"IR-27", "Golestān", "province", ""},
+	{"IR-28", "Khorāsān-e Shomālī", "province", ""},
+	{"IR-29", "Khorāsān-e Jonūbī", "province", ""},
+	{"IR-30", "Alborz", "province", ""},
+	{"IS-1", "Höfuðborgarsvæði", "region", ""},
+	{"IS-2", "Suðurnes", "region", ""},
+	{"IS-3", "Vesturland", "region", ""},
+	{"IS-4", "Vestfirðir", "region", ""},
+	{"IS-5", "Norðurland vestra", "region", ""},
+	{"IS-6", "Norðurland eystra", "region", ""},
+	{"IS-7", "Austurland", "region", ""},
+	{"IS-8", "Suðurland", "region", ""},
+	{"IS-AKH", "Akrahreppur", "municipality", "IS-5"},
+	{"IS-AKN", "Akraneskaupstaður", "municipality", "IS-3"},
+	{"IS-AKU", "Akureyrarbær", "municipality", "IS-6"},
+	{"IS-ARN", "Árneshreppur", "municipality", "IS-4"},
+	{"IS-ASA", "Ásahreppur", "municipality", "IS-8"},
+	{"IS-BFJ", "Borgarfjarðarhreppur", "municipality", "IS-7"},
+	{"IS-BLA", "Bláskógabyggð", "municipality", "IS-8"},
+	{"IS-BLO", "Blönduósbær", "municipality", "IS-5"},
+	{"IS-BOG", "Borgarbyggð", "municipality", "IS-3"},
+	{"IS-BOL", "Bolungarvíkurkaupstaður", "municipality", "IS-4"},
+	{"IS-DAB", "Dalabyggð", "municipality", "IS-3"},
+	{"IS-DAV", "Dalvíkurbyggð", "municipality", "IS-6"},
+	{"IS-DJU", "Djúpavogshreppur", "municipality", "IS-7"},
+	{"IS-EOM", "Eyja- og Miklaholtshreppur", "municipality", "IS-3"},
+	{"IS-EYF", "Eyjafjarðarsveit", "municipality", "IS-6"},
+	{"IS-FJD", "Fjarðabyggð", "municipality", "IS-7"},
+	{"IS-FJL", "Fjallabyggð", "municipality", "IS-6"},
+	{"IS-FLA", "Flóahreppur", "municipality", "IS-8"},
+	{"IS-FLD", "Fljótsdalshérað", "municipality", "IS-7"},
+	{"IS-FLR", "Fljótsdalshreppur", "municipality", "IS-7"},
+	{"IS-GAR", "Garðabær", "municipality", "IS-1"},
+	{"IS-GOG", "Grímsnes- og Grafningshreppur", "municipality", "IS-8"},
+	{"IS-GRN", "Grindavíkurbær", "municipality", "IS-2"},
+	{"IS-GRU", "Grundarfjarðarbær", "municipality", "IS-3"},
+	{"IS-GRY", "Grýtubakkahreppur", "municipality", "IS-6"},
+	{"IS-HAF", "Hafnarfjarðarkaupstaður", "municipality", "IS-1"},
+	{"IS-HEL", "Helgafellssveit", "municipality", "IS-3"},
+	{"IS-HRG", "Hörgársveit", "municipality", "IS-6"},
+	{"IS-HRU", "Hrunamannahreppur", "municipality", "IS-8"},
+	{"IS-HUT", "Húnavatnshreppur", "municipality", "IS-5"},
+	{"IS-HUV", "Húnaþing vestra", "municipality", "IS-5"},
+	{"IS-HVA", "Hvalfjarðarsveit", "municipality", "IS-3"},
+	{"IS-HVE", "Hveragerðisbær", "municipality", "IS-8"},
+	{"IS-ISA", "Ísafjarðarbær", "municipality", "IS-4"},
+	{"IS-KAL", "Kaldrananeshreppur", "municipality", "IS-4"},
+	{"IS-KJO", "Kjósarhreppur", "municipality", "IS-1"},
+	{"IS-KOP", "Kópavogsbær", "municipality", "IS-1"},
+	{"IS-LAN", "Langanesbyggð", "municipality", "IS-6"},
+	{"IS-MOS", "Mosfellsbær", "municipality", "IS-1"},
+	{"IS-MYR", "Mýrdalshreppur", "municipality", "IS-8"},
+	{"IS-NOR", "Norðurþing", "municipality", "IS-6"},
+	{"IS-RGE", "Rangárþing eystra", "municipality", "IS-8"},
+	{"IS-RGY", "Rangárþing ytra", "municipality", "IS-8"},
+	{"IS-RHH", "Reykhólahreppur", "municipality", "IS-4"},
+	{"IS-RKN", "Reykjanesbær", "municipality", "IS-2"},
+	{"IS-RKV", "Reykjavíkurborg", "municipality", "IS-1"},
+	{"IS-SBH", "Svalbarðshreppur", "municipality", "IS-6"},
+	{"IS-SBT", "Svalbarðsstrandarhreppur", "municipality", "IS-6"},
+	{"IS-SDN", "Suðurnesjabær", "municipality", "IS-2"},
+	{"IS-SDV", "Súðavíkurhreppur", "municipality", "IS-4"},
+	{"IS-SEL", "Seltjarnarnesbær", "municipality", "IS-1"},
+	{"IS-SEY", "Seyðisfjarðarkaupstaður", "municipality", "IS-7"},
+	{"IS-SFA", "Sveitarfélagið Árborg", "municipality", "IS-8"},
+	{"IS-SHF", "Sveitarfélagið Hornafjörður", "municipality", "IS-7"},
+	{"IS-SKF", "Skaftárhreppur", "municipality", "IS-8"},
+	{"IS-SKG", "Skagabyggð", "municipality", "IS-5"},
+	{"IS-SKO", "Skorradalshreppur", "municipality", "IS-3"},
+	{"IS-SKU", "Skútustaðahreppur", "municipality", "IS-6"},
+	{"IS-SNF", "Snæfellsbær", "municipality", "IS-3"},
+	{"IS-SOG", "Skeiða- og Gnúpverjahreppur", "municipality", "IS-8"},
+	{"IS-SOL", "Sveitarfélagið Ölfus", "municipality", "IS-8"},
+	{"IS-SSF", "Sveitarfélagið Skagafjörður", "municipality", "IS-5"},
+	{"IS-SSS", "Sveitarfélagið Skagaströnd", "municipality", "IS-5"},
+	{"IS-STR", "Strandabyggð", "municipality", "IS-4"},
+	{"IS-STY", "Stykkishólmsbær", "municipality", "IS-3"},
+	{"IS-SVG", "Sveitarfélagið Vogar", "municipality", "IS-2"},
+	{"IS-TAL", "Tálknafjarðarhreppur", "municipality", "IS-4"},
+	{"IS-THG", "Þingeyjarsveit", "municipality", "IS-6"},
+	{"IS-TJO", "Tjörneshreppur", "municipality", "IS-6"},
+	{"IS-VEM", "Vestmannaeyjabær", "municipality", "IS-8"},
+	{"IS-VER", "Vesturbyggð", "municipality", "IS-4"},
+	{"IS-VOP", "Vopnafjarðarhreppur", "municipality", "IS-7"},
+	{"IT-21", "Piemonte", "region", ""},
+	{"IT-23", "Valle d'Aosta", "autonomous region", ""},
+	{"IT-25", "Lombardia", "region", ""},
+	{"IT-32", "Trentino-Alto Adige", "autonomous region", ""},
+	{"IT-34", "Veneto", "region", ""},
+	{"IT-36", "Friuli Venezia Giulia", "autonomous region", ""},
+	{"IT-42", "Liguria", "region", ""},
+	{"IT-45", "Emilia-Romagna", "region", ""},
+	{"IT-52", "Toscana", "region", ""},
+	{"IT-55", "Umbria", "region", ""},
+	{"IT-57", "Marche", "region", ""},
+	{"IT-62", "Lazio", "region", ""},
+	{"IT-65", "Abruzzo", "region", ""},
+	{"IT-67", "Molise", "region", ""},
+	{"IT-72", "Campania", "region", ""},
+	{"IT-75", "Puglia", "region", ""},
+	{"IT-77", "Basilicata", "region", ""},
+	{"IT-78", "Calabria", "region", ""},
+	{"IT-82", "Sicilia", "autonomous region", ""},
+	{"IT-88", "Sardegna", "autonomous region", ""},
+	{"IT-AG", "Agrigento", "free municipal consortium", "IT-82"},
+	{"IT-AL", "Alessandria", "province", "IT-21"},
+	{"IT-AN", "Ancona", "province", "IT-57"},
+	{"IT-AP", "Ascoli Piceno", "province", "IT-57"},
+	{"IT-AQ", "L'Aquila", "province", "IT-65"},
+	{"IT-AR", "Arezzo", "province", "IT-52"},
+	{"IT-AT", "Asti", "province", "IT-21"},
+	{"IT-AV", "Avellino", "province", "IT-72"},
+	{"IT-BA", "Bari", "metropolitan city", "IT-75"},
+	{"IT-BG", "Bergamo", "province", "IT-25"},
+	{"IT-BI", "Biella", "province", "IT-21"},
+	{"IT-BL", "Belluno", "province", "IT-34"},
+	{"IT-BN", "Benevento", "province", "IT-72"},
+	{"IT-BO", "Bologna", "metropolitan city", "IT-45"},
+	{"IT-BR", "Brindisi", "province", "IT-75"},
+	{"IT-BS", "Brescia", "province", "IT-25"},
+	{"IT-BT", "Barletta-Andria-Trani", "province", "IT-75"},
+	{"IT-BZ", "Bolzano", "autonomous province", "IT-32"},
+	{"IT-CA", "Cagliari", "metropolitan city", "IT-88"},
+	{"IT-CB", "Campobasso", "province", "IT-67"},
+	{"IT-CE", "Caserta", "province", "IT-72"},
+	{"IT-CH", "Chieti", "province", "IT-65"},
+	{"IT-CL", "Caltanissetta", "free municipal consortium", "IT-82"},
+	{"IT-CN", "Cuneo", "province", "IT-21"},
+	{"IT-CO", "Como", "province", "IT-25"},
+	{"IT-CR", "Cremona", "province", "IT-25"},
+	{"IT-CS", "Cosenza", "province", "IT-78"},
+	{"IT-CT", "Catania", "metropolitan city", "IT-82"},
+	{"IT-CZ", "Catanzaro", "province", "IT-78"},
+	{"IT-EN", "Enna", "free municipal consortium", "IT-82"},
+	{"IT-FC", "Forlì-Cesena", "province", "IT-45"},
+	{"IT-FE", "Ferrara", "province", "IT-45"},
+	{"IT-FG", "Foggia", "province", "IT-75"},
+	{"IT-FI", "Firenze", "metropolitan city", "IT-52"},
+	{"IT-FM", "Fermo", "province", "IT-57"},
+	{"IT-FR", "Frosinone", "province", "IT-62"},
+	{"IT-GE", "Genova", "metropolitan city", "IT-42"},
+	{"IT-GO", "Gorizia", "decentralized regional entity", "IT-36"},
+	{"IT-GR", "Grosseto", "province", "IT-52"},
+	{"IT-IM", "Imperia", "province", "IT-42"},
+	{"IT-IS", "Isernia", "province", "IT-67"},
+	{"IT-KR", "Crotone", "province", "IT-78"},
+	{"IT-LC", "Lecco", "province", "IT-25"},
+	{"IT-LE", "Lecce", "province", "IT-75"},
+	{"IT-LI", "Livorno", "province", "IT-52"},
+	{"IT-LO", "Lodi", "province", "IT-25"},
+	{"IT-LT", "Latina", "province", "IT-62"},
+	{"IT-LU", "Lucca", "province", "IT-52"},
+	{"IT-MB", "Monza e Brianza", "province", "IT-25"},
+	{"IT-MC", "Macerata", "province", "IT-57"},
+	{"IT-ME", "Messina", "metropolitan city", "IT-82"},
+	{"IT-MI", "Milano", "metropolitan city", "IT-25"},
+	{"IT-MN", "Mantova", "province", "IT-25"},
+	{"IT-MO", "Modena", "province", "IT-45"},
+	{"IT-MS", "Massa-Carrara", "province", "IT-52"},
+	{"IT-MT", "Matera", "province", "IT-77"},
+	{"IT-NA", "Napoli", "metropolitan city", "IT-72"},
+	{"IT-NO", "Novara", "province", "IT-21"},
+	{"IT-NU", "Nuoro", "province", "IT-88"},
+	{"IT-OR", "Oristano", "province", "IT-88"},
+	{"IT-PA", "Palermo", "metropolitan city", "IT-82"},
+	{"IT-PC", "Piacenza", "province", "IT-45"},
+	{"IT-PD", "Padova", "province", "IT-34"},
+	{"IT-PE", "Pescara", "province", "IT-65"},
+	{"IT-PG", "Perugia", "province", "IT-55"},
+	{"IT-PI", "Pisa", "province", "IT-52"},
+	{"IT-PN", "Pordenone", "decentralized regional entity", "IT-36"},
+	{"IT-PO", "Prato", "province", "IT-52"},
+	{"IT-PR", "Parma", "province", "IT-45"},
+	{"IT-PT", "Pistoia", "province", "IT-52"},
+	{"IT-PU", "Pesaro e Urbino", "province", "IT-57"},
+	{"IT-PV", "Pavia", "province", "IT-25"},
+	{"IT-PZ", "Potenza", "province", "IT-77"},
+	{"IT-RA", "Ravenna", "province", "IT-45"},
+	{"IT-RC", "Reggio Calabria", "metropolitan city", "IT-78"},
+	{"IT-RE", "Reggio Emilia", "province", "IT-45"},
+	{"IT-RG", "Ragusa", "free municipal consortium", "IT-82"},
+	{"IT-RI", "Rieti", "province", "IT-62"},
+	{"IT-RM", "Roma", "metropolitan city", "IT-62"},
+	{"IT-RN", "Rimini", "province", "IT-45"},
+	{"IT-RO", "Rovigo", "province", "IT-34"},
+	{"IT-SA", "Salerno", "province", "IT-72"},
+	{"IT-SI", "Siena", "province", "IT-52"},
+	{"IT-SO", "Sondrio", "province", "IT-25"},
+	{"IT-SP", "La Spezia", "province", "IT-42"},
+	{"IT-SR", "Siracusa", "free municipal consortium", "IT-82"},
+	{"IT-SS", "Sassari", "province", "IT-88"},
+	{"IT-SU", "Sud Sardegna", "province", "IT-88"},
+	{"IT-SV", "Savona", "province", "IT-42"},
+	{"IT-TA", "Taranto", "province", "IT-75"},
+	{"IT-TE", "Teramo", "province", "IT-65"},
+	{"IT-TN", "Trento", "autonomous province", "IT-32"},
+	{"IT-TO", "Torino", "metropolitan city", "IT-21"},
+	{"IT-TP", "Trapani", "free municipal consortium", "IT-82"},
+	{"IT-TR", "Terni", "province", "IT-55"},
+	{"IT-TS", "Trieste", "decentralized regional entity", "IT-36"},
+	{"IT-TV", "Treviso", "province", "IT-34"},
+	{"IT-UD", "Udine", "decentralized regional entity", "IT-36"},
+	{"IT-VA", "Varese", "province", "IT-25"},
+	{"IT-VB", "Verbano-Cusio-Ossola", "province", "IT-21"},
+	{"IT-VC", "Vercelli", "province", "IT-21"},
+	{"IT-VE", "Venezia", "metropolitan city", "IT-34"},
+	{"IT-VI", "Vicenza", "province", "IT-34"},
+	{"IT-VR", "Verona", "province", "IT-34"},
+	{"IT-VT", "Viterbo", "province", "IT-62"},
+	{"IT-VV", "Vibo Valentia", "province", "IT-78"},
+	{"JM-01", "Kingston", "parish", ""},
+	{"JM-02", "Saint Andrew", "parish", ""},
+	{"JM-03", "Saint Thomas", "parish", ""},
+	{"JM-04", "Portland", "parish", ""},
+	{"JM-05", "Saint Mary", "parish", ""},
+	{"JM-06", "Saint Ann", "parish", ""},
+	{"JM-07", "Trelawny", "parish", ""},
+	{"JM-08", "Saint James", "parish", ""},
+	{"JM-09", "Hanover", "parish", ""},
+	{"JM-10", "Westmoreland", "parish", ""},
+	{"JM-11", "Saint Elizabeth", "parish", ""},
+	{"JM-12", "Manchester", "parish", ""},
+	{"JM-13", "Clarendon", "parish", ""},
+	{"JM-14", "Saint Catherine", "parish", ""},
+	{"JO-AJ", "‘Ajlūn", "governorate", ""},
+	{"JO-AM", "Al ‘A̅şimah", "governorate", ""},
+	{"JO-AQ", "Al ‘Aqabah", "governorate", ""},
+	{"JO-AT", "Aţ Ţafīlah", "governorate", ""},
+	{"JO-AZ", "Az Zarqā’", "governorate", ""},
+	{"JO-BA", "Al Balqā’", "governorate", ""},
+	{"JO-IR", "Irbid", "governorate", ""},
+	{"JO-JA", "Jarash", "governorate", ""},
+	{"JO-KA", "Al Karak", "governorate", ""},
+	{"JO-MA", "Al Mafraq", "governorate", ""},
+	{"JO-MD", "Mādabā", "governorate", ""},
+	{"JO-MN", "Ma‘ān", "governorate", ""},
+	{"JP-01", "Hokkaido", "prefecture", ""},
+	{"JP-02", "Aomori", "prefecture", ""},
+	{"JP-03", "Iwate", "prefecture", ""},
+	{"JP-04", "Miyagi", "prefecture", ""},
+	{"JP-05", "Akita", "prefecture", ""},
+	{"JP-06", "Yamagata", "prefecture", ""},
+	{"JP-07", "Fukushima", "prefecture", ""},
+	{"JP-08", "Ibaraki", "prefecture", ""},
+	{"JP-09", "Tochigi", "prefecture", ""},
+	{"JP-10", "Gunma", "prefecture", ""},
+	{"JP-11", "Saitama", "prefecture", ""},
+	{"JP-12", "Chiba", "prefecture", ""},
+	{"JP-13", "Tokyo", "prefecture", ""},
+	{"JP-14", "Kanagawa", "prefecture", ""},
+	{"JP-15", "Niigata", "prefecture", ""},
+	{"JP-16", "Toyama", "prefecture", ""},
+	{"JP-17", "Ishikawa", "prefecture", ""},
+	{"JP-18", "Fukui", "prefecture", ""},
+	{"JP-19", "Yamanashi", "prefecture", ""},
+	{"JP-20", "Nagano", "prefecture", ""},
+	{"JP-21", "Gifu", "prefecture", ""},
+	{"JP-22", "Shizuoka", "prefecture", ""},
+	{"JP-23", "Aichi", "prefecture", ""},
+	{"JP-24", "Mie", "prefecture", ""},
+	{"JP-25", "Shiga", "prefecture", ""},
+	{"JP-26", "Kyoto", "prefecture", ""},
+	{"JP-27", "Osaka", "prefecture", ""},
+	{"JP-28", "Hyogo", "prefecture", ""},
+	{"JP-29", "Nara", "prefecture", ""},
+	{"JP-30", "Wakayama", "prefecture", ""},
+	{"JP-31", "Tottori", "prefecture", ""},
+	{"JP-32", "Shimane", "prefecture", ""},
+	{"JP-33", "Okayama", "prefecture", ""},
+	{"JP-34", "Hiroshima", "prefecture", ""},
+	{"JP-35", "Yamaguchi", "prefecture", ""},
+	{"JP-36", "Tokushima", "prefecture", ""},
+	{"JP-37", "Kagawa", "prefecture", ""},
+	{"JP-38", "Ehime", "prefecture", ""},
+	{"JP-39", "Kochi", "prefecture", ""},
+	{"JP-40", "Fukuoka", "prefecture", ""},
+	{"JP-41", "Saga", "prefecture", ""},
+	{"JP-42", "Nagasaki", "prefecture", ""},
+	{"JP-43", "Kumamoto", "prefecture", ""},
+	{"JP-44", "Oita", "prefecture", ""},
+	{"JP-45", "Miyazaki", "prefecture", ""},
+	{"JP-46", "Kagoshima", "prefecture", ""},
+	{"JP-47", "Okinawa", "prefecture", ""},
+	{"KE-01", "Baringo", "county", ""},
+	{"KE-02", "Bomet", "county", ""},
+	{"KE-03", "Bungoma", "county", ""},
+	{"KE-04", "Busia", "county", ""},
+	{"KE-05", "Elgeyo/Marakwet", "county", ""},
+	{"KE-06", "Embu", "county", ""},
+	{"KE-07", "Garissa", "county", ""},
+	{"KE-08", "Homa Bay", "county", ""},
+	{"KE-09", "Isiolo", "county", ""},
+	{"KE-10", "Kajiado", "county", ""},
+	{"KE-11", "Kakamega", "county", ""},
+	{"KE-12", "Kericho", "county", ""},
+	{"KE-13", "Kiambu", "county", ""},
+	{"KE-14", "Kilifi", "county", ""},
+	{"KE-15", "Kirinyaga", "county", ""},
+	{"KE-16", "Kisii", "county", ""},
+	{"KE-17", "Kisumu", "county", ""},
+	{"KE-18", "Kitui", "county", ""},
+	{"KE-19", "Kwale", "county", ""},
+	{"KE-20", "Laikipia", "county", ""},
+	{"KE-21", "Lamu", "county", ""},
+	{"KE-22", "Machakos", "county", ""},
+	{"KE-23", "Makueni", "county", ""},
+	{"KE-24", "Mandera", "county", ""},
+	{"KE-25", "Marsabit", "county", ""},
+	{"KE-26", "Meru", "county", ""},
+	{"KE-27", "Migori", "county", ""},
+	{"KE-28", "Mombasa", "county", ""},
+	{"KE-29", "Murang'a", "county", ""},
+	{"KE-30", "Nairobi City", "county", ""},
+	{"KE-31", "Nakuru", "county", ""},
+	{"KE-32", "Nandi", "county", ""},
+	{"KE-33", "Narok", "county", ""},
+	{"KE-34", "Nyamira", "county", ""},
+	{"KE-35", "Nyandarua", "county", ""},
+	{"KE-36", "Nyeri", "county", ""},
+	{"KE-37", "Samburu", "county", ""},
+	{"KE-38", "Siaya", "county", ""},
+	{"KE-39", "Taita/Taveta", "county", ""},
+	{"KE-40", "Tana River", "county", ""},
+	{"KE-41", "Tharaka-Nithi", "county", ""},
+	{"KE-42", "Trans Nzoia", "county", ""},
+	{"KE-43", "Turkana", "county", ""},
+	{"KE-44", "Uasin Gishu", "county", ""},
+	{"KE-45", "Vihiga", "county", ""},
+	{"KE-46", "Wajir", "county", ""},
+	{"KE-47", "West Pokot", "county", ""},
+	{"KG-B", "Batken", "region", ""},
+	{"KG-C", "Chuyskaya oblast'", "region", ""},
+	{"KG-GB", "Bishkek Shaary", "city", ""},
+	{"KG-GO", "Gorod Osh", "city", ""},
+	{"KG-J", "Dzhalal-Abadskaya oblast'", "region", ""},
+	{"KG-N", "Naryn", "region", ""},
+	{"KG-O", "Osh", "region", ""},
+	{"KG-T", "Talas", "region", ""},
+	{"KG-Y", "Issyk-Kul'skaja oblast'", "region", ""},
+	{"KH-1", "Banteay Mean Choăy", "province", ""},
+	{"KH-10", "Kracheh", "province", ""},
+	{"KH-11", "Mondol Kiri", "province", ""},
+	{"KH-12", "Phnom Penh", "autonomous municipality", ""},
+	{"KH-13", "Preah Vihear", "province", ""},
+	{"KH-14", "Prey Veaeng", "province", ""},
+	{"KH-15", "Pousaat", "province", ""},
+	{"KH-16", "Rotanak Kiri", "province", ""},
+	{"KH-17", "Siem Reab", "province", ""},
+	{"KH-18", "Preah Sihanouk", "province", ""},
+	{"KH-19", "Stoĕng Trêng", "province", ""},
+	{"KH-2", "Baat Dambang", "province", ""},
+	{"KH-20", "Svaay Rieng", "province", ""},
+	{"KH-21", "Taakaev", "province", ""},
+	{"KH-22", "Otdar Mean Chey", "province", ""},
+	{"KH-23", "Kaeb", "province", ""},
+	{"KH-24", "Pailin", "province", ""},
+	{"KH-25", "Tbong Khmum", "province", ""},
+	{"KH-3", "Kampong Chaam", "province", ""},
+	{"KH-4", "Kampong Chhnang", "province", ""},
+	{"KH-5", "Kampong Spueu", "province", ""},
+	{"KH-6", "Kampong Thum", "province", ""},
+	{"KH-7", "Kampot", "province", ""},
+	{"KH-8", "Kandaal", "province", ""},
+	{"KH-9", "Kaoh Kong", "province", ""},
+	{"KI-G", "Gilbert Islands", "group of islands (20 inhabited islands)", ""},
+	{"KI-L", "Line Islands", "group of islands (20 inhabited islands)", ""},
+	{"KI-P", "Phoenix Islands", "group of islands (20 inhabited islands)", ""},
+	{"KM-A", "Andjouân", "island", ""},
+	{"KM-G", "Andjazîdja", "island", ""},
+	{"KM-M", "Mohéli", "island", ""},
+	{"KN-01", "Christ Church Nichola Town", "parish", "KN-K"},
+	{"KN-02", "Saint Anne Sandy Point", "parish", "KN-K"},
+	{"KN-03", "Saint George Basseterre", "parish", "KN-K"},
+	{"KN-04", "Saint George Gingerland", "parish", "KN-N"},
+	{"KN-05", "Saint James Windward", "parish", "KN-N"},
+	{"KN-06", "Saint John Capisterre", "parish", "KN-K"},
+	{"KN-07", "Saint John Figtree", "parish", "KN-N"},
+	{"KN-08", "Saint Mary Cayon", "parish", "KN-K"},
+	{"KN-09", "Saint Paul Capisterre", "parish", "KN-K"},
+	{"KN-10", "Saint Paul Charlestown", "parish", "KN-N"},
+	{"KN-11", "Saint Peter Basseterre", "parish", "KN-K"},
+	{"KN-12", "Saint Thomas Lowland", "parish", "KN-N"},
+	{"KN-13", "Saint Thomas Middle Island", "parish", "KN-K"},
+	{"KN-15", "Trinity Palmetto Point", "parish", "KN-K"},
+	{"KN-K", "Saint Kitts", "state", ""},
+	{"KN-N", "Nevis", "state", ""},
+	{"KP-01", "P'yǒngyang", "capital city", ""},
+	{"KP-02", "P'yǒngan-namdo", "province", ""},
+	{"KP-03", "P'yǒngan-bukto", "province", ""},
+	{"KP-04", "Chagang-do", "province", ""},
+	{"KP-05", "Hwanghae-namdo", "province", ""},
+	{"KP-06", "Hwanghae-bukto", "province", ""},
+	{"KP-07", "Kangweonto", "province", ""},
+	{"KP-08", "Hamgyǒng-namdo", "province", ""},
+	{"KP-09", "Hamgyǒng-bukto", "province", ""},
+	{"KP-10", "Ryanggang-do", "province", ""},
+	{"KP-13", "Raseon", "special city", ""},
+	{"KP-14", "Nampho", "metropolitan city", ""},
+	{"KR-11", "Seoul-teukbyeolsi", "special city", ""},
+	{"KR-26", "Busan-gwangyeoksi", "metropolitan city", ""},
+	{"KR-27", "Daegu-gwangyeoksi", "metropolitan city", ""},
+	{"KR-28", "Incheon-gwangyeoksi", "metropolitan city", ""},
+	{"KR-29", "Gwangju-gwangyeoksi", "metropolitan city", ""},
+	{"KR-30", "Daejeon-gwangyeoksi", "metropolitan city", ""},
+	{"KR-31", "Ulsan-gwangyeoksi", "metropolitan city", ""},
+	{"KR-41", "Gyeonggi-do", "province", ""},
+	{"KR-42", "Gangwon-do", "province", ""},
+	{"KR-43", "Chungcheongbuk-do", "province", ""},
+	{"KR-44", "Chungcheongnam-do", "province", ""},
+	{"KR-45", "Jeollabuk-do", "province", ""},
+	{"KR-46", "Jeollanam-do", "province", ""},
+	{"KR-47", "Gyeongsangbuk-do", "province", ""},
+	{"KR-48", "Gyeongsangnam-do", "province", ""},
+	{"KR-49", "Jeju-teukbyeoljachido", "special self-governing province", ""},
+	{"KR-50", "Sejong", "special self-governing city", ""},
+	{"KW-AH", "Al Aḩmadī", "governorate", ""},
+	{"KW-FA", "Al Farwānīyah", "governorate", ""},
+	{"KW-HA", "Ḩawallī", "governorate", ""},
+	{"KW-JA", "Al Jahrā’", "governorate", ""},
+	{"KW-KU", "Al ‘Āşimah", "governorate", ""},
+	{"KW-MU", "Mubārak al Kabīr", "governorate", ""},
+	{"KZ-AKM", "Akmolinskaja oblast'", "region", ""},
+	{"KZ-AKT", "Aktjubinskaja oblast'", "region", ""},
+	{"KZ-ALA", "Almaty", "city", ""},
+	{"KZ-ALM", "Almatinskaja oblast'", "region", ""},
+	{"KZ-AST", "Nur-Sultan", "city", ""},
+	{"KZ-ATY", "Atyrauskaja oblast'", "region", ""},
+	{"KZ-KAR", "Karagandinskaja oblast'", "region", ""},
+	{"KZ-KUS", "Kostanajskaja oblast'", "region", ""},
+	{"KZ-KZY", "Kyzylordinskaja oblast'", "region", ""},
+	{"KZ-MAN", "Mangghystaū oblysy", "region", ""},
+	{"KZ-PAV", "Pavlodar oblysy", "region", ""},
+	{"KZ-SEV", "Severo-Kazahstanskaja oblast'", "region", ""},
+	{"KZ-SHY", "Shymkent", "city", ""},
+	{"KZ-VOS", "Shyghys Qazaqstan oblysy", "region", ""},
+	{"KZ-YUZ", "Turkestankaya oblast'", "region", ""},
+	{"KZ-ZAP", "Batys Qazaqstan oblysy", "region", ""},
+	{"KZ-ZHA", "Zhambyl oblysy", "region", ""},
+	{"LA-AT", "Attapu", "province", ""},
+	{"LA-BK", "Bokèo", "province", ""},
+	{"LA-BL", "Bolikhamxai", "province", ""},
+	{"LA-CH", "Champasak", "province", ""},
+	{"LA-HO", "Houaphan", "province", ""},
+	{"LA-KH", "Khammouan", "province", ""},
+	{"LA-LM", "Louang Namtha", "province", ""},
+	{"LA-LP", "Louangphabang", "province", ""},
+	{"LA-OU", "Oudômxai", "province", ""},
+	{"LA-PH", "Phôngsali", "province", ""},
+	{"LA-SL", "Salavan", "province", ""},
+	{"LA-SV", "Savannakhét", "province", ""},
+	{"LA-VI", "Viangchan", "province", ""},
+	{"LA-VT", "Viangchan", "prefecture", ""},
+	{"LA-XA", "Xaignabouli", "province", ""},
+	{"LA-XE", "Xékong", "province", ""},
+	{"LA-XI", "Xiangkhouang", "province", ""},
+	{"LA-XS", "Xaisômboun", "province", ""},
+	{"LB-AK", "Aakkâr", "governorate", ""},
+	{"LB-AS", "Ash Shimāl", "governorate", ""},
+	{"LB-BA", "Bayrūt", "governorate", ""},
+	{"LB-BH", "Baalbek-Hermel", "governorate", ""},
+	{"LB-BI", "Al Biqā‘", "governorate", ""},
+	{"LB-JA", "Al Janūb", "governorate", ""},
+	{"LB-JL", "Jabal Lubnān", "governorate", ""},
+	{"LB-NA", "An Nabaţīyah", "governorate", ""},
+	{"LC-01", "Anse la Raye", "district", ""},
+	{"LC-02", "Castries", "district", ""},
+	{"LC-03", "Choiseul", "district", ""},
+	{"LC-05", "Dennery", "district", ""},
+	{"LC-06", "Gros Islet", "district", ""},
+	{"LC-07", "Laborie", "district", ""},
+	{"LC-08", "Micoud", "district", ""},
+	{"LC-10", "Soufrière", "district", ""},
+	{"LC-11", "Vieux Fort", "district", ""},
+	{"LC-12", "Canaries", "district", ""},
+	{"LI-01", "Balzers", "commune", ""},
+	{"LI-02", "Eschen", "commune", ""},
+	{"LI-03", "Gamprin", "commune", ""},
+	{"LI-04", "Mauren", "commune", ""},
+	{"LI-05", "Planken", "commune", ""},
+	{"LI-06", "Ruggell", "commune", ""},
+	{"LI-07", "Schaan", "commune", ""},
+	{"LI-08", "Schellenberg", "commune", ""},
+	{"LI-09", "Triesen", "commune", ""},
+	{"LI-10", "Triesenberg", "commune", ""},
+	{"LI-11", "Vaduz", "commune", ""},
+	{"LK-1", "Western Province", "province", ""},
+	{"LK-11", "Colombo", "district", "LK-1"},
+	{"LK-12", "Gampaha", "district", "LK-1"},
+	{"LK-13", "Kalutara", "district", "LK-1"},
+	{"LK-2", "Central Province", "province", ""},
+	{"LK-21", "Kandy", "district", "LK-2"},
+	{"LK-22", "Matale", "district", "LK-2"},
+	{"LK-23", "Nuwara Eliya", "district", "LK-2"},
+	{"LK-3", "Southern Province", "province", ""},
+	{"LK-31", "Galle", "district", "LK-3"},
+	{"LK-32", "Matara", "district", "LK-3"},
+	{"LK-33", "Hambantota", "district", "LK-3"},
+	{"LK-4", "Northern Province", "province", ""},
+	{"LK-41", "Jaffna", "district", "LK-4"},
+	{"LK-42", "Kilinochchi", "district", "LK-4"},
+	{"LK-43", "Mannar", "district", "LK-4"},
+	{"LK-44", "Vavuniya", "district", "LK-4"},
+	{"LK-45", "Mullaittivu", "district", "LK-4"},
+	{"LK-5", "Eastern Province", "province", ""},
+	{"LK-51", "Batticaloa", "district", "LK-5"},
+	{"LK-52", "Ampara", "district", "LK-5"},
+	{"LK-53", "Trincomalee", "district", "LK-5"},
+	{"LK-6", "North Western Province", "province", ""},
+	{"LK-61", "Kurunegala", "district", "LK-6"},
+	{"LK-62", "Puttalam", "district", "LK-6"},
+	{"LK-7", "North Central Province", "province", ""},
+	{"LK-71", "Anuradhapura", "district", "LK-7"},
+	{"LK-72", "Polonnaruwa", "district", "LK-7"},
+	{"LK-8", "Uva Province", "province", ""},
+	{"LK-81", "Badulla", "district", "LK-8"},
+	{"LK-82", "Monaragala", "district", "LK-8"},
+	{"LK-9", "Sabaragamuwa Province", "province", ""},
+	{"LK-91", "Ratnapura", "district", "LK-9"},
+	{"LK-92", "Kegalla", "district", "LK-9"},
+	{"LR-BG", "Bong", "county", ""},
+	{"LR-BM", "Bomi", "county", ""},
+	{"LR-CM", "Grand Cape Mount", "county", ""},
+	{"LR-GB", "Grand Bassa", "county", ""},
+	{"LR-GG", "Grand Gedeh", "county", ""},
+	{"LR-GK", "Grand Kru", "county", ""},
+	{"LR-GP", "Gbarpolu", "county", ""},
+	{"LR-LO", "Lofa", "county", ""},
+	{"LR-MG", "Margibi", "county", ""},
+	{"LR-MO", "Montserrado", "county", ""},
+	{"LR-MY", "Maryland", "county", ""},
+	{"LR-NI", "Nimba", "county", ""},
+	{"LR-RG", "River Gee", "county", ""},
+	{"LR-RI", "River Cess", "county", ""},
+	{"LR-SI", "Sinoe", "county", ""},
+	{"LS-A", "Maseru", "district", ""},
+	{"LS-B", "Botha-Bothe", "district", ""},
+	{"LS-C", "Leribe", "district", ""},
+	{"LS-D", "Berea", "district", ""},
+	{"LS-E", "Mafeteng", "district", ""},
+	{"LS-F", "Mohale's Hoek", "district", ""},
+	{"LS-G", "Quthing", "district", ""},
+	{"LS-H", "Qacha's Nek", "district", ""},
+	{"LS-J", "Mokhotlong", "district", ""},
+	{"LS-K", "Thaba-Tseka", "district", ""},
+	{"LT-01", "Akmenė", "district municipality", ""},
+	{"LT-02", "Alytaus miestas", "city municipality", ""},
+	{"LT-03", "Alytus", "district municipality", ""},
+	{"LT-04", "Anykščiai", "district municipality", ""},
+	{"LT-05", "Birštono", "municipality", ""},
+	{"LT-06", "Biržai", "district municipality", ""},
+	{"LT-07", "Druskininkai", "municipality", ""},
+	{"LT-08", "Elektrėnai", "municipality", ""},
+	{"LT-09", "Ignalina", "district municipality", ""},
+	{"LT-10", "Jonava", "district municipality", ""},
+	{"LT-11", "Joniškis", "district municipality", ""},
+	{"LT-12", "Jurbarkas", "district municipality", ""},
+	{"LT-13", "Kaišiadorys", "district municipality", ""},
+	{"LT-14", "Kalvarijos", "municipality", ""},
+	{"LT-15", "Kauno miestas", "city municipality", ""},
+	{"LT-16", "Kaunas", "district municipality", ""},
+	{"LT-17", "Kazlų Rūdos", "municipality", ""},
+	{"LT-18", "Kėdainiai", "district municipality", ""},
+	{"LT-19", "Kelmė", "district municipality", ""},
+	{"LT-20", "Klaipėdos miestas", "city municipality", ""},
+	{"LT-21", "Klaipėda", "district municipality", ""},
+	{"LT-22", "Kretinga", "district municipality", ""},
+	{"LT-23", "Kupiškis", "district municipality", ""},
+	{"LT-24", "Lazdijai", "district municipality", ""},
+	{"LT-25", "Marijampolė", "district municipality", ""},
+	{"LT-26", "Mažeikiai", "district municipality", ""},
+	{"LT-27", "Molėtai", "district municipality", ""},
+	{"LT-28", "Neringa", "municipality", ""},
+	{"LT-29", "Pagėgiai", "municipality", ""},
+	{"LT-30", "Pakruojis", "district municipality", ""},
+	{"LT-31", "Palangos miestas", "city municipality", ""},
+	{"LT-32", "Panevėžio miestas", "city municipality", ""},
+	{"LT-33", "Panevėžys", "district municipality", ""},
+	{"LT-34", "Pasvalys", "district municipality", ""},
+	{"LT-35", "Plungė", "district municipality", ""},
+	{"LT-36", "Prienai", "district municipality", ""},
+	{"LT-37", "Radviliškis", "district municipality", ""},
+	{"LT-38", "Raseiniai", "district municipality", ""},
+	{"LT-39", "Rietavo", "municipality", ""},
+	{"LT-40", "Rokiškis", "district municipality", ""},
+	{"LT-41", "Šakiai", "district municipality", ""},
+	{"LT-42", "Šalčininkai", "district municipality", ""},
+	{"LT-43", "Šiaulių miestas", "city municipality", ""},
+	{"LT-44", "Šiauliai", "district municipality", ""},
+	{"LT-45", "Šilalė", "district municipality", ""},
+	{"LT-46", "Šilutė", "district municipality", ""},
+	{"LT-47", "Širvintos", "district municipality", ""},
+	{"LT-48", "Skuodas", "district municipality", ""},
+	{"LT-49", "Švenčionys", "district municipality", ""},
+	{"LT-50", "Tauragė", "district municipality", ""},
+	{"LT-51", "Telšiai", "district municipality", ""},
+	{"LT-52", "Trakai", "district municipality", ""},
+	{"LT-53", "Ukmergė", "district municipality", ""},
+	{"LT-54", "Utena", "district municipality", ""},
+	{"LT-55", "Varėna", "district municipality", ""},
+	{"LT-56", "Vilkaviškis", "district municipality", ""},
+	{"LT-57", "Vilniaus miestas", "city municipality", ""},
+	{"LT-58", "Vilnius", "district municipality", ""},
+	{"LT-59", "Visaginas", "municipality", ""},
+	{"LT-60", "Zarasai", "district municipality", ""},
+	{"LT-AL", "Alytaus apskritis", "county", ""},
+	{"LT-KL", "Klaipėdos apskritis", "county", ""},
+	{"LT-KU", "Kauno apskritis", "county", ""},
+	{"LT-MR", "Marijampolės apskritis", "county", ""},
+	{"LT-PN", "Panevėžio apskritis", "county", ""},
+	{"LT-SA", "Šiaulių apskritis", "county", ""},
+	{"LT-TA", "Tauragės apskritis", "county", ""},
+	{"LT-TE", "Telšių apskritis", "county", ""},
+	{"LT-UT", "Utenos apskritis", "county", ""},
+	{"LT-VL", "Vilniaus apskritis", "county", ""},
+	{"LU-CA", "Capellen", "canton", ""},
+	{"LU-CL", "Clerf", "canton", ""},
+	{"LU-DI", "Diekirch", "canton", ""},
+	{"LU-EC", "Echternach", "canton", ""},
+	{"LU-ES", "Esch an der Alzette", "canton", ""},
+	{"LU-GR", "Grevenmacher", "canton", ""},
+	{"LU-LU", "Luxembourg", "canton", ""},
+	{"LU-ME", "Mersch", "canton", ""},
+	{"LU-RD", "Redange", "canton", ""},
+	{"LU-RM", "Remich", "canton", ""},
+	{"LU-VD", "Veianen", "canton", ""},
+	{"LU-WI", "Wiltz", "canton", ""},
+	{"LV-001", "Aglonas novads", "municipality", ""},
+	{"LV-002", "Aizkraukles novads", "municipality", ""},
+	{"LV-003", "Aizputes novads", "municipality", ""},
+	{"LV-004", "Aknīstes novads", "municipality", ""},
+	{"LV-005", "Alojas novads", "municipality", ""},
+	{"LV-006", "Alsungas novads", "municipality", ""},
+	{"LV-007", "Alūksnes novads", "municipality", ""},
+	{"LV-008", "Amatas novads", "municipality", ""},
+	{"LV-009", "Apes novads", "municipality", ""},
+	{"LV-010", "Auces novads", "municipality", ""},
+	{"LV-011", "Ādažu novads", "municipality", ""},
+	{"LV-012", "Babītes novads", "municipality", ""},
+	{"LV-013", "Baldones novads", "municipality", ""},
+	{"LV-014", "Baltinavas novads", "municipality", ""},
+	{"LV-015", "Balvu novads", "municipality", ""},
+	{"LV-016", "Bauskas novads", "municipality", ""},
+	{"LV-017", "Beverīnas novads", "municipality", ""},
+	{"LV-018", "Brocēnu novads", "municipality", ""},
+	{"LV-019", "Burtnieku novads", "municipality", ""},
+	{"LV-020", "Carnikavas novads", "municipality", ""},
+	{"LV-021", "Cesvaines novads", "municipality", ""},
+	{"LV-022", "Cēsu novads", "municipality", ""},
+	{"LV-023", "Ciblas novads", "municipality", ""},
+	{"LV-024", "Dagdas novads", "municipality", ""},
+	{"LV-025", "Daugavpils novads", "municipality", ""},
+	{"LV-026", "Dobeles novads", "municipality", ""},
+	{"LV-027", "Dundagas novads", "municipality", ""},
+	{"LV-028", "Durbes novads", "municipality", ""},
+	{"LV-029", "Engures novads", "municipality", ""},
+	{"LV-030", "Ērgļu novads", "municipality", ""},
+	{"LV-031", "Garkalnes novads", "municipality", ""},
+	{"LV-032", "Grobiņas novads", "municipality", ""},
+	{"LV-033", "Gulbenes novads", "municipality", ""},
+	{"LV-034", "Iecavas novads", "municipality", ""},
+	{"LV-035", "Ikšķiles novads", "municipality", ""},
+	{"LV-036", "Ilūkstes novads", "municipality", ""},
+	{"LV-037", "Inčukalna novads", "municipality", ""},
+	{"LV-038", "Jaunjelgavas novads", "municipality", ""},
+	{"LV-039", "Jaunpiebalgas novads", "municipality", ""},
+	{"LV-040", "Jaunpils novads", "municipality", ""},
+	{"LV-041", "Jelgavas novads", "municipality", ""},
+	{"LV-042", "Jēkabpils novads", "municipality", ""},
+	{"LV-043", "Kandavas novads", "municipality", ""},
+	{"LV-044", "Kārsavas novads", "municipality", ""},
+	{"LV-045", "Kocēnu novads", "municipality", ""},
+	{"LV-046", "Kokneses novads", "municipality", ""},
+	{"LV-047", "Krāslavas novads", "municipality", ""},
+	{"LV-048", "Krimuldas novads", "municipality", ""},
+	{"LV-049", "Krustpils novads", "municipality", ""},
+	{"LV-050", "Kuldīgas novads", "municipality", ""},
+	{"LV-051", "Ķeguma novads", "municipality", ""},
+	{"LV-052", "Ķekavas novads", "municipality", ""},
+	{"LV-053", "Lielvārdes novads", "municipality", ""},
+	{"LV-054", "Limbažu novads", "municipality", ""},
+	{"LV-055", "Līgatnes novads", "municipality", ""},
+	{"LV-056", "Līvānu novads", "municipality", ""},
+	{"LV-057", "Lubānas novads", "municipality", ""},
+	{"LV-058", "Ludzas novads", "municipality", ""},
+	{"LV-059", "Madonas novads", "municipality", ""},
+	{"LV-060", "Mazsalacas novads", "municipality", ""},
+	{"LV-061", "Mālpils novads", "municipality", ""},
+	{"LV-062", "Mārupes novads", "municipality", ""},
+	{"LV-063", "Mērsraga novads", "municipality", ""},
+	{"LV-064", "Naukšēnu novads", "municipality", ""},
+	{"LV-065", "Neretas novads", "municipality", ""},
+	{"LV-066", "Nīcas novads", "municipality", ""},
+	{"LV-067", "Ogres novads", "municipality", ""},
+	{"LV-068", "Olaines novads", "municipality", ""},
+	{"LV-069", "Ozolnieku novads", "municipality", ""},
+	{"LV-070", "Pārgaujas novads", "municipality", ""},
+	{"LV-071", "Pāvilostas novads", "municipality", ""},
+	{"LV-072", "Pļaviņu novads", "municipality", ""},
+	{"LV-073", "Preiļu novads", "municipality", ""},
+	{"LV-074", "Priekules novads", "municipality", ""},
+	{"LV-075", "Priekuļu novads", "municipality", ""},
+	{"LV-076", "Raunas novads", "municipality", ""},
+	{"LV-077", "Rēzeknes novads", "municipality", ""},
+	{"LV-078", "Riebiņu novads", "municipality", ""},
+	{"LV-079", "Rojas novads", "municipality", ""},
+	{"LV-080", "Ropažu novads", "municipality", ""},
+	{"LV-081", "Rucavas novads", "municipality", ""},
+	{"LV-082", "Rugāju novads", "municipality", ""},
+	{"LV-083", "Rundāles novads", "municipality", ""},
+	{"LV-084", "Rūjienas novads", "municipality", ""},
+	{"LV-085", "Salas novads", "municipality", ""},
+	{"LV-086", "Salacgrīvas novads", "municipality", ""},
+	{"LV-087", "Salaspils novads", "municipality", ""},
+	{"LV-088", "Saldus novads", "municipality", ""},
+	{"LV-089", "Saulkrastu novads", "municipality", ""},
+	{"LV-090", "Sējas novads", "municipality", ""},
+	{"LV-091", "Siguldas novads", "municipality", ""},
+	{"LV-092", "Skrīveru novads", "municipality", ""},
+	{"LV-093", "Skrundas novads", "municipality", ""},
+	{"LV-094", "Smiltenes novads", "municipality", ""},
+	{"LV-095", "Stopiņu novads", "municipality", ""},
+	{"LV-096", "Strenču novads", "municipality", ""},
+	{"LV-097", "Talsu novads", "municipality", ""},
+	{"LV-098", "Tērvetes novads", "municipality", ""},
+	{"LV-099", "Tukuma novads", "municipality", ""},
+	{"LV-100", "Vaiņodes novads", "municipality", ""},
+	{"LV-101", "Valkas novads", "municipality", ""},
+	{"LV-102", "Varakļānu novads", "municipality", ""},
+	{"LV-103", "Vārkavas novads", "municipality", ""},
+	{"LV-104", "Vecpiebalgas novads", "municipality", ""},
+	{"LV-105", "Vecumnieku novads", "municipality", ""},
+	{"LV-106", "Ventspils novads", "municipality", ""},
+	{"LV-107", "Viesītes novads", "municipality", ""},
+	{"LV-108", "Viļakas novads", "municipality", ""},
+	{"LV-109", "Viļānu novads", "municipality", ""},
+	{"LV-110", "Zilupes novads", "municipality", ""},
+	{"LV-DGV", "Daugavpils", "republican city", ""},
+	{"LV-JEL", "Jelgava", "republican city", ""},
+	{"LV-JKB", "Jēkabpils", "republican city", ""},
+	{"LV-JUR", "Jūrmala", "republican city", ""},
+	{"LV-LPX", "Liepāja", "republican city", ""},
+	{"LV-REZ", "Rēzekne", "republican city", ""},
+	{"LV-RIX", "Rīga", "republican city", ""},
+	{"LV-VEN", "Ventspils", "republican city", ""},
+	{"LV-VMR", "Valmiera", "republican city", ""},
+	{"LY-BA", "Banghāzī", "popularate", ""},
+	{"LY-BU", "Al Buţnān", "popularate", ""},
+	{"LY-DR", "Darnah", "popularate", ""},
+	{"LY-GT", "Ghāt", "popularate", ""},
+	{"LY-JA", "Al Jabal al Akhḑar", "popularate", ""},
+	{"LY-JG", "Al Jabal al Gharbī", "popularate", ""},
+	{"LY-JI", "Al Jafārah", "popularate", ""},
+	{"LY-JU", "Al Jufrah", "popularate", ""},
+	{"LY-KF", "Al Kufrah", "popularate", ""},
+	{"LY-MB", "Al Marqab", "popularate", ""},
+	{"LY-MI", "Mişrātah", "popularate", ""},
+	{"LY-MJ", "Al Marj", "popularate", ""},
+	{"LY-MQ", "Murzuq", "popularate", ""},
+	{"LY-NL", "Nālūt", "popularate", ""},
+	{"LY-NQ", "An Nuqāţ al Khams", "popularate", ""},
+	{"LY-SB", "Sabhā", "popularate", ""},
+	{"LY-SR", "Surt", "popularate", ""},
+	{"LY-TB", "Ţarābulus", "popularate", ""},
+	{"LY-WA", "Al Wāḩāt", "popularate", ""},
+	{"LY-WD", "Wādī al Ḩayāt", "popularate", ""},
+	{"LY-WS", "Wādī ash Shāţi’", "popularate", ""},
+	{"LY-ZA", "Az Zāwiyah", "popularate", ""},
+	{"MA-01", "Tanger-Tétouan-Al Hoceïma", "region", ""},
+	{"MA-02", "L'Oriental", "region", ""},
+	{"MA-03", "Fès-Meknès", "region", ""},
+	{"MA-04", "Rabat-Salé-Kénitra", "region", ""},
+	{"MA-05", "Béni Mellal-Khénifra", "region", ""},
+	{"MA-06", "Casablanca-Settat", "region", ""},
+	{"MA-07", "Marrakech-Safi", "region", ""},
+	{"MA-08", "Drâa-Tafilalet", "region", ""},
+	{"MA-09", "Souss-Massa", "region", ""},
+	{"MA-10", "Guelmim-Oued Noun (EH-partial)", "region", ""},
+	{"MA-11", "Laâyoune-Sakia El Hamra (EH-partial)", "region", ""},
+	{"MA-12", "Dakhla-Oued Ed-Dahab (EH)", "region", ""},
+	{"MA-AGD", "Agadir-Ida-Ou-Tanane", "prefecture", "MA-09"},
+	{"MA-AOU", "Aousserd (EH)", "province", "MA-12"},
+	{"MA-ASZ", "Assa-Zag (EH-partial)", "province", "MA-10"},
+	{"MA-AZI", "Azilal", "province", "MA-05"},
+	{"MA-BEM", "Béni Mellal", "province", "MA-05"},
+	{"MA-BER", "Berkane", "province", "MA-02"},
+	{"MA-BES", "Benslimane", "province", "MA-06"},
+	{"MA-BOD", "Boujdour (EH)", "province", "MA-11"},
+	{"MA-BOM", "Boulemane", "province", "MA-03"},
+	{"MA-BRR", "Berrechid", "province", "MA-06"},
+	{"MA-CAS", "Casablanca", "prefecture", "MA-06"},
+	{"MA-CHE", "Chefchaouen", "province", "MA-01"},
+	{"MA-CHI", "Chichaoua", "province", "MA-07"},
+	{"MA-CHT", "Chtouka-Ait Baha", "province", "MA-06"},
+	{"MA-DRI", "Driouch", "province", "MA-02"},
+	{"MA-ERR", "Errachidia", "province", "MA-08"},
+	{"MA-ESI", "Essaouira", "province", "MA-07"},
+	{"MA-ESM", "Es-Semara (EH-partial)", "province", "MA-11"},
+	{"MA-FAH", "Fahs-Anjra", "province", "MA-01"},
+	{"MA-FES", "Fès", "prefecture", "MA-03"},
+	{"MA-FIG", "Figuig", "province", "MA-02"},
+	{"MA-FQH", "Fquih Ben Salah", "province", "MA-05"},
+	{"MA-GUE", "Guelmim", "province", "MA-10"},
+	{"MA-GUF", "Guercif", "province", "MA-02"},
+	{"MA-HAJ", "El Hajeb", "province", "MA-03"},
+	{"MA-HAO", "Al Haouz", "province", "MA-07"},
+	{"MA-HOC", "Al Hoceïma", "province", "MA-01"},
+	{"MA-IFR", "Ifrane", "province", "MA-03"},
+	{"MA-INE", "Inezgane-Ait Melloul", "prefecture", "MA-09"},
+	{"MA-JDI", "El Jadida", "province", "MA-06"},
+	{"MA-JRA", "Jerada", "province", "MA-02"},
+	{"MA-KEN", "Kénitra", "province", "MA-04"},
+	{"MA-KES", "El Kelâa des Sraghna", "province", "MA-07"},
+	{"MA-KHE", "Khémisset", "province", "MA-04"},
+	{"MA-KHN", "Khénifra", "province", "MA-05"},
+	{"MA-KHO", "Khouribga", "province", "MA-05"},
+	{"MA-LAA", "Laâyoune (EH)", "province", "MA-11"},
+	{"MA-LAR", "Larache", "province", "MA-01"},
+	{"MA-MAR", "Marrakech", "prefecture", "MA-07"},
+	{"MA-MDF", "M’diq-Fnideq", "prefecture", "MA-01"},
+	{"MA-MED", "Médiouna", "province", "MA-06"},
+	{"MA-MEK", "Meknès", "prefecture", "MA-03"},
+	{"MA-MID", "Midelt", "province", "MA-08"},
+	{"MA-MOH", "Mohammadia", "prefecture", "MA-06"},
+	{"MA-MOU", "Moulay Yacoub", "province", "MA-03"},
+	{"MA-NAD", "Nador", "province", "MA-02"},
+	{"MA-NOU", "Nouaceur", "province", "MA-04"},
+	{"MA-OUA", "Ouarzazate", "province", "MA-08"},
+	{"MA-OUD", "Oued Ed-Dahab (EH)", "province", "MA-12"},
+	{"MA-OUJ", "Oujda-Angad", "prefecture", "MA-02"},
+	{"MA-OUZ", "Ouezzane", "province", "MA-01"},
+	{"MA-RAB", "Rabat", "prefecture", "MA-04"},
+	{"MA-REH", "Rehamna", "province", "MA-07"},
+	{"MA-SAF", "Safi", "province", "MA-07"},
+	{"MA-SAL", "Salé", "prefecture", "MA-04"},
+	{"MA-SEF", "Sefrou", "province", "MA-03"},
+	{"MA-SET", "Settat", "province", "MA-06"},
+	{"MA-SIB", "Sidi Bennour", "province", "MA-06"},
+	{"MA-SIF", "Sidi Ifni", "province", "MA-10"},
+	{"MA-SIK", "Sidi Kacem", "province", "MA-04"},
+	{"MA-SIL", "Sidi Slimane", "province", "MA-04"},
+	{"MA-SKH", "Skhirate-Témara", "prefecture", "MA-04"},
+	{"MA-TAF", "Tarfaya (EH-partial)", "province", "MA-11"},
+	{"MA-TAI", "Taourirt", "province", "MA-02"},
+	{"MA-TAO", "Taounate", "province", "MA-03"},
+	{"MA-TAR", "Taroudannt", "province", "MA-09"},
+	{"MA-TAT", "Tata", "province", "MA-09"},
+	{"MA-TAZ", "Taza", "province", "MA-03"},
+	{"MA-TET", "Tétouan", "province", "MA-01"},
+	{"MA-TIN", "Tinghir", "province", "MA-08"},
+	{"MA-TIZ", "Tiznit", "province", "MA-09"},
+	{"MA-TNG", "Tanger-Assilah", "prefecture", "MA-01"},
+	{"MA-TNT", "Tan-Tan (EH-partial)", "province", "MA-10"},
+	{"MA-YUS", "Youssoufia", "province", "MA-07"},
+	{"MA-ZAG", "Zagora", "province", "MA-08"},
+	{"MC-CL", "La Colle", "quarter", ""},
+	{"MC-CO", "La Condamine", "quarter", ""},
+	{"MC-FO", "Fontvieille", "quarter", ""},
+	{"MC-GA", "La Gare", "quarter", ""},
+	{"MC-JE", "Jardin Exotique", "quarter", ""},
+	{"MC-LA", "Larvotto", "quarter", ""},
+	{"MC-MA", "Malbousquet", "quarter", ""},
+	{"MC-MC", "Monte-Carlo", "quarter", ""},
+	{"MC-MG", "Moneghetti", "quarter", ""},
+	{"MC-MO", "Monaco-Ville", "quarter", ""},
+	{"MC-MU", "Moulins", "quarter", ""},
+	{"MC-PH", "Port-Hercule", "quarter", ""},
+	{"MC-SD", "Sainte-Dévote", "quarter", ""},
+	{"MC-SO", "La Source", "quarter", ""},
+	{"MC-SP", "Spélugues", "quarter", ""},
+	{"MC-SR", "Saint-Roman", "quarter", ""},
+	{"MC-VR", "Vallon de la Rousse", "quarter", ""},
+	{"MD-AN", "Anenii Noi", "district", ""},
+	{"MD-BA", "Bălți", "city", ""},
+	{"MD-BD", "Bender [Tighina]", "city", ""},
+	{"MD-BR", "Briceni", "district", ""},
+	{"MD-BS", "Basarabeasca", "district", ""},
+	{"MD-CA", "Cahul", "district", ""},
+	{"MD-CL", "Călărași", "district", ""},
+	{"MD-CM", "Cimișlia", "district", ""},
+	{"MD-CR", "Criuleni", "district", ""},
+	{"MD-CS", "Căușeni", "district", ""},
+	{"MD-CT", "Cantemir", "district", ""},
+	{"MD-CU", "Chișinău", "city", ""},
+	{"MD-DO", "Dondușeni", "district", ""},
+	{"MD-DR", "Drochia", "district", ""},
+	{"MD-DU", "Dubăsari", "district", ""},
+	{"MD-ED", "Edineț", "district", ""},
+	{"MD-FA", "Fălești", "district", ""},
+	{"MD-FL", "Florești", "district", ""},
+	{"MD-GA", "Găgăuzia, Unitatea teritorială autonomă (UTAG)", "autonomous territorial unit", ""},
+	{"MD-GL", "Glodeni", "district", ""},
+	{"MD-HI", "Hîncești", "district", ""},
+	{"MD-IA", "Ialoveni", "district", ""},
+	{"MD-LE", "Leova", "district", ""},
+	{"MD-NI", "Nisporeni", "district", ""},
+	{"MD-OC", "Ocnița", "district", ""},
+	{"MD-OR", "Orhei", "district", ""},
+	{"MD-RE", "Rezina", "district", ""},
+	{"MD-RI", "Rîșcani", "district", ""},
+	{"MD-SD", "Șoldănești", "district", ""},
+	{"MD-SI", "Sîngerei", "district", ""},
+	{"MD-SN", "Stînga Nistrului, unitatea teritorială din", "territorial unit", ""},
+	{"MD-SO", "Soroca", "district", ""},
+	{"MD-ST", "Strășeni", "district", ""},
+	{"MD-SV", "Ștefan Vodă", "district", ""},
+	{"MD-TA", "Taraclia", "district", ""},
+	{"MD-TE", "Telenești", "district", ""},
+	{"MD-UN", "Ungheni", "district", ""},
+	{"ME-01", "Andrijevica", "municipality", ""},
+	{"ME-02", "Bar", "municipality", ""},
+	{"ME-03", "Berane", "municipality", ""},
+	{"ME-04", "Bijelo Polje", "municipality", ""},
+	{"ME-05", "Budva", "municipality", ""},
+	{"ME-06", "Cetinje", "municipality", ""},
+	{"ME-07", "Danilovgrad", "municipality", ""},
+	{"ME-08", "Herceg-Novi", "municipality", ""},
+	{"ME-09", "Kolašin", "municipality", ""},
+	{"ME-10", "Kotor", "municipality", ""},
+	{"ME-11", "Mojkovac", "municipality", ""},
+	{"ME-12", "Nikšić", "municipality", ""},
+	{"ME-13", "Plav", "municipality", ""},
+	{"ME-14", "Pljevlja", "municipality", ""},
+	{"ME-15", "Plužine", "municipality", ""},
+	{"ME-16", "Podgorica", "municipality", ""},
+	{"ME-17", "Rožaje", "municipality", ""},
+	{"ME-18", "Šavnik", "municipality", ""},
+	{"ME-19", "Tivat", "municipality", ""},
+	{"ME-20", "Ulcinj", "municipality", ""},
+	{"ME-21", "Žabljak", "municipality", ""},
+	{"ME-22", "Gusinje", "municipality", ""},
+	{"ME-23", "Petnjica", "municipality", ""},
+	{"ME-24", "Tuzi", "municipality", ""},
+	{"MG-A", "Toamasina", "province", ""},
+	{"MG-D", "Antsiranana", "province", ""},
+	{"MG-F", "Fianarantsoa", "province", ""},
+	{"MG-M", "Mahajanga", "province", ""},
+	{"MG-T", "Antananarivo", "province", ""},
+	{"MG-U", "Toliara", "province", ""},
+	{"MH-ALK", "Ailuk", "municipality", "MH-T"},
+	{"MH-ALL", "Ailinglaplap", "municipality", "MH-L"},
+	{"MH-ARN", "Arno", "municipality", "MH-T"},
+	{"MH-AUR", "Aur", "municipality", "MH-T"},
+	{"MH-EBO", "Ebon", "municipality", "MH-L"},
+	{"MH-ENI", "Enewetak & Ujelang", "municipality", "MH-L"},
+	{"MH-JAB", "Jabat", "municipality", "MH-L"},
+	{"MH-JAL", "Jaluit", "municipality", "MH-L"},
+	{"MH-KIL", "Bikini & Kili", "municipality", "MH-L"},
+	{"MH-KWA", "Kwajalein", "municipality", "MH-L"},
+	{"MH-L", "Ralik chain", "chain (of islands)", ""},
+	{"MH-LAE", "Lae", "municipality", "MH-L"},
+	{"MH-LIB", "Lib", "municipality", "MH-L"},
+	{"MH-LIK", "Likiep", "municipality", "MH-T"},
+	{"MH-MAJ", "Majuro", "municipality", "MH-T"},
+	{"MH-MAL", "Maloelap", "municipality", "MH-T"},
+	{"MH-MEJ", "Mejit", "municipality", "MH-T"},
+	{"MH-MIL", "Mili", "municipality", "MH-T"},
+	{"MH-NMK", "Namdrik", "municipality", "MH-L"},
+	{"MH-NMU", "Namu", "municipality", "MH-L"},
+	{"MH-RON", "Rongelap", "municipality", "MH-L"},
+	{"MH-T", "Ratak chain", "chain (of islands)", ""},
+	{"MH-UJA", "Ujae", "municipality", "MH-L"},
+	{"MH-UTI", "Utrik", "municipality", "MH-T"},
+	{"MH-WTH", "Wotho", "municipality", "MH-L"},
+	{"MH-WTJ", "Wotje", "municipality", "MH-T"},
+	{"MK-101", "Veles", "municipality", ""},
+	{"MK-102", "Gradsko", "municipality", ""},
+	{"MK-103", "Demir Kapija", "municipality", ""},
+	{"MK-104", "Kavadarci", "municipality", ""},
+	{"MK-105", "Lozovo", "municipality", ""},
+	{"MK-106", "Negotino", "municipality", ""},
+	{"MK-107", "Rosoman", "municipality", ""},
+	{"MK-108", "Sveti Nikole", "municipality", ""},
+	{"MK-109", "Čaška", "municipality", ""},
+	{"MK-201", "Berovo", "municipality", ""},
+	{"MK-202", "Vinica", "municipality", ""},
+	{"MK-203", "Delčevo", "municipality", ""},
+	{"MK-204", "Zrnovci", "municipality", ""},
+	{"MK-205", "Karbinci", "municipality", ""},
+	{"MK-206", "Kočani", "municipality", ""},
+	{"MK-207", "Makedonska Kamenica", "municipality", ""},
+	{"MK-208", "Pehčevo", "municipality", ""},
+	{"MK-209", "Probištip", "municipality", ""},
+	{"MK-210", "Češinovo-Obleševo", "municipality", ""},
+	{"MK-211", "Štip", "municipality", ""},
+	{"MK-301", "Vevčani", "municipality", ""},
+	{"MK-303", "Debar", "municipality", ""},
+	{"MK-304", "Debrca", "municipality", ""},
+	{"MK-307", "Kičevo", "municipality", ""},
+	{"MK-308", "Makedonski Brod", "municipality", ""},
+	{"MK-310", "Ohrid", "municipality", ""},
+	{"MK-311", "Plasnica", "municipality", ""},
+	{"MK-312", "Struga", "municipality", ""},
+	{"MK-313", "Centar Župa", "municipality", ""},
+	{"MK-401", "Bogdanci", "municipality", ""},
+	{"MK-402", "Bosilovo", "municipality", ""},
+	{"MK-403", "Valandovo", "municipality", ""},
+	{"MK-404", "Vasilevo", "municipality", ""},
+	{"MK-405", "Gevgelija", "municipality", ""},
+	{"MK-406", "Dojran", "municipality", ""},
+	{"MK-407", "Konče", "municipality", ""},
+	{"MK-408", "Novo Selo", "municipality", ""},
+	{"MK-409", "Radoviš", "municipality", ""},
+	{"MK-410", "Strumica", "municipality", ""},
+	{"MK-501", "Bitola", "municipality", ""},
+	{"MK-502", "Demir Hisar", "municipality", ""},
+	{"MK-503", "Dolneni", "municipality", ""},
+	{"MK-504", "Krivogaštani", "municipality", ""},
+	{"MK-505", "Kruševo", "municipality", ""},
+	{"MK-506", "Mogila", "municipality", ""},
+	{"MK-507", "Novaci", "municipality", ""},
+	{"MK-508", "Prilep", "municipality", ""},
+	{"MK-509", "Resen", "municipality", ""},
+	{"MK-601", "Bogovinje", "municipality", ""},
+	{"MK-602", "Brvenica", "municipality", ""},
+	{"MK-603", "Vrapčište", "municipality", ""},
+	{"MK-604", "Gostivar", "municipality", ""},
+	{"MK-605", "Želino", "municipality", ""},
+	{"MK-606", "Jegunovce", "municipality", ""},
+	{"MK-607", "Mavrovo i Rostuše", "municipality", ""},
+	{"MK-608", "Tearce", "municipality", ""},
+	{"MK-609", "Tetovo", "municipality", ""},
+	{"MK-701", "Kratovo", "municipality", ""},
+	{"MK-702", "Kriva Palanka", "municipality", ""},
+	{"MK-703", "Kumanovo", "municipality", ""},
+	{"MK-704", "Lipkovo", "municipality", ""},
+	{"MK-705", "Rankovce", "municipality", ""},
+	{"MK-706", "Staro Nagoričane", "municipality", ""},
+	{"MK-801", "Aerodrom †", "municipality", ""},
+	{"MK-802", "Aračinovo", "municipality", ""},
+	{"MK-803", "Butel †", "municipality", ""},
+	{"MK-804", "Gazi Baba †", "municipality", ""},
+	{"MK-805", "Gjorče Petrov †", "municipality", ""},
+	{"MK-806", "Zelenikovo", "municipality", ""},
+	{"MK-807", "Ilinden", "municipality", ""},
+	{"MK-808", "Karpoš †", "municipality", ""},
+	{"MK-809", "Kisela Voda †", "municipality", ""},
+	{"MK-810", "Petrovec", "municipality", ""},
+	{"MK-811", "Saraj †", "municipality", ""},
+	{"MK-812", "Sopište", "municipality", ""},
+	{"MK-813", "Studeničani", "municipality", ""},
+	{"MK-814", "Centar †", "municipality", ""},
+	{"MK-815", "Čair †", "municipality", ""},
+	{"MK-816", "Čučer-Sandevo", "municipality", ""},
+	{"MK-817", "Šuto Orizari †", "municipality", ""},
+	{"ML-1", "Kayes", "region", ""},
+	{"ML-10", "Taoudénit", "region", ""},
+	{"ML-2", "Koulikoro", "region", ""},
+	{"ML-3", "Sikasso", "region", ""},
+	{"ML-4", "Ségou", "region", ""},
+	{"ML-5", "Mopti", "region", ""},
+	{"ML-6", "Tombouctou", "region", ""},
+	{"ML-7", "Gao", "region", ""},
+	{"ML-8", "Kidal", "region", ""},
+	{"ML-9", "Ménaka", "region", ""},
+	{"ML-BKO", "Bamako", "district", ""},
+	{"MM-01", "Sagaing", "region", ""},
+	{"MM-02", "Bago", "region", ""},
+	{"MM-03", "Magway", "region", ""},
+	{"MM-04", "Mandalay", "region", ""},
+	{"MM-05", "Tanintharyi", "region", ""},
+	{"MM-06", "Yangon", "region", ""},
+	{"MM-07", "Ayeyarwady", "region", ""},
+	{"MM-11", "Kachin", "state", ""},
+	{"MM-12", "Kayah", "state", ""},
+	{"MM-13", "Kayin", "state", ""},
+	{"MM-14", "Chin", "state", ""},
+	{"MM-15", "Mon", "state", ""},
+	{"MM-16", "Rakhine", "state", ""},
+	{"MM-17", "Shan", "state", ""},
+	{"MM-18", "Nay Pyi Taw", "union territory", ""},
+	{"MN-035", "Orhon", "province", ""},
+	{"MN-037", "Darhan uul", "province", ""},
+	{"MN-039", "Hentiy", "province", ""},
+	{"MN-041", "Hövsgöl", "province", ""},
+	{"MN-043", "Hovd", "province", ""},
+	{"MN-046", "Uvs", "province", ""},
+	{"MN-047", "Töv", "province", ""},
+	{"MN-049", "Selenge", "province", ""},
+	{"MN-051", "Sühbaatar", "province", ""},
+	{"MN-053", "Ömnögovĭ", "province", ""},
+	{"MN-055", "Övörhangay", "province", ""},
+	{"MN-057", "Dzavhan", "province", ""},
+	{"MN-059", "Dundgovĭ", "province", ""},
+	{"MN-061", "Dornod", "province", ""},
+	{"MN-063", "Dornogovĭ", "province", ""},
+	{"MN-064", "Govĭ-Sümber", "province", ""},
+	{"MN-065", "Govĭ-Altay", "province", ""},
+	{"MN-067", "Bulgan", "province", ""},
+	{"MN-069", "Bayanhongor", "province", ""},
+	{"MN-071", "Bayan-Ölgiy", "province", ""},
+	{"MN-073", "Arhangay", "province", ""},
+	{"MN-1", "Ulaanbaatar", "capital city", ""},
+	{"MR-01", "Hodh ech Chargui", "region", ""},
+	{"MR-02", "Hodh el Gharbi", "region", ""},
+	{"MR-03", "Assaba", "region", ""},
+	{"MR-04", "Gorgol", "region", ""},
+	{"MR-05", "Brakna", "region", ""},
+	{"MR-06", "Trarza", "region", ""},
+	{"MR-07", "Adrar", "region", ""},
+	{"MR-08", "Dakhlet Nouâdhibou", "region", ""},
+	{"MR-09", "Tagant", "region", ""},
+	{"MR-10", "Guidimaka", "region", ""},
+	{"MR-11", "Tiris Zemmour", "region", ""},
+	{"MR-12", "Inchiri", "region", ""},
+	{"MR-13", "Nouakchott Ouest", "region", ""},
+	{"MR-14", "Nouakchott Nord", "region", ""},
+	{"MR-15", "Nouakchott Sud", "region", ""},
+	{"MT-01", "Attard", "local council", ""},
+	{"MT-02", "Balzan", "local council", ""},
+	{"MT-03", "Birgu", "local council", ""},
+	{"MT-04", "Birkirkara", "local council", ""},
+	{"MT-05", "Birżebbuġa", "local council", ""},
+	{"MT-06", "Bormla", "local council", ""},
+	{"MT-07", "Dingli", "local council", ""},
+	{"MT-08", "Fgura", "local council", ""},
+	{"MT-09", "Floriana", "local council", ""},
+	{"MT-10", "Fontana", "local council", ""},
+	{"MT-11", "Gudja", "local council", ""},
+	{"MT-12", "Gżira", "local council", ""},
+	{"MT-13", "Għajnsielem", "local council", ""},
+	{"MT-14", "Għarb", "local council", ""},
+	{"MT-15", "Għargħur", "local council", ""},
+	{"MT-16", "Għasri", "local council", ""},
+	{"MT-17", "Għaxaq", "local council", ""},
+	{"MT-18", "Ħamrun", "local council", ""},
+	{"MT-19", "Iklin", "local council", ""},
+	{"MT-20", "Isla", "local council", ""},
+	{"MT-21", "Kalkara", "local council", ""},
+	{"MT-22", "Kerċem", "local council", ""},
+	{"MT-23", "Kirkop", "local council", ""},
+	{"MT-24", "Lija", "local council", ""},
+	{"MT-25", "Luqa", "local council", ""},
+	{"MT-26", "Marsa", "local council", ""},
+	{"MT-27", "Marsaskala", "local council", ""},
+	{"MT-28", "Marsaxlokk", "local council", ""},
+	{"MT-29", "Mdina", "local council", ""},
+	{"MT-30", "Mellieħa", "local council", ""},
+	{"MT-31", "Mġarr", "local council", ""},
+	{"MT-32", "Mosta", "local council", ""},
+	{"MT-33", "Mqabba", "local council", ""},
+	{"MT-34", "Msida", "local council", ""},
+	{"MT-35", "Mtarfa", "local council", ""},
+	{"MT-36", "Munxar", "local council", ""},
+	{"MT-37", "Nadur", "local council", ""},
+	{"MT-38", "Naxxar", "local council", ""},
+	{"MT-39", "Paola", "local council", ""},
+	{"MT-40", "Pembroke", "local council", ""},
+	{"MT-41", "Pietà", "local council", ""},
+	{"MT-42", "Qala", "local council", ""},
+	{"MT-43", "Qormi", "local council", ""},
+	{"MT-44", "Qrendi", "local council", ""},
+	{"MT-45", "Rabat Gozo", "local council", ""},
+	{"MT-46", "Rabat Malta", "local council", ""},
+	{"MT-47", "Safi", "local council", ""},
+	{"MT-48", "Saint Julian's", "local council", ""},
+	{"MT-49", "Saint John", "local council", ""},
+	{"MT-50", "Saint Lawrence", "local council", ""},
+	{"MT-51", "Saint Paul's Bay", "local council", ""},
+	{"MT-52", "Sannat", "local council", ""},
+	{"MT-53", "Saint Lucia's", "local council", ""},
+	{"MT-54", "Santa Venera", "local council", ""},
+	{"MT-55", "Siġġiewi", "local council", ""},
+	{"MT-56", "Sliema", "local council", ""},
+	{"MT-57", "Swieqi", "local council", ""},
+	{"MT-58", "Ta' Xbiex", "local council", ""},
+	{"MT-59", "Tarxien", "local council", ""},
+	{"MT-60", "Valletta", "local council", ""},
+	{"MT-61", "Xagħra", "local council", ""},
+	{"MT-62", "Xewkija", "local council", ""},
+	{"MT-63", "Xgħajra", "local council", ""},
+	{"MT-64", "Żabbar", "local council", ""},
+	{"MT-65", "Żebbuġ Gozo", "local council", ""},
+	{"MT-66", "Żebbuġ Malta", "local council", ""},
+	{"MT-67", "Żejtun", "local council", ""},
+	{"MT-68", "Żurrieq", "local council", ""},
+	{"MU-AG", "Agalega Islands", "dependency", ""},
+	{"MU-BL", "Black River", "district", ""},
+	{"MU-CC", "Cargados Carajos Shoals", "dependency", ""},
+	{"MU-FL", "Flacq", "district", ""},
+	{"MU-GP", "Grand Port", "district", ""},
+	{"MU-MO", "Moka", "district", ""},
+	{"MU-PA", "Pamplemousses", "district", ""},
+	{"MU-PL", "Port Louis", "district", ""},
+	{"MU-PW", "Plaines Wilhems", "district", ""},
+	{"MU-RO", "Rodrigues Island", "dependency", ""},
+	{"MU-RR", "Rivière du Rempart", "district", ""},
+	{"MU-SA", "Savanne", "district", ""},
+	{"MV-00", "South Ari Atoll", "administrative atoll", ""},
+	{"MV-01", "Addu City", "city", ""},
+	{"MV-02", "North Ari Atoll", "administrative atoll", ""},
+	{"MV-03", "Faadhippolhu", "administrative atoll", ""},
+	{"MV-04", "Felidhu Atoll", "administrative atoll", ""},
+	{"MV-05", "Hahdhunmathi", "administrative atoll", ""},
+	{"MV-07", "North Thiladhunmathi", "administrative atoll", ""},
+	{"MV-08", "Kolhumadulu", "administrative atoll", ""},
+	{"MV-12", "Mulaku Atoll", "administrative atoll", ""},
+	{"MV-13", "North Maalhosmadulu", "administrative atoll", ""},
+	{"MV-14", "North Nilandhe Atoll", "administrative atoll", ""},
+	{"MV-17", "South Nilandhe Atoll", "administrative atoll", ""},
+	{"MV-20", "South Maalhosmadulu", "administrative atoll", ""},
+	{"MV-23", "South Thiladhunmathi", "administrative atoll", ""},
+	{"MV-24", "North Miladhunmadulu", "administrative atoll", ""},
+	{"MV-25", "South Miladhunmadulu", "administrative atoll", ""},
+	{"MV-26", "Male Atoll", "administrative atoll", ""},
+	{"MV-27", "North Huvadhu Atoll", "administrative atoll", ""},
+	{"MV-28", "South Huvadhu Atoll", "administrative atoll", ""},
+	{"MV-29", "Fuvammulah", "administrative atoll", ""},
+	{"MV-MLE", "Male", "city", ""},
+	{"MW-BA", "Balaka", "district", "MW-S"},
+	{"MW-BL", "Blantyre", "district", "MW-S"},
+	{"MW-C", "Central Region", "region", ""},
+	{"MW-CK", "Chikwawa", "district", "MW-S"},
+	{"MW-CR", "Chiradzulu", "district", "MW-S"},
+	{"MW-CT", "Chitipa", "district", "MW-N"},
+	{"MW-DE", "Dedza", "district", "MW-C"},
+	{"MW-DO", "Dowa", "district", "MW-C"},
+	{"MW-KR", "Karonga", "district", "MW-N"},
+	{"MW-KS", "Kasungu", "district", "MW-C"},
+	{"MW-LI", "Lilongwe", "district", "MW-C"},
+	{"MW-LK", "Likoma", "district", "MW-N"},
+	{"MW-MC", "Mchinji", "district", "MW-C"},
+	{"MW-MG", "Mangochi", "district", "MW-S"},
+	{"MW-MH", "Machinga", "district", "MW-S"},
+	{"MW-MU", "Mulanje", "district", "MW-S"},
+	{"MW-MW", "Mwanza", "district", "MW-S"},
+	{"MW-MZ", "Mzimba", "district", "MW-N"},
+	{"MW-N", "Northern Region", "region", ""},
+	{"MW-NB", "Nkhata Bay", "district", "MW-N"},
+	{"MW-NE", "Neno", "district", "MW-S"},
+	{"MW-NI", "Ntchisi", "district", "MW-C"},
+	{"MW-NK", "Nkhotakota", "district", "MW-C"},
+	{"MW-NS", "Nsanje", "district", "MW-S"},
+	{"MW-NU", "Ntcheu", "district", "MW-C"},
+	{"MW-PH", "Phalombe", "district", "MW-S"},
+	{"MW-RU", "Rumphi", "district", "MW-N"},
+	{"MW-S", "Southern Region", "region", ""},
+	{"MW-SA", "Salima", "district", "MW-C"},
+	{"MW-TH", "Thyolo", "district", "MW-S"},
+	{"MW-ZO", "Zomba", "district", "MW-S"},
+	{"MX-AGU", "Aguascalientes", "state", ""},
+	{"MX-BCN", "Baja California", "state", ""},
+	{"MX-BCS", "Baja California Sur", "state", ""},
+	{"MX-CAM", "Campeche", "state", ""},
+	{"MX-CHH", "Chihuahua", "state", ""},
+	{"MX-CHP", "Chiapas", "state", ""},
+	{"MX-CMX", "Ciudad de México", "federal entity", ""},
+	{"MX-COA", "Coahuila de Zaragoza", "state", ""},
+	{"MX-COL", "Colima", "state", ""},
+	{"MX-DUR", "Durango", "state", ""},
+	{"MX-GRO", "Guerrero", "state", ""},
+	{"MX-GUA", "Guanajuato", "state", ""},
+	{"MX-HID", "Hidalgo", "state", ""},
+	{"MX-JAL", "Jalisco", "state", ""},
+	{"MX-MEX", "México", "state", ""},
+	{"MX-MIC", "Michoacán de Ocampo", "state", ""},
+	{"MX-MOR", "Morelos", "state", ""},
+	{"MX-NAY", "Nayarit", "state", ""},
+	{"MX-NLE", "Nuevo León", "state", ""},
+	{"MX-OAX", "Oaxaca", "state", ""},
+	{"MX-PUE", "Puebla", "state", ""},
+	{"MX-QUE", "Querétaro", "state", ""},
+	{"MX-ROO", "Quintana Roo", "state", ""},
+	{"MX-SIN", "Sinaloa", "state", ""},
+	{"MX-SLP", "San Luis Potosí", "state", ""},
+	{"MX-SON", "Sonora", "state", ""},
+	{"MX-TAB", "Tabasco", "state", ""},
+	{"MX-TAM", "Tamaulipas", "state", ""},
+	{"MX-TLA", "Tlaxcala", "state", ""},
+	{"MX-VER", "Veracruz de Ignacio de la Llave", "state", ""},
+	{"MX-YUC", "Yucatán", "state", ""},
+	{"MX-ZAC", "Zacatecas", "state", ""},
+	{"MY-01", "Johor", "state", ""},
+	{"MY-02", "Kedah", "state", ""},
+	{"MY-03", "Kelantan", "state", ""},
+	{"MY-04", "Melaka", "state", ""},
+	{"MY-05", "Negeri Sembilan", "state", ""},
+	{"MY-06", "Pahang", "state", ""},
+	{"MY-07", "Pulau Pinang", "state", ""},
+	{"MY-08", "Perak", "state", ""},
+	{"MY-09", "Perlis", "state", ""},
+	{"MY-10", "Selangor", "state", ""},
+	{"MY-11", "Terengganu", "state", ""},
+	{"MY-12", "Sabah", "state", ""},
+	{"MY-13", "Sarawak", "state", ""},
+	{"MY-14", "Wilayah Persekutuan Kuala Lumpur", "federal territory", ""},
+	{"MY-15", "Wilayah Persekutuan Labuan", "federal territory", ""},
+	{"MY-16", "Wilayah Persekutuan Putrajaya", "federal territory", ""},
+	{"MZ-A", "Niassa", "province", ""},
+	{"MZ-B", "Manica", "province", ""},
+	{"MZ-G", "Gaza", "province", ""},
+	{"MZ-I", "Inhambane", "province", ""},
+	{"MZ-L", "Maputo", "province", ""},
+	{"MZ-MPM", "Maputo", "city", ""},
+	{"MZ-N", "Nampula", "province", ""},
+	{"MZ-P", "Cabo Delgado", "province", ""},
+	{"MZ-Q", "Zambézia", "province", ""},
+	{"MZ-S", "Sofala", "province", ""},
+	{"MZ-T", "Tete", "province", ""},
+	{"NA-CA", "Zambezi", "region", ""},
+	{"NA-ER", "Erongo", "region", ""},
+	{"NA-HA", "Hardap", "region", ""},
+	{"NA-KA", "//Karas", "region", ""},
+	{"NA-KE", "Kavango East", "region", ""},
+	{"NA-KH", "Khomas", "region", ""},
+	{"NA-KU", "Kunene", "region", ""},
+	{"NA-KW", "Kavango West", "region", ""},
+	{"NA-OD", "Otjozondjupa", "region", ""},
+	{"NA-OH", "Omaheke", "region", ""},
+	{"NA-ON", "Oshana", "region", ""},
+	{"NA-OS", "Omusati", "region", ""},
+	{"NA-OT", "Oshikoto", "region", ""},
+	{"NA-OW", "Ohangwena", "region", ""},
+	{"NE-1", "Agadez", "region", ""},
+	{"NE-2", "Diffa", "region", ""},
+	{"NE-3", "Dosso", "region", ""},
+	{"NE-4", "Maradi", "region", ""},
+	{"NE-5", "Tahoua", "region", ""},
+	{"NE-6", "Tillabéri", "region", ""},
+	{"NE-7", "Zinder", "region", ""},
+	{"NE-8", "Niamey", "urban community", ""},
+	{"NG-AB", "Abia", "state", ""},
+	{"NG-AD", "Adamawa", "state", ""},
+	{"NG-AK", "Akwa Ibom", "state", ""},
+	{"NG-AN", "Anambra", "state", ""},
+	{"NG-BA", "Bauchi", "state", ""},
+	{"NG-BE", "Benue", "state", ""},
+	{"NG-BO", "Borno", "state", ""},
+	{"NG-BY", "Bayelsa", "state", ""},
+	{"NG-CR", "Cross River", "state", ""},
+	{"NG-DE", "Delta", "state", ""},
+	{"NG-EB", "Ebonyi", "state", ""},
+	{"NG-ED", "Edo", "state", ""},
+	{"NG-EK", "Ekiti", "state", ""},
+	{"NG-EN", "Enugu", "state", ""},
+	{"NG-FC", "Abuja Federal Capital Territory", "capital territory", ""},
+	{"NG-GO", "Gombe", "state", ""},
+	{"NG-IM", "Imo", "state", ""},
+	{"NG-JI", "Jigawa", "state", ""},
+	{"NG-KD", "Kaduna", "state", ""},
+	{"NG-KE", "Kebbi", "state", ""},
+	{"NG-KN", "Kano", "state", ""},
+	{"NG-KO", "Kogi", "state", ""},
+	{"NG-KT", "Katsina", "state", ""},
+	{"NG-KW", "Kwara", "state", ""},
+	{"NG-LA", "Lagos", "state", ""},
+	{"NG-NA", "Nasarawa", "state", ""},
+	{"NG-NI", "Niger", "state", ""},
+	{"NG-OG", "Ogun", "state", ""},
+	{"NG-ON", "Ondo", "state", ""},
+	{"NG-OS", "Osun", "state", ""},
+	{"NG-OY", "Oyo", "state", ""},
+	{"NG-PL", "Plateau", "state", ""},
+	{"NG-RI", "Rivers", "state", ""},
+	{"NG-SO", "Sokoto", "state", ""},
+	{"NG-TA", "Taraba", "state", ""},
+	{"NG-YO", "Yobe", "state", ""},
+	{"NG-ZA", "Zamfara", "state", ""},
+	{"NI-AN", "Costa Caribe Norte", "autonomous region", ""},
+	{"NI-AS", "Costa Caribe Sur", "autonomous region", ""},
+	{"NI-BO", "Boaco", "department", ""},
+	{"NI-CA", "Carazo", "department", ""},
+	{"NI-CI", "Chinandega", "department", ""},
+	{"NI-CO", "Chontales", "department", ""},
+	{"NI-ES", "Estelí", "department", ""},
+	{"NI-GR", "Granada", "department", ""},
+	{"NI-JI", "Jinotega", "department", ""},
+	{"NI-LE", "León", "department", ""},
+	{"NI-MD", "Madriz", "department", ""},
+	{"NI-MN", "Managua", "department", ""},
+	{"NI-MS", "Masaya", "department", ""},
+	{"NI-MT", "Matagalpa", "department", ""},
+	{"NI-NS", "Nueva Segovia", "department", ""},
+	{"NI-RI", "Rivas", "department", ""},
+	{"NI-SJ", "Río San Juan", "department", ""},
+	{"NL-AW", "Aruba", "country", ""},
+	{"NL-BQ1", "Bonaire", "special municipality", ""},
+	{"NL-BQ2", "Saba", "special municipality", ""},
+	{"NL-BQ3", "Sint Eustatius", "special municipality", ""},
+	{"NL-CW", "Curaçao", "country", ""},
+	{"NL-DR", "Drenthe", "province", ""},
+	{"NL-FL", "Flevoland", "province", ""},
+	{"NL-FR", "Fryslân", "province", ""},
+	{"NL-GE", "Gelderland", "province", ""},
+	{"NL-GR", "Groningen", "province", ""},
+	{"NL-LI", "Limburg", "province", ""},
+	{"NL-NB", "Noord-Brabant", "province", ""},
+	{"NL-NH", "Noord-Holland", "province", ""},
+	{"NL-OV", "Overijssel", "province", ""},
+	{"NL-SX", "Sint Maarten", "country", ""},
+	{"NL-UT", "Utrecht", "province", ""},
+	{"NL-ZE", "Zeeland", "province", ""},
+	{"NL-ZH", "Zuid-Holland", "province", ""},
+	{"NO-03", "Oslo", "county", ""},
+	{"NO-11", "Rogaland", "county", ""},
+	{"NO-15", "Møre og Romsdal", "county", ""},
+	{"NO-18", "Nordland", "county", ""},
+	{"NO-21", "Svalbard (Arctic Region)", "arctic region", ""},
+	{"NO-22", "Jan Mayen (Arctic Region)", "arctic region", ""},
+	{"NO-30", "Viken", "county", ""},
+	{"NO-34", "Innlandet", "county", ""},
+	{"NO-38", "Vestfold og Telemark", "county", ""},
+	{"NO-42", "Agder", "county", ""},
+	{"NO-46", "Vestland", "county", ""},
+	{"NO-50", "Trööndelage", "county", ""},
+	{"NO-54", "Romssa ja Finnmárkku", "county", ""},
+	{"NP-1", "Central", "development region", ""},
+	{"NP-2", "Mid Western", "development region", ""},
+	{"NP-3", "Western", "development region", ""},
+	{"NP-4", "Eastern", "development region", ""},
+	{"NP-5", "Far Western", "development region", ""},
+	{"NP-BA", "Bagmati", "zone", "NP-1"},
+	{"NP-BH", "Bheri", "zone", "NP-2"},
+	{"NP-DH", "Dhawalagiri", "zone", "NP-3"},
+	{"NP-GA", "Gandaki", "zone", "NP-3"},
+	{"NP-JA", "Janakpur", "zone", "NP-1"},
+	{"NP-KA", "Karnali", "zone", "NP-2"},
+	{"NP-KO", "Kosi", "zone", "NP-4"},
+	{"NP-LU", "Lumbini", "zone", "NP-3"},
+	{"NP-MA", "Mahakali", "zone", "NP-5"},
+	{"NP-ME", "Mechi", "zone", "NP-4"},
+	{"NP-NA", "Narayani", "zone", "NP-1"},
+	{"NP-P1", "Province 1", "province", ""},
+	{"NP-P2", "Province 2", "province", ""},
+	{"NP-P3", "Bāgmatī", "province", ""},
+	{"NP-P4", "Gandaki", "province", ""},
+	{"NP-P5", "Province 5", "province", ""},
+	{"NP-P6", "Karnali", "province", ""},
+	{"NP-P7", "Sudūr Pashchim", "province", ""},
+	{"NP-RA", "Rapti", "zone", "NP-2"},
+	{"NP-SA", "Sagarmatha", "zone", "NP-4"},
+	{"NP-SE", "Seti", "zone", "NP-5"},
+	{"NR-01", "Aiwo", "district", ""},
+	{"NR-02", "Anabar", "district", ""},
+	{"NR-03", "Anetan", "district", ""},
+	{"NR-04", "Anibare", "district", ""},
+	{"NR-05", "Baitsi", "district", ""},
+	{"NR-06", "Boe", "district", ""},
+	{"NR-07", "Buada", "district", ""},
+	{"NR-08", "Denigomodu", "district", ""},
+	{"NR-09", "Ewa", "district", ""},
+	{"NR-10", "Ijuw", "district", ""},
+	{"NR-11", "Meneng", "district", ""},
+	{"NR-12", "Nibok", "district", ""},
+	{"NR-13", "Uaboe", "district", ""},
+	{"NR-14", "Yaren", "district", ""},
+	{"NZ-AUK", "Auckland", "region", ""},
+	{"NZ-BOP", "Bay of Plenty", "region", ""},
+	{"NZ-CAN", "Canterbury", "region", ""},
+	{"NZ-CIT", "Chatham Islands Territory", "special island authority", ""},
+	{"NZ-GIS", "Gisborne", "region", ""},
+	{"NZ-HKB", "Hawke's Bay", "region", ""},
+	{"NZ-MBH", "Marlborough", "region", ""},
+	{"NZ-MWT", "Manawatu-Wanganui", "region", ""},
+	{"NZ-NSN", "Nelson", "region", ""},
+	{"NZ-NTL", "Northland", "region", ""},
+	{"NZ-OTA", "Otago", "region", ""},
+	{"NZ-STL", "Southland", "region", ""},
+	{"NZ-TAS", "Tasman", "region", ""},
+	{"NZ-TKI", "Taranaki", "region", ""},
+	{"NZ-WGN", "Wellington", "region", ""},
+	{"NZ-WKO", "Waikato", "region", ""},
+	{"NZ-WTC", "West Coast", "region", ""},
+	{"OM-BJ", "Janūb al Bāţinah", "governorate", ""},
+	{"OM-BS", "Shamāl al Bāţinah", "governorate", ""},
+	{"OM-BU", "Al Buraymī", "governorate", ""},
+	{"OM-DA", "Ad Dākhilīyah", "governorate", ""},
+	{"OM-MA", "Masqaţ", "governorate", ""},
+	{"OM-MU", "Musandam", "governorate", ""},
+	{"OM-SJ", "Janūb ash Sharqīyah", "governorate", ""},
+	{"OM-SS", "Shamāl ash Sharqīyah", "governorate", ""},
+	{"OM-WU", "Al Wusţá", "governorate", ""},
+	{"OM-ZA", "Az̧ Z̧āhirah", "governorate", ""},
+	{"OM-ZU", "Z̧ufār", "governorate", ""},
+	{"PA-1", "Bocas del Toro", "province", ""},
+	{"PA-10", "Panamá Oeste", "province", ""},
+	{"PA-2", "Coclé", "province", ""},
+	{"PA-3", "Colón", "province", ""},
+	{"PA-4", "Chiriquí", "province", ""},
+	{"PA-5", "Darién", "province", ""},
+	{"PA-6", "Herrera", "province", ""},
+	{"PA-7", "Los Santos", "province", ""},
+	{"PA-8", "Panamá", "province", ""},
+	{"PA-9", "Veraguas", "province", ""},
+	{"PA-EM", "Emberá", "indigenous region", ""},
+	{"PA-KY", "Guna Yala", "indigenous region", ""},
+	{"PA-NB", "Ngöbe-Buglé", "indigenous region", ""},
+	{"PE-AMA", "Amarumayu", "region", ""},
+	{"PE-ANC", "Ancash", "region", ""},
+	{"PE-APU", "Apurimaq", "region", ""},
+	{"PE-ARE", "Arequipa", "region", ""},
+	{"PE-AYA", "Ayacucho", "region", ""},
+	{"PE-CAJ", "Cajamarca", "region", ""},
+	{"PE-CAL", "El Callao", "region", ""},
+	{"PE-CUS", "Cusco", "region", ""},
+	{"PE-HUC", "Huánuco", "region", ""},
+	{"PE-HUV", "Huancavelica", "region", ""},
+	{"PE-ICA", "Ica", "region", ""},
+	{"PE-JUN", "Hunin", "region", ""},
+	{"PE-LAL", "La Libertad", "region", ""},
+	{"PE-LAM", "Lambayeque", "region", ""},
+	{"PE-LIM", "Lima", "region", ""},
+	{"PE-LMA", "Lima hatun llaqta", "municipality", ""},
+	{"PE-LOR", "Loreto", "region", ""},
+	{"PE-MDD", "Madre de Dios", "region", ""},
+	{"PE-MOQ", "Moquegua", "region", ""},
+	{"PE-PAS", "Pasco", "region", ""},
+	{"PE-PIU", "Piura", "region", ""},
+	{"PE-PUN", "Puno", "region", ""},
+	{"PE-SAM", "San Martin", "region", ""},
+	{"PE-TAC", "Tacna", "region", ""},
+	{"PE-TUM", "Tumbes", "region", ""},
+	{"PE-UCA", "Ucayali", "region", ""},
+	{"PG-CPK", "Chimbu", "province", ""},
+	{"PG-CPM", "Central", "province", ""},
+	{"PG-EBR", "East New Britain", "province", ""},
+	{"PG-EHG", "Eastern Highlands", "province", ""},
+	{"PG-EPW", "Enga", "province", ""},
+	{"PG-ESW", "East Sepik", "province", ""},
+	{"PG-GPK", "Gulf", "province", ""},
+	{"PG-HLA", "Hela", "province", ""},
+	{"PG-JWK", "Jiwaka", "province", ""},
+	{"PG-MBA", "Milne Bay", "province", ""},
+	{"PG-MPL", "Morobe", "province", ""},
+	{"PG-MPM", "Madang", "province", ""},
+	{"PG-MRL", "Manus", "province", ""},
+	{"PG-NCD", "National Capital District (Port Moresby)", "district", ""},
+	{"PG-NIK", "New Ireland", "province", ""},
+	{"PG-NPP", "Northern", "province", ""},
+	{"PG-NSB", "Bougainville", "autonomous region", ""},
+	{"PG-SAN", "West Sepik", "province", ""},
+	{"PG-SHM", "Southern Highlands", "province", ""},
+	{"PG-WBK", "West New Britain", "province", ""},
+	{"PG-WHM", "Western Highlands", "province", ""},
+	{"PG-WPD", "Western", "province", ""},
+	{"PH-00", "National Capital Region", "region", ""},
+	{"PH-01", "Ilocos (Region I)", "region", ""},
+	{"PH-02", "Cagayan Valley (Region II)", "region", ""},
+	{"PH-03", "Central Luzon (Region III)", "region", ""},
+	{"PH-05", "Bicol (Region V)", "region", ""},
+	{"PH-06", "Western Visayas (Region VI)", "region", ""},
+	{"PH-07", "Central Visayas (Region VII)", "region", ""},
+	{"PH-08", "Eastern Visayas (Region VIII)", "region", ""},
+	{"PH-09", "Zamboanga Peninsula (Region IX)", "region", ""},
+	{"PH-10", "Northern Mindanao (Region X)", "region", ""},
+	{"PH-11", "Davao (Region XI)", "region", ""},
+	{"PH-12", "Soccsksargen (Region XII)", "region", ""},
+	{"PH-13", "Caraga (Region XIII)", "region", ""},
+	{"PH-14", "Autonomous Region in Muslim Mindanao (ARMM)", "region", ""},
+	{"PH-15", "Cordillera Administrative Region (CAR)", "region", ""},
+	{"PH-40", "Calabarzon (Region IV-A)", "region", ""},
+	{"PH-41", "Mimaropa (Region IV-B)", "region", ""},
+	{"PH-ABR", "Abra", "province", "PH-15"},
+	{"PH-AGN", "Agusan del Norte", "province", "PH-13"},
+	{"PH-AGS", "Agusan del Sur", "province", "PH-13"},
+	{"PH-AKL", "Aklan", "province", "PH-06"},
+	{"PH-ALB", "Albay", "province", "PH-05"},
+	{"PH-ANT", "Antique", "province", "PH-06"},
+	{"PH-APA", "Apayao", "province", "PH-15"},
+	{"PH-AUR", "Aurora", "province", "PH-03"},
+	{"PH-BAN", "Bataan", "province", "PH-03"},
+	{"PH-BAS", "Basilan", "province", "PH-09"},
+	{"PH-BEN", "Benguet", "province", "PH-15"},
+	{"PH-BIL", "Biliran", "province", "PH-08"},
+	{"PH-BOH", "Bohol", "province", "PH-07"},
+	{"PH-BTG", "Batangas", "province", "PH-40"},
+	{"PH-BTN", "Batanes", "province", "PH-02"},
+	{"PH-BUK", "Bukidnon", "province", "PH-10"},
+	{"PH-BUL", "Bulacan", "province", "PH-03"},
+	{"PH-CAG", "Cagayan", "province", "PH-02"},
+	{"PH-CAM", "Camiguin", "province", "PH-10"},
+	{"PH-CAN", "Camarines Norte", "province", "PH-05"},
+	{"PH-CAP", "Capiz", "province", "PH-06"},
+	{"PH-CAS", "Camarines Sur", "province", "PH-05"},
+	{"PH-CAT", "Catanduanes", "province", "PH-05"},
+	{"PH-CAV", "Cavite", "province", "PH-40"},
+	{"PH-CEB", "Cebu", "province", "PH-07"},
+	{"PH-COM", "Davao de Oro", "province", "PH-11"},
+	{"PH-DAO", "Davao Oriental", "province", "PH-11"},
+	{"PH-DAS", "Davao del Sur", "province", "PH-11"},
+	{"PH-DAV", "Davao del Norte", "province", "PH-11"},
+	{"PH-DIN", "Dinagat Islands", "province", "PH-13"},
+	{"PH-DVO", "Davao Occidental", "province", "PH-11"},
+	{"PH-EAS", "Eastern Samar", "province", "PH-08"},
+	{"PH-GUI", "Guimaras", "province", "PH-06"},
+	{"PH-IFU", "Ifugao", "province", "PH-15"},
+	{"PH-ILI", "Iloilo", "province", "PH-06"},
+	{"PH-ILN", "Ilocos Norte", "province", "PH-01"},
+	{"PH-ILS", "Ilocos Sur", "province", "PH-01"},
+	{"PH-ISA", "Isabela", "province", "PH-02"},
+	{"PH-KAL", "Kalinga", "province", "PH-15"},
+	{"PH-LAG", "Laguna", "province", "PH-40"},
+	{"PH-LAN", "Lanao del Norte", "province", "PH-12"},
+	{"PH-LAS", "Lanao del Sur", "province", "PH-14"},
+	{"PH-LEY", "Leyte", "province", "PH-08"},
+	{"PH-LUN", "La Union", "province", "PH-01"},
+	{"PH-MAD", "Marinduque", "province", "PH-41"},
+	{"PH-MAG", "Maguindanao", "province", "PH-14"},
+	{"PH-MAS", "Masbate", "province", "PH-05"},
+	{"PH-MDC", "Mindoro Occidental", "province", "PH-41"},
+	{"PH-MDR", "Mindoro Oriental", "province", "PH-41"},
+	{"PH-MOU", "Mountain Province", "province", "PH-15"},
+	{"PH-MSC", "Misamis Occidental", "province", "PH-10"},
+	{"PH-MSR", "Misamis Oriental", "province", "PH-10"},
+	{"PH-NCO", "Cotabato", "province", "PH-12"},
+	{"PH-NEC", "Negros Occidental", "province", "PH-06"},
+	{"PH-NER", "Negros Oriental", "province", "PH-07"},
+	{"PH-NSA", "Northern Samar", "province", "PH-08"},
+	{"PH-NUE", "Nueva Ecija", "province", "PH-03"},
+	{"PH-NUV", "Nueva Vizcaya", "province", "PH-02"},
+	{"PH-PAM", "Pampanga", "province", "PH-03"},
+	{"PH-PAN", "Pangasinan", "province", "PH-01"},
+	{"PH-PLW", "Palawan", "province", "PH-41"},
+	{"PH-QUE", "Quezon", "province", "PH-40"},
+	{"PH-QUI", "Quirino", "province", "PH-02"},
+	{"PH-RIZ", "Rizal", "province", "PH-40"},
+	{"PH-ROM", "Romblon", "province", "PH-41"},
+	{"PH-SAR", "Sarangani", "province", "PH-11"},
+	{"PH-SCO", "South Cotabato", "province", "PH-11"},
+	{"PH-SIG", "Siquijor", "province", "PH-07"},
+	{"PH-SLE", "Southern Leyte", "province", "PH-08"},
+	{"PH-SLU", "Sulu", "province", "PH-14"},
+	{"PH-SOR", "Sorsogon", "province", "PH-05"},
+	{"PH-SUK", "Sultan Kudarat", "province", "PH-12"},
+	{"PH-SUN", "Surigao del Norte", "province", "PH-13"},
+	{"PH-SUR", "Surigao del Sur", "province", "PH-13"},
+	{"PH-TAR", "Tarlac", "province", "PH-03"},
+	{"PH-TAW", "Tawi-Tawi", "province", "PH-14"},
+	{"PH-WSA", "Samar", "province", "PH-08"},
+	{"PH-ZAN", "Zamboanga del Norte", "province", "PH-09"},
+	{"PH-ZAS", "Zamboanga del Sur", "province", "PH-09"},
+	{"PH-ZMB", "Zambales", "province", "PH-03"},
+	{"PH-ZSI", "Zamboanga Sibugay", "province", "PH-09"},
+	{"PK-BA", "Balochistan", "province", ""},
+	{"PK-GB", "Gilgit-Baltistan", "pakistan administered area", ""},
+	{"PK-IS", "Islamabad", "federal capital territory", ""},
+	{"PK-JK", "Azad Jammu and Kashmir", "pakistan administered area", ""},
+	{"PK-KP", "Khyber Pakhtunkhwa", "province", ""},
+	{"PK-PB", "Punjab", "province", ""},
+	{"PK-SD", "Sindh", "province", ""},
+	{"PL-02", "Dolnośląskie", "voivodeship", ""},
+	{"PL-04", "Kujawsko-pomorskie", "voivodeship", ""},
+	{"PL-06", "Lubelskie", "voivodeship", ""},
+	{"PL-08", "Lubuskie", "voivodeship", ""},
+	{"PL-10", "Łódzkie", "voivodeship", ""},
+	{"PL-12", "Małopolskie", "voivodeship", ""},
+	{"PL-14", "Mazowieckie", "voivodeship", ""},
+	{"PL-16", "Opolskie", "voivodeship", ""},
+	{"PL-18", "Podkarpackie", "voivodeship", ""},
+	{"PL-20", "Podlaskie", "voivodeship", ""},
+	{"PL-22", "Pomorskie", "voivodeship", ""},
+	{"PL-24", "Śląskie", "voivodeship", ""},
+	{"PL-26", "Świętokrzyskie", "voivodeship", ""},
+	{"PL-28", "Warmińsko-mazurskie", "voivodeship", ""},
+	{"PL-30", "Wielkopolskie", "voivodeship", ""},
+	{"PL-32", "Zachodniopomorskie", "voivodeship", ""},
+	{"PS-BTH", "Bethlehem", "governorate", ""},
+	{"PS-DEB", "Deir El Balah", "governorate", ""},
+	{"PS-GZA", "Gaza", "governorate", ""},
+	{"PS-HBN", "Hebron", "governorate", ""},
+	{"PS-JEM", "Jerusalem", "governorate", ""},
+	{"PS-JEN", "Jenin", "governorate", ""},
+	{"PS-JRH", "Jericho and Al Aghwar", "governorate", ""},
+	{"PS-KYS", "Khan Yunis", "governorate", ""},
+	{"PS-NBS", "Nablus", "governorate", ""},
+	{"PS-NGZ", "North Gaza", "governorate", ""},
+	{"PS-QQA", "Qalqilya", "governorate", ""},
+	{"PS-RBH", "Ramallah", "governorate", ""},
+	{"PS-RFH", "Rafah", "governorate", ""},
+	{"PS-SLT", "Salfit", "governorate", ""},
+	{"PS-TBS", "Tubas", "governorate", ""},
+	{"PS-TKM", "Tulkarm", "governorate", ""},
+	{"PT-01", "Aveiro", "district", ""},
+	{"PT-02", "Beja", "district", ""},
+	{"PT-03", "Braga", "district", ""},
+	{"PT-04", "Bragança", "district", ""},
+	{"PT-05", "Castelo Branco", "district", ""},
+	{"PT-06", "Coimbra", "district", ""},
+	{"PT-07", "Évora", "district", ""},
+	{"PT-08", "Faro", "district", ""},
+	{"PT-09", "Guarda", "district", ""},
+	{"PT-10", "Leiria", "district", ""},
+	{"PT-11", "Lisboa", "district", ""},
+	{"PT-12", "Portalegre", "district", ""},
+	{"PT-13", "Porto", "district", ""},
+	{"PT-14", "Santarém", "district", ""},
+	{"PT-15", "Setúbal", "district", ""},
+	{"PT-16", "Viana do Castelo", "district", ""},
+	{"PT-17", "Vila Real", "district", ""},
+	{"PT-18", "Viseu", "district", ""},
+	{"PT-20", "Região Autónoma dos Açores", "autonomous region", ""},
+	{"PT-30", "Região Autónoma da Madeira", "autonomous region", ""},
+	{"PW-002", "Aimeliik", "state", ""},
+	{"PW-004", "Airai", "state", ""},
+	{"PW-010", "Angaur", "state", ""},
+	{"PW-050", "Hatohobei", "state", ""},
+	{"PW-100", "Kayangel", "state", ""},
+	{"PW-150", "Koror", "state", ""},
+	{"PW-212", "Melekeok", "state", ""},
+	{"PW-214", "Ngaraard", "state", ""},
+	{"PW-218", "Ngarchelong", "state", ""},
+	{"PW-222", "Ngardmau", "state", ""},
+	{"PW-224", "Ngatpang", "state", ""},
+	{"PW-226", "Ngchesar", "state", ""},
+	{"PW-227", "Ngeremlengui", "state", ""},
+	{"PW-228", "Ngiwal", "state", ""},
+	{"PW-350", "Peleliu", "state", ""},
+	{"PW-370", "Sonsorol", "state", ""},
+	{"PY-1", "Concepción", "department", ""},
+	{"PY-10", "Alto Paraná", "department", ""},
+	{"PY-11", "Central", "department", ""},
+	{"PY-12", "Ñeembucú", "department", ""},
+	{"PY-13", "Amambay", "department", ""},
+	{"PY-14", "Canindeyú", "department", ""},
+	{"PY-15", "Presidente Hayes", "department", ""},
+	{"PY-16", "Alto Paraguay", "department", ""},
+	{"PY-19", "Boquerón", "department", ""},
+	{"PY-2", "San Pedro", "department", ""},
+	{"PY-3", "Cordillera", "department", ""},
+	{"PY-4", "Guairá", "department", ""},
+	{"PY-5", "Caaguazú", "department", ""},
+	{"PY-6", "Caazapá", "department", ""},
+	{"PY-7", "Itapúa", "department", ""},
+	{"PY-8", "Misiones", "department", ""},
+	{"PY-9", "Paraguarí", "department", ""},
+	{"PY-ASU", "Asunción", "capital", ""},
+	{"QA-DA", "Ad Dawḩah", "municipality", ""},
+	{"QA-KH", "Al Khawr wa adh Dhakhīrah", "municipality", ""},
+	{"QA-MS", "Ash Shamāl", "municipality", ""},
+	{"QA-RA", "Ar Rayyān", "municipality", ""},
+	{"QA-SH", "Ash Shīḩānīyah", "municipality", ""},
+	{"QA-US", "Umm Şalāl", "municipality", ""},
+	{"QA-WA", "Al Wakrah", "municipality", ""},
+	{"QA-ZA", "Az̧ Z̧a‘āyin", "municipality", ""},
+	{"RO-AB", "Alba", "department", ""},
+	{"RO-AG", "Argeș", "department", ""},
+	{"RO-AR", "Arad", "department", ""},
+	{"RO-B", "București", "municipality", ""},
+	{"RO-BC", "Bacău", "department", ""},
+	{"RO-BH", "Bihor", "department", ""},
+	{"RO-BN", "Bistrița-Năsăud", "department", ""},
+	{"RO-BR", "Brăila", "department", ""},
+	{"RO-BT", "Botoșani", "department", ""},
+	{"RO-BV", "Brașov", "department", ""},
+	{"RO-BZ", "Buzău", "department", ""},
+	{"RO-CJ", "Cluj", "department", ""},
+	{"RO-CL", "Călărași", "department", ""},
+	{"RO-CS", "Caraș-Severin", "department", ""},
+	{"RO-CT", "Constanța", "department", ""},
+	{"RO-CV", "Covasna", "department", ""},
+	{"RO-DB", "Dâmbovița", "department", ""},
+	{"RO-DJ", "Dolj", "department", ""},
+	{"RO-GJ", "Gorj", "department", ""},
+	{"RO-GL", "Galați", "department", ""},
+	{"RO-GR", "Giurgiu", "department", ""},
+	{"RO-HD", "Hunedoara", "department", ""},
+	{"RO-HR", "Harghita", "department", ""},
+	{"RO-IF", "Ilfov", "department", ""},
+	{"RO-IL", "Ialomița", "department", ""},
+	{"RO-IS", "Iași", "department", ""},
+	{"RO-MH", "Mehedinți", "department", ""},
+	{"RO-MM", "Maramureș", "department", ""},
+	{"RO-MS", "Mureș", "department", ""},
+	{"RO-NT", "Neamț", "department", ""},
+	{"RO-OT", "Olt", "department", ""},
+	{"RO-PH", "Prahova", "department", ""},
+	{"RO-SB", "Sibiu", "department", ""},
+	{"RO-SJ", "Sălaj", "department", ""},
+	{"RO-SM", "Satu Mare", "department", ""},
+	{"RO-SV", "Suceava", "department", ""},
+	{"RO-TL", "Tulcea", "department", ""},
+	{"RO-TM", "Timiș", "department", ""},
+	{"RO-TR", "Teleorman", "department", ""},
+	{"RO-VL", "Vâlcea", "department", ""},
+	{"RO-VN", "Vrancea", "department", ""},
+	{"RO-VS", "Vaslui", "department", ""},
+	{"RS-00", "Beograd", "city", ""},
+	{"RS-01", "Severnobački okrug", "district", "RS-VO"},
+	{"RS-02", "Srednjebanatski okrug", "district", "RS-VO"},
+	{"RS-03", "Severnobanatski okrug", "district", "RS-VO"},
+	{"RS-04", "Južnobanatski okrug", "district", "RS-VO"},
+	{"RS-05", "Zapadnobački okrug", "district", "RS-VO"},
+	{"RS-06", "Južnobački okrug", "district", "RS-VO"},
+	{"RS-07", "Sremski okrug", "district", "RS-VO"},
+	{"RS-08", "Mačvanski okrug", "district", ""},
+	{"RS-09", "Kolubarski okrug", "district", ""},
+	{"RS-10", "Podunavski okrug", "district", ""},
+	{"RS-11", "Braničevski okrug", "district", ""},
+	{"RS-12", "Šumadijski okrug", "district", ""},
+	{"RS-13", "Pomoravski okrug", "district", ""},
+	{"RS-14", "Borski okrug", "district", ""},
+	{"RS-15", "Zaječarski okrug", "district", ""},
+	{"RS-16", "Zlatiborski okrug", "district", ""},
+	{"RS-17", "Moravički okrug", "district", ""},
+	{"RS-18", "Raški okrug", "district", ""},
+	{"RS-19", "Rasinski okrug", "district", ""},
+	{"RS-20", "Nišavski okrug", "district", ""},
+	{"RS-21", "Toplički okrug", "district", ""},
+	{"RS-22", "Pirotski okrug", "district", ""},
+	{"RS-23", "Jablanički okrug", "district", ""},
+	{"RS-24", "Pčinjski okrug", "district", ""},
+	{"RS-25", "Kosovski okrug", "district", "RS-KM"},
+	{"RS-26", "Pećki okrug", "district", "RS-KM"},
+	{"RS-27", "Prizrenski okrug", "district", "RS-KM"},
+	{"RS-28", "Kosovsko-Mitrovački okrug", "district", "RS-KM"},
+	{"RS-29", "Kosovsko-Pomoravski okrug", "district", "RS-KM"},
+	{"RS-KM", "Kosovo-Metohija", "autonomous province", ""},
+	{"RS-VO", "Vojvodina", "autonomous province", ""},
+	{"RU-AD", "Adygeja, Respublika", "republic", ""},
+	{"RU-AL", "Altaj, Respublika", "republic", ""},
+	{"RU-ALT", "Altajskij kraj", "administrative territory", ""},
+	{"RU-AMU", "Amurskaja oblast'", "administrative region", ""},
+	{"RU-ARK", "Arhangel'skaja oblast'", "administrative region", ""},
+	{"RU-AST", "Astrahanskaja oblast'", "administrative region", ""},
+	{"RU-BA", "Bashkortostan, Respublika", "republic", ""},
+	{"RU-BEL", "Belgorodskaja oblast'", "administrative region", ""},
+	{"RU-BRY", "Brjanskaja oblast'", "administrative region", ""},
+	{"RU-BU", "Burjatija, Respublika", "republic", ""},
+	{"RU-CE", "Chechenskaya Respublika", "republic", ""},
+	{"RU-CHE", "Chelyabinskaya oblast'", "administrative region", ""},
+	{"RU-CHU", "Chukotskiy avtonomnyy okrug", "autonomous district", ""},
+	{"RU-CU", "Chuvashskaya Respublika", "republic", ""},
+	{"RU-DA", "Dagestan, Respublika", "republic", ""},
+	{"RU-IN", "Ingushetiya, Respublika", "republic", ""},
+	{"RU-IRK", "Irkutskaja oblast'", "administrative region", ""},
+	{"RU-IVA", "Ivanovskaja oblast'", "administrative region", ""},
+	{"RU-KAM", "Kamchatskiy kray", "administrative territory", ""},
+	{"RU-KB", "Kabardino-Balkarskaja Respublika", "republic", ""},
+	{"RU-KC", "Karachayevo-Cherkesskaya Respublika", "republic", ""},
+	{"RU-KDA", "Krasnodarskij kraj", "administrative territory", ""},
+	{"RU-KEM", "Kemerovskaja oblast'", "administrative region", ""},
+	{"RU-KGD", "Kaliningradskaja oblast'", "administrative region", ""},
+	{"RU-KGN", "Kurganskaja oblast'", "administrative region", ""},
+	{"RU-KHA", "Habarovskij kraj", "administrative territory", ""},
+	{"RU-KHM", "Hanty-Mansijskij avtonomnyj okrug", "autonomous district", ""},
+	{"RU-KIR", "Kirovskaja oblast'", "administrative region", ""},
+	{"RU-KK", "Hakasija, Respublika", "republic", ""},
+	{"RU-KL", "Kalmykija, Respublika", "republic", ""},
+	{"RU-KLU", "Kaluzhskaya oblast'", "administrative region", ""},
+	{"RU-KO", "Komi, Respublika", "republic", ""},
+	{"RU-KOS", "Kostromskaja oblast'", "administrative region", ""},
+	{"RU-KR", "Karelija, Respublika", "republic", ""},
+	{"RU-KRS", "Kurskaja oblast'", "administrative region", ""},
+	{"RU-KYA", "Krasnojarskij kraj", "administrative territory", ""},
+	{"RU-LEN", "Leningradskaja oblast'", "administrative region", ""},
+	{"RU-LIP", "Lipeckaja oblast'", "administrative region", ""},
+	{"RU-MAG", "Magadanskaja oblast'", "administrative region", ""},
+	{"RU-ME", "Marij Èl, Respublika", "republic", ""},
+	{"RU-MO", "Mordovija, Respublika", "republic", ""},
+	{"RU-MOS", "Moskovskaja oblast'", "administrative region", ""},
+	{"RU-MOW", "Moskva", "autonomous city", ""},
+	{"RU-MUR", "Murmanskaja oblast'", "administrative region", ""},
+	{"RU-NEN", "Neneckij avtonomnyj okrug", "autonomous district", ""},
+	{"RU-NGR", "Novgorodskaja oblast'", "administrative region", ""},
+	{"RU-NIZ", "Nizhegorodskaya oblast'", "administrative region", ""},
+	{"RU-NVS", "Novosibirskaja oblast'", "administrative region", ""},
+	{"RU-OMS", "Omskaja oblast'", "administrative region", ""},
+	{"RU-ORE", "Orenburgskaja oblast'", "administrative region", ""},
+	{"RU-ORL", "Orlovskaja oblast'", "administrative region", ""},
+	{"RU-PER", "Permskij kraj", "administrative territory", ""},
+	{"RU-PNZ", "Penzenskaja oblast'", "administrative region", ""},
+	{"RU-PRI", "Primorskij kraj", "administrative territory", ""},
+	{"RU-PSK", "Pskovskaja oblast'", "administrative region", ""},
+	{"RU-ROS", "Rostovskaja oblast'", "administrative region", ""},
+	{"RU-RYA", "Rjazanskaja oblast'", "administrative region", ""},
+	{"RU-SA", "Saha, Respublika", "republic", ""},
+	{"RU-SAK", "Sahalinskaja oblast'", "administrative region", ""},
+	{"RU-SAM", "Samarskaja oblast'", "administrative region", ""},
+	{"RU-SAR", "Saratovskaja oblast'", "administrative region", ""},
+	{"RU-SE", "Severnaja Osetija, Respublika", "republic", ""},
+	{"RU-SMO", "Smolenskaja oblast'", "administrative region", ""},
+	{"RU-SPE", "Sankt-Peterburg", "autonomous city", ""},
+	{"RU-STA", "Stavropol'skij kraj", "administrative territory", ""},
+	{"RU-SVE", "Sverdlovskaja oblast'", "administrative region", ""},
+	{"RU-TA", "Tatarstan, Respublika", "republic", ""},
+	{"RU-TAM", "Tambovskaja oblast'", "administrative region", ""},
+	{"RU-TOM", "Tomskaja oblast'", "administrative region", ""},
+	{"RU-TUL", "Tul'skaja oblast'", "administrative region", ""},
+	{"RU-TVE", "Tverskaja oblast'", "administrative region", ""},
+	{"RU-TY", "Tyva, Respublika", "republic", ""},
+	{"RU-TYU", "Tjumenskaja oblast'", "administrative region", ""},
+	{"RU-UD", "Udmurtskaja Respublika", "republic", ""},
+	{"RU-ULY", "Ul'janovskaja oblast'", "administrative region", ""},
+	{"RU-VGG", "Volgogradskaja oblast'", "administrative region", ""},
+	{"RU-VLA", "Vladimirskaja oblast'", "administrative region", ""},
+	{"RU-VLG", "Vologodskaja oblast'", "administrative region", ""},
+	{"RU-VOR", "Voronezhskaya oblast'", "administrative region", ""},
+	{"RU-YAN", "Jamalo-Neneckij avtonomnyj okrug", "autonomous district", ""},
+	{"RU-YAR", "Jaroslavskaja oblast'", "administrative region", ""},
+	{"RU-YEV", "Evrejskaja avtonomnaja oblast'", "autonomous region", ""},
+	{"RU-ZAB", "Zabajkal'skij kraj", "administrative territory", ""},
+	{"RW-01", "City of Kigali", "city", ""},
+	{"RW-02", "Eastern", "province", ""},
+	{"RW-03", "Northern", "province", ""},
+	{"RW-04", "Western", "province", ""},
+	{"RW-05", "Southern", "province", ""},
+	{"SA-01", "Ar Riyāḑ", "region", ""},
+	{"SA-02", "Makkah al Mukarramah", "region", ""},
+	{"SA-03", "Al Madīnah al Munawwarah", "region", ""},
+	{"SA-04", "Ash Sharqīyah", "region", ""},
+	{"SA-05", "Al Qaşīm", "region", ""},
+	{"SA-06", "Ḩā'il", "region", ""},
+	{"SA-07", "Tabūk", "region", ""},
+	{"SA-08", "Al Ḩudūd ash Shamālīyah", "region", ""},
+	{"SA-09", "Jāzān", "region", ""},
+	{"SA-10", "Najrān", "region", ""},
+	{"SA-11", "Al Bāḩah", "region", ""},
+	{"SA-12", "Al Jawf", "region", ""},
+	{"SA-14", "'Asīr", "region", ""},
+	{"SB-CE", "Central", "province", ""},
+	{"SB-CH", "Choiseul", "province", ""},
+	{"SB-CT", "Capital Territory (Honiara)", "capital territory", ""},
+	{"SB-GU", "Guadalcanal", "province", ""},
+	{"SB-IS", "Isabel", "province", ""},
+	{"SB-MK", "Makira-Ulawa", "province", ""},
+	{"SB-ML", "Malaita", "province", ""},
+	{"SB-RB", "Rennell and Bellona", "province", ""},
+	{"SB-TE", "Temotu", "province", ""},
+	{"SB-WE", "Western", "province", ""},
+	{"SC-01", "Anse aux Pins", "district", ""},
+	{"SC-02", "Anse Boileau", "district", ""},
+	{"SC-03", "Anse Etoile", "district", ""},
+	{"SC-04", "Au Cap", "district", ""},
+	{"SC-05", "Anse Royale", "district", ""},
+	{"SC-06", "Baie Lazare", "district", ""},
+	{"SC-07", "Baie Sainte Anne", "district", ""},
+	{"SC-08", "Beau Vallon", "district", ""},
+	{"SC-09", "Bel Air", "district", ""},
+	{"SC-10", "Bel Ombre", "district", ""},
+	{"SC-11", "Cascade", "district", ""},
+	{"SC-12", "Glacis", "district", ""},
+	{"SC-13", "Grand Anse Mahe", "district", ""},
+	{"SC-14", "Grand Anse Praslin", "district", ""},
+	{"SC-15", "La Digue", "district", ""},
+	{"SC-16", "English River", "district", ""},
+	{"SC-17", "Mont Buxton", "district", ""},
+	{"SC-18", "Mont Fleuri", "district", ""},
+	{"SC-19", "Plaisance", "district", ""},
+	{"SC-20", "Pointe Larue", "district", ""},
+	{"SC-21", "Port Glaud", "district", ""},
+	{"SC-22", "Saint Louis", "district", ""},
+	{"SC-23", "Takamaka", "district", ""},
+	{"SC-24", "Les Mamelles", "district", ""},
+	{"SC-25", "Roche Caiman", "district", ""},
+	{"SC-26", "Ile Perseverance I", "district", ""},
+	{"SC-27", "Ile Perseverance II", "district", ""},
+	{"SD-DC", "Central Darfur", "state", ""},
+	{"SD-DE", "East Darfur", "state", ""},
+	{"SD-DN", "North Darfur", "state", ""},
+	{"SD-DS", "South Darfur", "state", ""},
+	{"SD-DW", "West Darfur", "state", ""},
+	{"SD-GD", "Gedaref", "state", ""},
+	{"SD-GK", "West Kordofan", "state", ""},
+	{"SD-GZ", "Gezira", "state", ""},
+	{"SD-KA", "Kassala", "state", ""},
+	{"SD-KH", "Khartoum", "state", ""},
+	{"SD-KN", "North Kordofan", "state", ""},
+	{"SD-KS", "South Kordofan", "state", ""},
+	{"SD-NB", "Blue Nile", "state", ""},
+	{"SD-NO", "Northern", "state", ""},
+	{"SD-NR", "River Nile", "state", ""},
+	{"SD-NW", "White Nile", "state", ""},
+	{"SD-RS", "Red Sea", "state", ""},
+	{"SD-SI", "Sennar", "state", ""},
+	{"SE-AB", "Stockholms län [SE-01]", "county", ""},
+	{"SE-AC", "Västerbottens län [SE-24]", "county", ""},
+	{"SE-BD", "Norrbottens län [SE-25]", "county", ""},
+	{"SE-C", "Uppsala län [SE-03]", "county", ""},
+	{"SE-D", "Södermanlands län [SE-04]", "county", ""},
+	{"SE-E", "Östergötlands län [SE-05]", "county", ""},
+	{"SE-F", "Jönköpings län [SE-06]", "county", ""},
+	{"SE-G", "Kronobergs län [SE-07]", "county", ""},
+	{"SE-H", "Kalmar län [SE-08]", "county", ""},
+	{"SE-I", "Gotlands län [SE-09]", "county", ""},
+	{"SE-K", "Blekinge län [SE-10]", "county", ""},
+	{"SE-M", "Skåne län [SE-12]", "county", ""},
+	{"SE-N", "Hallands län [SE-13]", "county", ""},
+	{"SE-O", "Västra Götalands län [SE-14]", "county", ""},
+	{"SE-S", "Värmlands län [SE-17]", "county", ""},
+	{"SE-T", "Örebro län [SE-18]", "county", ""},
+	{"SE-U", "Västmanlands län [SE-19]", "county", ""},
+	{"SE-W", "Dalarnas län [SE-20]", "county", ""},
+	{"SE-X", "Gävleborgs län [SE-21]", "county", ""},
+	{"SE-Y", "Västernorrlands län [SE-22]", "county", ""},
+	{"SE-Z", "Jämtlands län [SE-23]", "county", ""},
+	{"SG-01", "Central Singapore", "district", ""},
+	{"SG-02", "North East", "district", ""},
+	{"SG-03", "North West", "district", ""},
+	{"SG-04", "South East", "district", ""},
+	{"SG-05", "South West", "district", ""},
+	{"SH-AC", "Ascension", "geographical entity", ""},
+	{"SH-HL", "Saint Helena", "geographical entity", ""},
+	{"SH-TA", "Tristan da Cunha", "geographical entity", ""},
+	{"SI-001", "Ajdovščina", "municipality", ""},
+	{"SI-002", "Beltinci", "municipality", ""},
+	{"SI-003", "Bled", "municipality", ""},
+	{"SI-004", "Bohinj", "municipality", ""},
+	{"SI-005", "Borovnica", "municipality", ""},
+	{"SI-006", "Bovec", "municipality", ""},
+	{"SI-007", "Brda", "municipality", ""},
+	{"SI-008", "Brezovica", "municipality", ""},
+	{"SI-009", "Brežice", "municipality", ""},
+	{"SI-010", "Tišina", "municipality", ""},
+	{"SI-011", "Celje", "municipality", ""},
+	{"SI-012", "Cerklje na Gorenjskem", "municipality", ""},
+	{"SI-013", "Cerknica", "municipality", ""},
+	{"SI-014", "Cerkno", "municipality", ""},
+	{"SI-015", "Črenšovci", "municipality", ""},
+	{"SI-016", "Črna na Koroškem", "municipality", ""},
+	{"SI-017", "Črnomelj", "municipality", ""},
+	{"SI-018", "Destrnik", "municipality", ""},
+	{"SI-019", "Divača", "municipality", ""},
+	{"SI-020", "Dobrepolje", "municipality", ""},
+	{"SI-021", "Dobrova-Polhov Gradec", "municipality", ""},
+	{"SI-022", "Dol pri Ljubljani", "municipality", ""},
+	{"SI-023", "Domžale", "municipality", ""},
+	{"SI-024", "Dornava", "municipality", ""},
+	{"SI-025", "Dravograd", "municipality", ""},
+	{"SI-026", "Duplek", "municipality", ""},
+	{"SI-027", "Gorenja vas-Poljane", "municipality", ""},
+	{"SI-028", "Gorišnica", "municipality", ""},
+	{"SI-029", "Gornja Radgona", "municipality", ""},
+	{"SI-030", "Gornji Grad", "municipality", ""},
+	{"SI-031", "Gornji Petrovci", "municipality", ""},
+	{"SI-032", "Grosuplje", "municipality", ""},
+	{"SI-033", "Šalovci", "municipality", ""},
+	{"SI-034", "Hrastnik", "municipality", ""},
+	{"SI-035", "Hrpelje-Kozina", "municipality", ""},
+	{"SI-036", "Idrija", "municipality", ""},
+	{"SI-037", "Ig", "municipality", ""},
+	{"SI-038", "Ilirska Bistrica", "municipality", ""},
+	{"SI-039", "Ivančna Gorica", "municipality", ""},
+	{"SI-040", "Izola", "municipality", ""},
+	{"SI-041", "Jesenice", "municipality", ""},
+	{"SI-042", "Juršinci", "municipality", ""},
+	{"SI-043", "Kamnik", "municipality", ""},
+	{"SI-044", "Kanal", "municipality", ""},
+	{"SI-045", "Kidričevo", "municipality", ""},
+	{"SI-046", "Kobarid", "municipality", ""},
+	{"SI-047", "Kobilje", "municipality", ""},
+	{"SI-048", "Kočevje", "municipality", ""},
+	{"SI-049", "Komen", "municipality", ""},
+	{"SI-050", "Koper", "municipality", ""},
+	{"SI-051", "Kozje", "municipality", ""},
+	{"SI-052", "Kranj", "municipality", ""},
+	{"SI-053", "Kranjska Gora", "municipality", ""},
+	{"SI-054", "Krško", "municipality", ""},
+	{"SI-055", "Kungota", "municipality", ""},
+	{"SI-056", "Kuzma", "municipality", ""},
+	{"SI-057", "Laško", "municipality", ""},
+	{"SI-058", "Lenart", "municipality", ""},
+	{"SI-059", "Lendava", "municipality", ""},
+	{"SI-060", "Litija", "municipality", ""},
+	{"SI-061", "Ljubljana", "municipality", ""},
+	{"SI-062", "Ljubno", "municipality", ""},
+	{"SI-063", "Ljutomer", "municipality", ""},
+	{"SI-064", "Logatec", "municipality", ""},
+	{"SI-065", "Loška dolina", "municipality", ""},
+	{"SI-066", "Loški Potok", "municipality", ""},
+	{"SI-067", "Luče", "municipality", ""},
+	{"SI-068", "Lukovica", "municipality", ""},
+	{"SI-069", "Majšperk", "municipality", ""},
+	{"SI-070", "Maribor", "municipality", ""},
+	{"SI-071", "Medvode", "municipality", ""},
+	{"SI-072", "Mengeš", "municipality", ""},
+	{"SI-073", "Metlika", "municipality", ""},
+	{"SI-074", "Mežica", "municipality", ""},
+	{"SI-075", "Miren-Kostanjevica", "municipality", ""},
+	{"SI-076", "Mislinja", "municipality", ""},
+	{"SI-077", "Moravče", "municipality", ""},
+	{"SI-078", "Moravske Toplice", "municipality", ""},
+	{"SI-079", "Mozirje", "municipality", ""},
+	{"SI-080", "Murska Sobota", "municipality", ""},
+	{"SI-081", "Muta", "municipality", ""},
+	{"SI-082", "Naklo", "municipality", ""},
+	{"SI-083", "Nazarje", "municipality", ""},
+	{"SI-084", "Nova Gorica", "municipality", ""},
+	{"SI-085", "Novo Mesto", "municipality", ""},
+	{"SI-086", "Odranci", "municipality", ""},
+	{"SI-087", "Ormož", "municipality", ""},
+	{"SI-088", "Osilnica", "municipality", ""},
+	{"SI-089", "Pesnica", "municipality", ""},
+	{"SI-090", "Piran", "municipality", ""},
+	{"SI-091", "Pivka", "municipality", ""},
+	{"SI-092", "Podčetrtek", "municipality", ""},
+	{"SI-093", "Podvelka", "municipality", ""},
+	{"SI-094", "Postojna", "municipality", ""},
+	{"SI-095", "Preddvor", "municipality", ""},
+	{"SI-096", "Ptuj", "municipality", ""},
+	{"SI-097", "Puconci", "municipality", ""},
+	{"SI-098", "Rače-Fram", "municipality", ""},
+	{"SI-099", "Radeče", "municipality", ""},
+	{"SI-100", "Radenci", "municipality", ""},
+	{"SI-101", "Radlje ob Dravi", "municipality", ""},
+	{"SI-102", "Radovljica", "municipality", ""},
+	{"SI-103", "Ravne na Koroškem", "municipality", ""},
+	{"SI-104", "Ribnica", "municipality", ""},
+	{"SI-105", "Rogašovci", "municipality", ""},
+	{"SI-106", "Rogaška Slatina", "municipality", ""},
+	{"SI-107", "Rogatec", "municipality", ""},
+	{"SI-108", "Ruše", "municipality", ""},
+	{"SI-109", "Semič", "municipality", ""},
+	{"SI-110", "Sevnica", "municipality", ""},
+	{"SI-111", "Sežana", "municipality", ""},
+	{"SI-112", "Slovenj Gradec", "municipality", ""},
+	{"SI-113", "Slovenska Bistrica", "municipality", ""},
+	{"SI-114", "Slovenske Konjice", "municipality", ""},
+	{"SI-115", "Starše", "municipality", ""},
+	{"SI-116", "Sveti Jurij ob Ščavnici", "municipality", ""},
+	{"SI-117", "Šenčur", "municipality", ""},
+	{"SI-118", "Šentilj", "municipality", ""},
+	{"SI-119", "Šentjernej", "municipality", ""},
+	{"SI-120", "Šentjur", "municipality", ""},
+	{"SI-121", "Škocjan", "municipality", ""},
+	{"SI-122", "Škofja Loka", "municipality", ""},
+	{"SI-123", "Škofljica", "municipality", ""},
+	{"SI-124", "Šmarje pri Jelšah", "municipality", ""},
+	{"SI-125", "Šmartno ob Paki", "municipality", ""},
+	{"SI-126", "Šoštanj", "municipality", ""},
+	{"SI-127", "Štore", "municipality", ""},
+	{"SI-128", "Tolmin", "municipality", ""},
+	{"SI-129", "Trbovlje", "municipality", ""},
+	{"SI-130", "Trebnje", "municipality", ""},
+	{"SI-131", "Tržič", "municipality", ""},
+	{"SI-132", "Turnišče", "municipality", ""},
+	{"SI-133", "Velenje", "municipality", ""},
+	{"SI-134", "Velike Lašče", "municipality", ""},
+	{"SI-135", "Videm", "municipality", ""},
+	{"SI-136", "Vipava", "municipality", ""},
+	{"SI-137", "Vitanje", "municipality", ""},
+	{"SI-138", "Vodice", "municipality", ""},
+	{"SI-139", "Vojnik", "municipality", ""},
+	{"SI-140", "Vrhnika", "municipality", ""},
+	{"SI-141", "Vuzenica", "municipality", ""},
+	{"SI-142", "Zagorje ob Savi", "municipality", ""},
+	{"SI-143", "Zavrč", "municipality", ""},
+	{"SI-144", "Zreče", "municipality", ""},
+	{"SI-146", "Železniki", "municipality", ""},
+	{"SI-147", "Žiri", "municipality", ""},
+	{"SI-148", "Benedikt", "municipality", ""},
+	{"SI-149", "Bistrica ob Sotli", "municipality", ""},
+	{"SI-150", "Bloke", "municipality", ""},
+	{"SI-151", "Braslovče", "municipality", ""},
+	{"SI-152", "Cankova", "municipality", ""},
+	{"SI-153", "Cerkvenjak", "municipality", ""},
+	{"SI-154", "Dobje", "municipality", ""},
+	{"SI-155", "Dobrna", "municipality", ""},
+	{"SI-156", "Dobrovnik", "municipality", ""},
+	{"SI-157", "Dolenjske Toplice", "municipality", ""},
+	{"SI-158", "Grad", "municipality", ""},
+	{"SI-159", "Hajdina", "municipality", ""},
+	{"SI-160", "Hoče-Slivnica", "municipality", ""},
+	{"SI-161", "Hodoš", "municipality", ""},
+	{"SI-162", "Horjul", "municipality", ""},
+	{"SI-163", "Jezersko", "municipality", ""},
+	{"SI-164", "Komenda", "municipality", ""},
+	{"SI-165", "Kostel", "municipality", ""},
+	{"SI-166", "Križevci", "municipality", ""},
+	{"SI-167", "Lovrenc na Pohorju", "municipality", ""},
+	{"SI-168", "Markovci", "municipality", ""},
+	{"SI-169", "Miklavž na Dravskem polju", "municipality", ""},
+	{"SI-170", "Mirna Peč", "municipality", ""},
+	{"SI-171", "Oplotnica", "municipality", ""},
+	{"SI-172", "Podlehnik", "municipality", ""},
+	{"SI-173", "Polzela", "municipality", ""},
+	{"SI-174", "Prebold", "municipality", ""},
+	{"SI-175", "Prevalje", "municipality", ""},
+	{"SI-176", "Razkrižje", "municipality", ""},
+	{"SI-177", "Ribnica na Pohorju", "municipality", ""},
+	{"SI-178", "Selnica ob Dravi", "municipality", ""},
+	{"SI-179", "Sodražica", "municipality", ""},
+	{"SI-180", "Solčava", "municipality", ""},
+	{"SI-181", "Sveta Ana", "municipality", ""},
+	{"SI-182", "Sveti Andraž v Slovenskih goricah", "municipality", ""},
+	{"SI-183", "Šempeter-Vrtojba", "municipality", ""},
+	{"SI-184", "Tabor", "municipality", ""},
+	{"SI-185", "Trnovska Vas", "municipality", ""},
+	{"SI-186", "Trzin", "municipality", ""},
+	{"SI-187", "Velika Polana", "municipality", ""},
+	{"SI-188", "Veržej", "municipality", ""},
+	{"SI-189", "Vransko", "municipality", ""},
+	{"SI-190", "Žalec", "municipality", ""},
+	{"SI-191", "Žetale", "municipality", ""},
+	{"SI-192", "Žirovnica", "municipality", ""},
+	{"SI-193", "Žužemberk", "municipality", ""},
+	{"SI-194", "Šmartno pri Litiji", "municipality", ""},
+	{"SI-195", "Apače", "municipality", ""},
+	{"SI-196", "Cirkulane", "municipality", ""},
+	{"SI-197", "Kosanjevica na Krki", "municipality", ""},
+	{"SI-198", "Makole", "municipality", ""},
+	{"SI-199", "Mokronog-Trebelno", "municipality", ""},
+	{"SI-200", "Poljčane", "municipality", ""},
+	{"SI-201", "Renče-Vogrsko", "municipality", ""},
+	{"SI-202", "Središče ob Dravi", "municipality", ""},
+	{"SI-203", "Straža", "municipality", ""},
+	{"SI-204", "Sveta Trojica v Slovenskih goricah", "municipality", ""},
+	{"SI-205", "Sveti Tomaž", "municipality", ""},
+	{"SI-206", "Šmarješke Toplice", "municipality", ""},
+	{"SI-207", "Gorje", "municipality", ""},
+	{"SI-208", "Log-Dragomer", "municipality", ""},
+	{"SI-209", "Rečica ob Savinji", "municipality", ""},
+	{"SI-210", "Sveti Jurij v Slovenskih goricah", "municipality", ""},
+	{"SI-211", "Šentrupert", "municipality", ""},
+	{"SI-212", "Mirna", "municipality", ""},
+	{"SI-213", "Ankaran", "municipality", ""},
+	{"SK-BC", "Banskobystrický kraj", "region", ""},
+	{"SK-BL", "Bratislavský kraj", "region", ""},
+	{"SK-KI", "Košický kraj", "region", ""},
+	{"SK-NI", "Nitriansky kraj", "region", ""},
+	{"SK-PV", "Prešovský kraj", "region", ""},
+	{"SK-TA", "Trnavský kraj", "region", ""},
+	{"SK-TC", "Trenčiansky kraj", "region", ""},
+	{"SK-ZI", "Žilinský kraj", "region", ""},
+	{"SL-E", "Eastern", "province", ""},
+	{"SL-N", "Northern", "province", ""},
+	{"SL-NW", "North Western", "province", ""},
+	{"SL-S", "Southern", "province", ""},
+	{"SL-W", "Western Area (Freetown)", "area", ""},
+	{"SM-01", "Acquaviva", "municipality", ""},
+	{"SM-02", "Chiesanuova", "municipality", ""},
+	{"SM-03", "Domagnano", "municipality", ""},
+	{"SM-04", "Faetano", "municipality", ""},
+	{"SM-05", "Fiorentino", "municipality", ""},
+	{"SM-06", "Borgo Maggiore", "municipality", ""},
+	{"SM-07", "Città di San Marino", "municipality", ""},
+	{"SM-08", "Montegiardino", "municipality", ""},
+	{"SM-09", "Serravalle", "municipality", ""},
+	{"SN-DB", "Diourbel", "region", ""},
+	{"SN-DK", "Dakar", "region", ""},
+	{"SN-FK", "Fatick", "region", ""},
+	{"SN-KA", "Kaffrine", "region", ""},
+	{"SN-KD", "Kolda", "region", ""},
+	{"SN-KE", "Kédougou", "region", ""},
+	{"SN-KL", "Kaolack", "region", ""},
+	{"SN-LG", "Louga", "region", ""},
+	{"SN-MT", "Matam", "region", ""},
+	{"SN-SE", "Sédhiou", "region", ""},
+	{"SN-SL", "Saint-Louis", "region", ""},
+	{"SN-TC", "Tambacounda", "region", ""},
+	{"SN-TH", "Thiès", "region", ""},
+	{"SN-ZG", "Ziguinchor", "region", ""},
+	{"SO-AW", "Awdal", "region", ""},
+	{"SO-BK", "Bakool", "region", ""},
+	{"SO-BN", "Banaadir", "region", ""},
+	{"SO-BR", "Bari", "region", ""},
+	{"SO-BY", "Bay", "region", ""},
+	{"SO-GA", "Galguduud", "region", ""},
+	{"SO-GE", "Gedo", "region", ""},
+	{"SO-HI", "Hiiraan", "region", ""},
+	{"SO-JD", "Jubbada Dhexe", "region", ""},
+	{"SO-JH", "Jubbada Hoose", "region", ""},
+	{"SO-MU", "Mudug", "region", ""},
+	{"SO-NU", "Nugaal", "region", ""},
+	{"SO-SA", "Sanaag", "region", ""},
+	{"SO-SD", "Shabeellaha Dhexe", "region", ""},
+	{"SO-SH", "Shabeellaha Hoose", "region", ""},
+	{"SO-SO", "Sool", "region", ""},
+	{"SO-TO", "Togdheer", "region", ""},
+	{"SO-WO", "Woqooyi Galbeed", "region", ""},
+	{"SR-BR", "Brokopondo", "district", ""},
+	{"SR-CM", "Commewijne", "district", ""},
+	{"SR-CR", "Coronie", "district", ""},
+	{"SR-MA", "Marowijne", "district", ""},
+	{"SR-NI", "Nickerie", "district", ""},
+	{"SR-PM", "Paramaribo", "district", ""},
+	{"SR-PR", "Para", "district", ""},
+	{"SR-SA", "Saramacca", "district", ""},
+	{"SR-SI", "Sipaliwini", "district", ""},
+	{"SR-WA", "Wanica", "district", ""},
+	{"SS-BN", "Northern Bahr el Ghazal", "state", ""},
+	{"SS-BW", "Western Bahr el Ghazal", "state", ""},
+	{"SS-EC", "Central Equatoria", "state", ""},
+	{"SS-EE", "Eastern Equatoria", "state", ""},
+	{"SS-EW", "Western Equatoria", "state", ""},
+	{"SS-JG", "Jonglei", "state", ""},
+	{"SS-LK", "Lakes", "state", ""},
+	{"SS-NU", "Upper Nile", "state", ""},
+	{"SS-UY", "Unity", "state", ""},
+	{"SS-WR", "Warrap", "state", ""},
+	{"ST-01", "Água Grande", "district", ""},
+	{"ST-02", "Cantagalo", "district", ""},
+	{"ST-03", "Caué", "district", ""},
+	{"ST-04", "Lembá", "district", ""},
+	{"ST-05", "Lobata", "district", ""},
+	{"ST-06", "Mé-Zóchi", "district", ""},
+	{"ST-P", "Príncipe", "autonomous region", ""},
+	{"SV-AH", "Ahuachapán", "department", ""},
+	{"SV-CA", "Cabañas", "department", ""},
+	{"SV-CH", "Chalatenango", "department", ""},
+	{"SV-CU", "Cuscatlán", "department", ""},
+	{"SV-LI", "La Libertad", "department", ""},
+	{"SV-MO", "Morazán", "department", ""},
+	{"SV-PA", "La Paz", "department", ""},
+	{"SV-SA", "Santa Ana", "department", ""},
+	{"SV-SM", "San Miguel", "department", ""},
+	{"SV-SO", "Sonsonate", "department", ""},
+	{"SV-SS", "San Salvador", "department", ""},
+	{"SV-SV", "San Vicente", "department", ""},
+	{"SV-UN", "La Unión", "department", ""},
+	{"SV-US", "Usulután", "department", ""},
+	{"SY-DI", "Dimashq", "province", ""},
+	{"SY-DR", "Dar'ā", "province", ""},
+	{"SY-DY", "Dayr az Zawr", "province", ""},
+	{"SY-HA", "Al Ḩasakah", "province", ""},
+	{"SY-HI", "Ḩimş", "province", ""},
+	{"SY-HL", "Ḩalab", "province", ""},
+	{"SY-HM", "Ḩamāh", "province", ""},
+	{"SY-ID", "Idlib", "province", ""},
+	{"SY-LA", "Al Lādhiqīyah", "province", ""},
+	{"SY-QU", "Al Qunayţirah", "province", ""},
+	{"SY-RA", "Ar Raqqah", "province", ""},
+	{"SY-RD", "Rīf Dimashq", "province", ""},
+	{"SY-SU", "As Suwaydā'", "province", ""},
+	{"SY-TA", "Ţarţūs", "province", ""},
+	{"SZ-HH", "Hhohho", "region", ""},
+	{"SZ-LU", "Lubombo", "region", ""},
+	{"SZ-MA", "Manzini", "region", ""},
+	{"SZ-SH", "Shiselweni", "region", ""},
+	{"TD-BA", "Al Baţḩā’", "province", ""},
+	{"TD-BG", "Bahr el Ghazal", "province", ""},
+	{"TD-BO", "Borkou", "province", ""},
+	{"TD-CB", "Chari-Baguirmi", "province", ""},
+	{"TD-EE", "Ennedi-Est", "province", ""},
+	{"TD-EO", "Ennedi-Ouest", "province", ""},
+	{"TD-GR", "Guéra", "province", ""},
+	{"TD-HL", "Hadjer Lamis", "province", ""},
+	{"TD-KA", "Kanem", "province", ""},
+	{"TD-LC", "Al Buḩayrah", "province", ""},
+	{"TD-LO", "Logone-Occidental", "province", ""},
+	{"TD-LR", "Logone-Oriental", "province", ""},
+	{"TD-MA", "Mandoul", "province", ""},
+	{"TD-MC", "Moyen-Chari", "province", ""},
+	{"TD-ME", "Mayo-Kebbi-Est", "province", ""},
+	{"TD-MO", "Mayo-Kebbi-Ouest", "province", ""},
+	{"TD-ND", "Madīnat Injamīnā", "province", ""},
+	{"TD-OD", "Ouaddaï", "province", ""},
+	{"TD-SA", "Salamat", "province", ""},
+	{"TD-SI", "Sila", "province", ""},
+	{"TD-TA", "Tandjilé", "province", ""},
+	{"TD-TI", "Tibastī", "province", ""},
+	{"TD-WF", "Wadi Fira", "province", ""},
+	{"TG-C", "Centrale", "region", ""},
+	{"TG-K", "Kara", "region", ""},
+	{"TG-M", "Maritime (Région)", "region", ""},
+	{"TG-P", "Plateaux", "region", ""},
+	{"TG-S", "Savanes", "region", ""},
+	{"TH-10", "Krung Thep Maha Nakhon", "metropolitan administration", ""},
+	{"TH-11", "Samut Prakan", "province", ""},
+	{"TH-12", "Nonthaburi", "province", ""},
+	{"TH-13", "Pathum Thani", "province", ""},
+	{"TH-14", "Phra Nakhon Si Ayutthaya", "province", ""},
+	{"TH-15", "Ang Thong", "province", ""},
+	{"TH-16", "Lop Buri", "province", ""},
+	{"TH-17", "Sing Buri", "province", ""},
+	{"TH-18", "Chai Nat", "province", ""},
+	{"TH-19", "Saraburi", "province", ""},
+	{"TH-20", "Chon Buri", "province", ""},
+	{"TH-21", "Rayong", "province", ""},
+	{"TH-22", "Chanthaburi", "province", ""},
+	{"TH-23", "Trat", "province", ""},
+	{"TH-24", "Chachoengsao", "province", ""},
+	{"TH-25", "Prachin Buri", "province", ""},
+	{"TH-26", "Nakhon Nayok", "province", ""},
+	{"TH-27", "Sa Kaeo", "province", ""},
+	{"TH-30", "Nakhon Ratchasima", "province", ""},
+	{"TH-31", "Buri Ram", "province", ""},
+	{"TH-32", "Surin", "province", ""},
+	{"TH-33", "Si Sa Ket", "province", ""},
+	{"TH-34", "Ubon Ratchathani", "province", ""},
+	{"TH-35", "Yasothon", "province", ""},
+	{"TH-36", "Chaiyaphum", "province", ""},
+	{"TH-37", "Amnat Charoen", "province", ""},
+	{"TH-38", "Bueng Kan", "province", ""},
+	{"TH-39", "Nong Bua Lam Phu", "province", ""},
+	{"TH-40", "Khon Kaen", "province", ""},
+	{"TH-41", "Udon Thani", "province", ""},
+	{"TH-42", "Loei", "province", ""},
+	{"TH-43", "Nong Khai", "province", ""},
+	{"TH-44", "Maha Sarakham", "province", ""},
+	{"TH-45", "Roi Et", "province", ""},
+	{"TH-46", "Kalasin", "province", ""},
+	{"TH-47", "Sakon Nakhon", "province", ""},
+	{"TH-48", "Nakhon Phanom", "province", ""},
+	{"TH-49", "Mukdahan", "province", ""},
+	{"TH-50", "Chiang Mai", "province", ""},
+	{"TH-51", "Lamphun", "province", ""},
+	{"TH-52", "Lampang", "province", ""},
+	{"TH-53", "Uttaradit", "province", ""},
+	{"TH-54", "Phrae", "province", ""},
+	{"TH-55", "Nan", "province", ""},
+	{"TH-56", "Phayao", "province", ""},
+	{"TH-57", "Chiang Rai", "province", ""},
+	{"TH-58", "Mae Hong Son", "province", ""},
+	{"TH-60", "Nakhon Sawan", "province", ""},
+	{"TH-61", "Uthai Thani", "province", ""},
+	{"TH-62", "Kamphaeng Phet", "province", ""},
+	{"TH-63", "Tak", "province", ""},
+	{"TH-64", "Sukhothai", "province", ""},
+	{"TH-65", "Phitsanulok", "province", ""},
+	{"TH-66", "Phichit", "province", ""},
+	{"TH-67", "Phetchabun", "province", ""},
+	{"TH-70", "Ratchaburi", "province", ""},
+	{"TH-71", "Kanchanaburi", "province", ""},
+	{"TH-72", "Suphan Buri", "province", ""},
+	{"TH-73", "Nakhon Pathom", "province", ""},
+	{"TH-74", "Samut Sakhon", "province", ""},
+	{"TH-75", "Samut Songkhram", "province", ""},
+	{"TH-76", "Phetchaburi", "province", ""},
+	{"TH-77", "Prachuap Khiri Khan", "province", ""},
+	{"TH-80", "Nakhon Si Thammarat", "province", ""},
+	{"TH-81", "Krabi", "province", ""},
+	{"TH-82", "Phangnga", "province", ""},
+	{"TH-83", "Phuket", "province", ""},
+	{"TH-84", "Surat Thani", "province", ""},
+	{"TH-85", "Ranong", "province", ""},
+	{"TH-86", "Chumphon", "province", ""},
+	{"TH-90", "Songkhla", "province", ""},
+	{"TH-91", "Satun", "province", ""},
+	{"TH-92", "Trang", "province", ""},
+	{"TH-93", "Phatthalung", "province", ""},
+	{"TH-94", "Pattani", "province", ""},
+	{"TH-95", "Yala", "province", ""},
+	{"TH-96", "Narathiwat", "province", ""},
+	{"TH-S", "Phatthaya", "special administrative city", ""},
+	{"TJ-DU", "Dushanbe", "capital territory", ""},
+	{"TJ-GB", "Kŭhistoni Badakhshon", "autonomous region", ""},
+	{"TJ-KT", "Khatlon", "region", ""},
+	{"TJ-RA", "nohiyahoi tobei jumhurí", "districts under republic administration", ""},
+	{"TJ-SU", "Sughd", "region", ""},
+	{"TL-AL", "Aileu", "municipality", ""},
+	{"TL-AN", "Ainaro", "municipality", ""},
+	{"TL-BA", "Baucau", "municipality", ""},
+	{"TL-BO", "Bobonaro", "municipality", ""},
+	{"TL-CO", "Cova Lima", "municipality", ""},
+	{"TL-DI", "Díli", "municipality", ""},
+	{"TL-ER", "Ermera", "municipality", ""},
+	{"TL-LA", "Lautein", "municipality", ""},
+	{"TL-LI", "Likisá", "municipality", ""},
+	{"TL-MF", "Manufahi", "municipality", ""},
+	{"TL-MT", "Manatuto", "municipality", ""},
+	{"TL-OE", "Oekusi-Ambenu", "special administrative region", ""},
+	{"TL-VI", "Vikeke", "municipality", ""},
+	{"TM-A", "Ahal", "region", ""},
+	{"TM-B", "Balkan", "region", ""},
+	{"TM-D", "Daşoguz", "region", ""},
+	{"TM-L", "Lebap", "region", ""},
+	{"TM-M", "Mary", "region", ""},
+	{"TM-S", "Aşgabat", "city", ""},
+	{"TN-11", "Tunis", "governorate", ""},
+	{"TN-12", "L'Ariana", "governorate", ""},
+	{"TN-13", "Ben Arous", "governorate", ""},
+	{"TN-14", "La Manouba", "governorate", ""},
+	{"TN-21", "Nabeul", "governorate", ""},
+	{"TN-22", "Zaghouan", "governorate", ""},
+	{"TN-23", "Bizerte", "governorate", ""},
+	{"TN-31", "Béja", "governorate", ""},
+	{"TN-32", "Jendouba", "governorate", ""},
+	{"TN-33", "Le Kef", "governorate", ""},
+	{"TN-34", "Siliana", "governorate", ""},
+	{"TN-41", "Kairouan", "governorate", ""},
+	{"TN-42", "Kasserine", "governorate", ""},
+	{"TN-43", "Sidi Bouzid", "governorate", ""},
+	{"TN-51", "Sousse", "governorate", ""},
+	{"TN-52", "Monastir", "governorate", ""},
+	{"TN-53", "Mahdia", "governorate", ""},
+	{"TN-61", "Sfax", "governorate", ""},
+	{"TN-71", "Gafsa", "governorate", ""},
+	{"TN-72", "Tozeur", "governorate", ""},
+	{"TN-73", "Kébili", "governorate", ""},
+	{"TN-81", "Gabès", "governorate", ""},
+	{"TN-82", "Médenine", "governorate", ""},
+	{"TN-83", "Tataouine", "governorate", ""},
+	{"TO-01", "'Eua", "division", ""},
+	{"TO-02", "Ha'apai", "division", ""},
+	{"TO-03", "Niuas", "division", ""},
+	{"TO-04", "Tongatapu", "division", ""},
+	{"TO-05", "Vava'u", "division", ""},
+	{"TR-01", "Adana", "province", ""},
+	{"TR-02", "Adıyaman", "province", ""},
+	{"TR-03", "Afyonkarahisar", "province", ""},
+	{"TR-04", "Ağrı", "province", ""},
+	{"TR-05", "Amasya", "province", ""},
+	{"TR-06", "Ankara", "province", ""},
+	{"TR-07", "Antalya", "province", ""},
+	{"TR-08", "Artvin", "province", ""},
+	{"TR-09", "Aydın", "province", ""},
+	{"TR-10", "Balıkesir", "province", ""},
+	{"TR-11", "Bilecik", "province", ""},
+	{"TR-12", "Bingöl", "province", ""},
+	{"TR-13", "Bitlis", "province", ""},
+	{"TR-14", "Bolu", "province", ""},
+	{"TR-15", "Burdur", "province", ""},
+	{"TR-16", "Bursa", "province", ""},
+	{"TR-17", "Çanakkale", "province", ""},
+	{"TR-18", "Çankırı", "province", ""},
+	{"TR-19", "Çorum", "province", ""},
+	{"TR-20", "Denizli", "province", ""},
+	{"TR-21", "Diyarbakır", "province", ""},
+	{"TR-22", "Edirne", "province", ""},
+	{"TR-23", "Elazığ", "province", ""},
+	{"TR-24", "Erzincan", "province", ""},
+	{"TR-25", "Erzurum", "province", ""},
+	{"TR-26", "Eskişehir", "province", ""},
+	{"TR-27", "Gaziantep", "province", ""},
+	{"TR-28", "Giresun", "province", ""},
+	{"TR-29", "Gümüşhane", "province", ""},
+	{"TR-30", "Hakkâri", "province", ""},
+	{"TR-31", "Hatay", "province", ""},
+	{"TR-32", "Isparta", "province", ""},
+	{"TR-33", "Mersin", "province", ""},
+	{"TR-34", "İstanbul", "province", ""},
+	{"TR-35", "İzmir", "province", ""},
+	{"TR-36", "Kars", "province", ""},
+	{"TR-37", "Kastamonu", "province", ""},
+	{"TR-38", "Kayseri", "province", ""},
+	{"TR-39", "Kırklareli", "province", ""},
+	{"TR-40", "Kırşehir", "province", ""},
+	{"TR-41", "Kocaeli", "province", ""},
+	{"TR-42", "Konya", "province", ""},
+	{"TR-43", "Kütahya", "province", ""},
+	{"TR-44", "Malatya", "province", ""},
+	{"TR-45", "Manisa", "province", ""},
+	{"TR-46", "Kahramanmaraş", "province", ""},
+	{"TR-47", "Mardin", "province", ""},
+	{"TR-48", "Muğla", "province", ""},
+	{"TR-49", "Muş", "province", ""},
+	{"TR-50", "Nevşehir", "province", ""},
+	{"TR-51", "Niğde", "province", ""},
+	{"TR-52", "Ordu", "province", ""},
+	{"TR-53", "Rize", "province", ""},
+	{"TR-54", "Sakarya", "province", ""},
+	{"TR-55", "Samsun", "province", ""},
+	{"TR-56", "Siirt", "province", ""},
+	{"TR-57", "Sinop", "province", ""},
+	{"TR-58", "Sivas", "province", ""},
+	{"TR-59", "Tekirdağ", "province", ""},
+	{"TR-60", "Tokat", "province", ""},
+	{"TR-61", "Trabzon", "province", ""},
+	{"TR-62", "Tunceli", "province", ""},
+	{"TR-63", "Şanlıurfa", "province", ""},
+	{"TR-64", "Uşak", "province", ""},
+	{"TR-65", "Van", "province", ""},
+	{"TR-66", "Yozgat", "province", ""},
+	{"TR-67", "Zonguldak", "province", ""},
+	{"TR-68", "Aksaray", "province", ""},
+	{"TR-69", "Bayburt", "province", ""},
+	{"TR-70", "Karaman", "province", ""},
+	{"TR-71", "Kırıkkale", "province", ""},
+	{"TR-72", "Batman", "province", ""},
+	{"TR-73", "Şırnak", "province", ""},
+	{"TR-74", "Bartın", "province", ""},
+	{"TR-75", "Ardahan", "province", ""},
+	{"TR-76", "Iğdır", "province", ""},
+	{"TR-77", "Yalova", "province", ""},
+	{"TR-78", "Karabük", "province", ""},
+	{"TR-79", "Kilis", "province", ""},
+	{"TR-80", "Osmaniye", "province", ""},
+	{"TR-81", "Düzce", "province", ""},
+	{"TT-ARI", "Arima", "borough", ""},
+	{"TT-CHA", "Chaguanas", "borough", ""},
+	{"TT-CTT", "Couva-Tabaquite-Talparo", "region", ""},
+	{"TT-DMN", "Diego Martin", "region", ""},
+	{"TT-MRC", "Mayaro-Rio Claro", "region", ""},
+	{"TT-PED", "Penal-Debe", "region", ""},
+	{"TT-POS", "Port of Spain", "city", ""},
+	{"TT-PRT", "Princes Town", "region", ""},
+	{"TT-PTF", "Point Fortin", "borough", ""},
+	{"TT-SFO", "San Fernando", "city", ""},
+	{"TT-SGE", "Sangre Grande", "region", ""},
+	{"TT-SIP", "Siparia", "region", ""},
+	{"TT-SJL", "San Juan-Laventille", "region", ""},
+	{"TT-TOB", "Tobago", "ward", ""},
+	{"TT-TUP", "Tunapuna-Piarco", "region", ""},
+	{"TV-FUN", "Funafuti", "town council", ""},
+	{"TV-NIT", "Niutao", "island council", ""},
+	{"TV-NKF", "Nukufetau", "island council", ""},
+	{"TV-NKL", "Nukulaelae", "island council", ""},
+	{"TV-NMA", "Nanumea", "island council", ""},
+	{"TV-NMG", "Nanumaga", "island council", ""},
+	{"TV-NUI", "Nui", "island council", ""},
+	{"TV-VAI", "Vaitupu", "island council", ""},
+	{"TW-CHA", "Changhua", "county", ""},
+	{"TW-CYI", "Chiayi", "city", ""},
+	{"TW-CYQ", "Chiayi", "county", ""},
+	{"TW-HSQ", "Hsinchu", "county", ""},
+	{"TW-HSZ", "Hsinchu", "city", ""},
+	{"TW-HUA", "Hualien", "county", ""},
+	{"TW-ILA", "Yilan", "county", ""},
+	{"TW-KEE", "Keelung", "city", ""},
+	{"TW-KHH", "Kaohsiung", "special municipality", ""},
+	{"TW-KIN", "Kinmen", "county", ""},
+	{"TW-LIE", "Lienchiang", "county", ""},
+	{"TW-MIA", "Miaoli", "county", ""},
+	{"TW-NAN", "Nantou", "county", ""},
+	{"TW-NWT", "New Taipei", "special municipality", ""},
+	{"TW-PEN", "Penghu", "county", ""},
+	{"TW-PIF", "Pingtung", "county", ""},
+	{"TW-TAO", "Taoyuan", "special municipality", ""},
+	{"TW-TNN", "Tainan", "special municipality", ""},
+	{"TW-TPE", "Taipei", "special municipality", ""},
+	{"TW-TTT", "Taitung", "county", ""},
+	{"TW-TXG", "Taichung", "special municipality", ""},
+	{"TW-YUN", "Yunlin", "county", ""},
+	{"TZ-01", "Arusha", "region", ""},
+	{"TZ-02", "Dar es Salaam", "region", ""},
+	{"TZ-03", "Dodoma", "region", ""},
+	{"TZ-04", "Iringa", "region", ""},
+	{"TZ-05", "Kagera", "region", ""},
+	{"TZ-06", "Pemba North", "region", ""},
+	{"TZ-07", "Zanzibar North", "region", ""},
+	{"TZ-08", "Kigoma", "region", ""},
+	{"TZ-09", "Kilimanjaro", "region", ""},
+	{"TZ-10", "Pemba South", "region", ""},
+	{"TZ-11", "Zanzibar South", "region", ""},
+	{"TZ-12", "Lindi", "region", ""},
+	{"TZ-13", "Mara", "region", ""},
+	{"TZ-14", "Mbeya", "region", ""},
+	{"TZ-15", "Zanzibar West", "region", ""},
+	{"TZ-16", "Morogoro", "region", ""},
+	{"TZ-17", "Mtwara", "region", ""},
+	{"TZ-18", "Mwanza", "region", ""},
+	{"TZ-19", "Coast", "region", ""},
+	{"TZ-20", "Rukwa", "region", ""},
+	{"TZ-21", "Ruvuma", "region", ""},
+	{"TZ-22", "Shinyanga", "region", ""},
+	{"TZ-23", "Singida", "region", ""},
+	{"TZ-24", "Tabora", "region", ""},
+	{"TZ-25", "Tanga", "region", ""},
+	{"TZ-26", "Manyara", "region", ""},
+	{"TZ-27", "Geita", "region", ""},
+	{"TZ-28", "Katavi", "region", ""},
+	{"TZ-29", "Njombe", "region", ""},
+	{"TZ-30", "Simiyu", "region", ""},
+	{"TZ-31", "Songwe", "region", ""},
+	{"UA-05", "Vinnytska oblast", "region", ""},
+	{"UA-07", "Volynska oblast", "region", ""},
+	{"UA-09", "Luhanska oblast", "region", ""},
+	{"UA-12", "Dnipropetrovska oblast", "region", ""},
+	{"UA-14", "Donetska oblast", "region", ""},
+	{"UA-18", "Zhytomyrska oblast", "region", ""},
+	{"UA-21", "Zakarpatska oblast", "region", ""},
+	{"UA-23", "Zaporizka oblast", "region", ""},
+	{"UA-26", "Ivano-Frankivska oblast", "region", ""},
+	{"UA-30", "Kyiv", "city", ""},
+	{"UA-32", "Kyivska oblast", "region", ""},
+	{"UA-35", "Kirovohradska oblast", "region", ""},
+	{"UA-40", "Sevastopol", "city", ""},
+	{"UA-43", "Avtonomna Respublika Krym", "republic", ""},
+	{"UA-46", "Lvivska oblast", "region", ""},
+	{"UA-48", "Mykolaivska oblast", "region", ""},
+	{"UA-51", "Odeska oblast", "region", ""},
+	{"UA-53", "Poltavska oblast", "region", ""},
+	{"UA-56", "Rivnenska oblast", "region", ""},
+	{"UA-59", "Sumska oblast", "region", ""},
+	{"UA-61", "Ternopilska oblast", "region", ""},
+	{"UA-63", "Kharkivska oblast", "region", ""},
+	{"UA-65", "Khersonska oblast", "region", ""},
+	{"UA-68", "Khmelnytska oblast", "region", ""},
+	{"UA-71", "Cherkaska oblast", "region", ""},
+	{"UA-74", "Chernihivska oblast", "region", ""},
+	{"UA-77", "Chernivetska oblast", "region", ""},
+	{"UG-101", "Kalangala", "district", "UG-C"},
+	{"UG-102", "Kampala", "city", "UG-C"},
+	{"UG-103", "Kiboga", "district", "UG-C"},
+	{"UG-104", "Luwero", "district", "UG-C"},
+	{"UG-105", "Masaka", "district", "UG-C"},
+	{"UG-106", "Mpigi", "district", "UG-C"},
+	{"UG-107", "Mubende", "district", "UG-C"},
+	{"UG-108", "Mukono", "district", "UG-C"},
+	{"UG-109", "Nakasongola", "district", "UG-C"},
+	{"UG-110", "Rakai", "district", "UG-C"},
+	{"UG-111", "Sembabule", "district", "UG-C"},
+	{"UG-112", "Kayunga", "district", "UG-C"},
+	{"UG-113", "Wakiso", "district", "UG-C"},
+	{"UG-114", "Lyantonde", "district", "UG-C"},
+	{"UG-115", "Mityana", "district", "UG-C"},
+	{"UG-116", "Nakaseke", "district", "UG-C"},
+	{"UG-117", "Buikwe", "district", "UG-C"},
+	{"UG-118", "Bukomansibi", "district", "UG-C"},
+	{"UG-119", "Butambala", "district", "UG-C"},
+	{"UG-120", "Buvuma", "district", "UG-C"},
+	{"UG-121", "Gomba", "district", "UG-C"},
+	{"UG-122", "Kalungu", "district", "UG-C"},
+	{"UG-123", "Kyankwanzi", "district", "UG-C"},
+	{"UG-124", "Lwengo", "district", "UG-C"},
+	{"UG-125", "Kyotera", "district", "UG-C"},
+	{"UG-126", "Kasanda", "district", "UG-C"},
+	{"UG-201", "Bugiri", "district", "UG-E"},
+	{"UG-202", "Busia", "district", "UG-E"},
+	{"UG-203", "Iganga", "district", "UG-E"},
+	{"UG-204", "Jinja", "district", "UG-E"},
+	{"UG-205", "Kamuli", "district", "UG-E"},
+	{"UG-206", "Kapchorwa", "district", "UG-E"},
+	{"UG-207", "Katakwi", "district", "UG-E"},
+	{"UG-208", "Kumi", "district", "UG-E"},
+	{"UG-209", "Mbale", "district", "UG-E"},
+	{"UG-210", "Pallisa", "district", "UG-E"},
+	{"UG-211", "Soroti", "district", "UG-E"},
+	{"UG-212", "Tororo", "district", "UG-E"},
+	{"UG-213", "Kaberamaido", "district", "UG-E"},
+	{"UG-214", "Mayuge", "district", "UG-E"},
+	{"UG-215", "Sironko", "district", "UG-E"},
+	{"UG-216", "Amuria", "district", "UG-E"},
+	{"UG-217", "Budaka", "district", "UG-E"},
+	{"UG-218", "Bududa", "district", "UG-E"},
+	{"UG-219", "Bukedea", "district", "UG-E"},
+	{"UG-220", "Bukwo", "district", "UG-E"},
+	{"UG-221", "Butaleja", "district", "UG-E"},
+	{"UG-222", "Kaliro", "district", "UG-E"},
+	{"UG-223", "Manafwa", "district", "UG-E"},
+	{"UG-224", "Namutumba", "district", "UG-E"},
+	{"UG-225", "Bulambuli", "district", "UG-E"},
+	{"UG-226", "Buyende", "district", "UG-E"},
+	{"UG-227", "Kibuku", "district", "UG-E"},
+	{"UG-228", "Kween", "district", "UG-E"},
+	{"UG-229", "Luuka", "district", "UG-E"},
+	{"UG-230", "Namayingo", "district", "UG-E"},
+	{"UG-231", "Ngora", "district", "UG-E"},
+	{"UG-232", "Serere", "district", "UG-E"},
+	{"UG-233", "Butebo", "district", "UG-E"},
+	{"UG-234", "Namisindwa", "district", "UG-E"},
+	{"UG-235", "Bugweri", "district", "UG-E"},
+	{"UG-236", "Kapelebyong", "district", "UG-E"},
+	{"UG-237", "Kalaki", "district", "UG-E"},
+	{"UG-301", "Adjumani", "district", "UG-N"},
+	{"UG-302", "Apac", "district", "UG-N"},
+	{"UG-303", "Arua", "district", "UG-N"},
+	{"UG-304", "Gulu", "district", "UG-N"},
+	{"UG-305", "Kitgum", "district", "UG-N"},
+	{"UG-306", "Kotido", "district", "UG-N"},
+	{"UG-307", "Lira", "district", "UG-N"},
+	{"UG-308", "Moroto", "district", "UG-N"},
+	{"UG-309", "Moyo", "district", "UG-N"},
+	{"UG-310", "Nebbi", "district", "UG-N"},
+	{"UG-311", "Nakapiripirit", "district", "UG-N"},
+	{"UG-312", "Pader", "district", "UG-N"},
+	{"UG-313", "Yumbe", "district", "UG-N"},
+	{"UG-314", "Abim", "district", "UG-N"},
+	{"UG-315", "Amolatar", "district", "UG-N"},
+	{"UG-316", "Amuru", "district", "UG-N"},
+	{"UG-317", "Dokolo", "district", "UG-N"},
+	{"UG-318", "Kaabong", "district", "UG-N"},
+	{"UG-319", "Koboko", "district", "UG-N"},
+	{"UG-320", "Maracha", "district", "UG-N"},
+	{"UG-321", "Oyam", "district", "UG-N"},
+	{"UG-322", "Agago", "district", "UG-N"},
+	{"UG-323", "Alebtong", "district", "UG-N"},
+	{"UG-324", "Amudat", "district", "UG-N"},
+	{"UG-325", "Kole", "district", "UG-N"},
+	{"UG-326", "Lamwo", "district", "UG-N"},
+	{"UG-327", "Napak", "district", "UG-N"},
+	{"UG-328", "Nwoya", "district", "UG-N"},
+	{"UG-329", "Otuke", "district", "UG-N"},
+	{"UG-330", "Zombo", "district", "UG-N"},
+	{"UG-331", "Omoro", "district", "UG-N"},
+	{"UG-332", "Pakwach", "district", "UG-N"},
+	{"UG-333", "Kwania", "district", "UG-N"},
+	{"UG-334", "Nabilatuk", "district", "UG-N"},
+	{"UG-335", "Karenga", "district", "UG-N"},
+	{"UG-336", "Madi-Okollo", "district", "UG-N"},
+	{"UG-337", "Obongi", "district", "UG-N"},
+	{"UG-401", "Bundibugyo", "district", "UG-W"},
+	{"UG-402", "Bushenyi", "district", "UG-W"},
+	{"UG-403", "Hoima", "district", "UG-W"},
+	{"UG-404", "Kabale", "district", "UG-W"},
+	{"UG-405", "Kabarole", "district", "UG-W"},
+	{"UG-406", "Kasese", "district", "UG-W"},
+	{"UG-407", "Kibaale", "district", "UG-W"},
+	{"UG-408", "Kisoro", "district", "UG-W"},
+	{"UG-409", "Masindi", "district", "UG-W"},
+	{"UG-410", "Mbarara", "district", "UG-W"},
+	{"UG-411", "Ntungamo", "district", "UG-W"},
+	{"UG-412", "Rukungiri", "district", "UG-W"},
+	{"UG-413", "Kamwenge", "district", "UG-W"},
+	{"UG-414", "Kanungu", "district", "UG-W"},
+	{"UG-415", "Kyenjojo", "district", "UG-W"},
+	{"UG-416", "Buliisa", "district", "UG-W"},
+	{"UG-417", "Ibanda", "district", "UG-W"},
+	{"UG-418", "Isingiro", "district", "UG-W"},
+	{"UG-419", "Kiruhura", "district", "UG-W"},
+	{"UG-420", "Buhweju", "district", "UG-W"},
+	{"UG-421", "Kiryandongo", "district", "UG-W"},
+	{"UG-422", "Kyegegwa", "district", "UG-W"},
+	{"UG-423", "Mitooma", "district", "UG-W"},
+	{"UG-424", "Ntoroko", "district", "UG-W"},
+	{"UG-425", "Rubirizi", "district", "UG-W"},
+	{"UG-426", "Sheema", "district", "UG-W"},
+	{"UG-427", "Kagadi", "district", "UG-W"},
+	{"UG-428", "Kakumiro", "district", "UG-W"},
+	{"UG-429", "Rubanda", "district", "UG-W"},
+	{"UG-430", "Bunyangabu", "district", "UG-W"},
+	{"UG-431", "Rukiga", "district", "UG-W"},
+	{"UG-432", "Kikuube", "district", "UG-W"},
+	{"UG-433", "Kazo", "district", "UG-W"},
+	{"UG-434", "Kitagwenda", "district", "UG-W"},
+	{"UG-435", "Rwampara", "district", "UG-W"},
+	{"UG-C", "Central", "geographical region", ""},
+	{"UG-E", "Eastern", "geographical region", ""},
+	{"UG-N", "Northern", "geographical region", ""},
+	{"UG-W", "Western", "geographical region", ""},
+	{"UM-67", "Johnston Atoll", "islands, groups of islands", ""},
+	{"UM-71", "Midway Islands", "islands, groups of islands", ""},
+	{"UM-76", "Navassa Island", "islands, groups of islands", ""},
+	{"UM-79", "Wake Island", "islands, groups of islands", ""},
+	{"UM-81", "Baker Island", "islands, groups of islands", ""},
+	{"UM-84", "Howland Island", "islands, groups of islands", ""},
+	{"UM-86", "Jarvis Island", "islands, groups of islands", ""},
+	{"UM-89", "Kingman Reef", "islands, groups of islands", ""},
+	{"UM-95", "Palmyra Atoll", "islands, groups of islands", ""},
+	{"US-AK", "Alaska", "state", ""},
+	{"US-AL", "Alabama", "state", ""},
+	{"US-AR", "Arkansas", "state", ""},
+	{"US-AS", "American Samoa", "outlying area", ""},
+	{"US-AZ", "Arizona", "state", ""},
+	{"US-CA", "California", "state", ""},
+	{"US-CO", "Colorado", "state", ""},
+	{"US-CT", "Connecticut", "state", ""},
+	{"US-DC", "District of Columbia", "district", ""},
+	{"US-DE", "Delaware", "state", ""},
+	{"US-FL", "Florida", "state", ""},
+	{"US-GA", "Georgia", "state", ""},
+	{"US-GU", "Guam", "outlying area", ""},
+	{"US-HI", "Hawaii", "state", ""},
+	{"US-IA", "Iowa", "state", ""},
+	{"US-ID", "Idaho", "state", ""},
+	{"US-IL", "Illinois", "state", ""},
+	{"US-IN", "Indiana", "state", ""},
+	{"US-KS", "Kansas", "state", ""},
+	{"US-KY", "Kentucky", "state", ""},
+	{"US-LA", "Louisiana", "state", ""},
+	{"US-MA", "Massachusetts", "state", ""},
+	{"US-MD", "Maryland", "state", ""},
+	{"US-ME", "Maine", "state", ""},
+	{"US-MI", "Michigan", "state", ""},
+	{"US-MN", "Minnesota", "state", ""},
+	{"US-MO", "Missouri", "state", ""},
+	{"US-MP", "Northern Mariana Islands", "outlying area", ""},
+	{"US-MS", "Mississippi", "state", ""},
+	{"US-MT", "Montana", "state", ""},
+	{"US-NC", "North Carolina", "state", ""},
+	{"US-ND", "North Dakota", "state", ""},
+	{"US-NE", "Nebraska", "state", ""},
+	{"US-NH", "New Hampshire", "state", ""},
+	{"US-NJ", "New Jersey", "state", ""},
+	{"US-NM", "New Mexico", "state", ""},
+	{"US-NV", "Nevada", "state", ""},
+	{"US-NY", "New York", "state", ""},
+	{"US-OH", "Ohio", "state", ""},
+	{"US-OK", "Oklahoma", "state", ""},
+	{"US-OR", "Oregon", "state", ""},
+	{"US-PA", "Pennsylvania", "state", ""},
+	{"US-PR", "Puerto Rico", "outlying area", ""},
+	{"US-RI", "Rhode Island", "state", ""},
+	{"US-SC", "South Carolina", "state", ""},
+	{"US-SD", "South Dakota", "state", ""},
+	{"US-TN", "Tennessee", "state", ""},
+	{"US-TX", "Texas", "state", ""},
+	{"US-UM", "United States Minor Outlying Islands", "outlying area", ""},
+	{"US-UT", "Utah", "state", ""},
+	{"US-VA", "Virginia", "state", ""},
+	{"US-VI", "Virgin Islands, U.S.", "outlying area", ""},
+	{"US-VT", "Vermont", "state", ""},
+	{"US-WA", "Washington", "state", ""},
+	{"US-WI", "Wisconsin", "state", ""},
+	{"US-WV", "West Virginia", "state", ""},
+	{"US-WY", "Wyoming", "state", ""},
+	{"UY-AR", "Artigas", "department", ""},
+	{"UY-CA", "Canelones", "department", ""},
+	{"UY-CL", "Cerro Largo", "department", ""},
+	{"UY-CO", "Colonia", "department", ""},
+	{"UY-DU", "Durazno", "department", ""},
+	{"UY-FD", "Florida", "department", ""},
+	{"UY-FS", "Flores", "department", ""},
+	{"UY-LA", "Lavalleja", "department", ""},
+	{"UY-MA", "Maldonado", "department", ""},
+	{"UY-MO", "Montevideo", "department", ""},
+	{"UY-PA", "Paysandú", "department", ""},
+	{"UY-RN", "Río Negro", "department", ""},
+	{"UY-RO", "Rocha", "department", ""},
+	{"UY-RV", "Rivera", "department", ""},
+	{"UY-SA", "Salto", "department", ""},
+	{"UY-SJ", "San José", "department", ""},
+	{"UY-SO", "Soriano", "department", ""},
+	{"UY-TA", "Tacuarembó", "department", ""},
+	{"UY-TT", "Treinta y Tres", "department", ""},
+	{"UZ-AN", "Andijon", "region", ""},
+	{"UZ-BU", "Buxoro", "region", ""},
+	{"UZ-FA", "Farg‘ona", "region", ""},
+	{"UZ-JI", "Jizzax", "region", ""},
+	{"UZ-NG", "Namangan", "region", ""},
+	{"UZ-NW", "Navoiy", "region", ""},
+	{"UZ-QA", "Qashqadaryo", "region", ""},
+	{"UZ-QR", "Qoraqalpog‘iston Respublikasi", "republic", ""},
+	{"UZ-SA", "Samarqand", "region", ""},
+	{"UZ-SI", "Sirdaryo", "region", ""},
+	{"UZ-SU", "Surxondaryo", "region", ""},
+	{"UZ-TK", "Toshkent", "city", ""},
+	{"UZ-TO", "Toshkent", "region", ""},
+	{"UZ-XO", "Xorazm", "region", ""},
+	{"VC-01", "Charlotte", "parish", ""},
+	{"VC-02", "Saint Andrew", "parish", ""},
+	{"VC-03", "Saint David", "parish", ""},
+	{"VC-04", "Saint George", "parish", ""},
+	{"VC-05", "Saint Patrick", "parish", ""},
+	{"VC-06", "Grenadines", "parish", ""},
+	{"VE-A", "Distrito Capital", "capital district", ""},
+	{"VE-B", "Anzoátegui", "state", ""},
+	{"VE-C", "Apure", "state", ""},
+	{"VE-D", "Aragua", "state", ""},
+	{"VE-E", "Barinas", "state", ""},
+	{"VE-F", "Bolívar", "state", ""},
+	{"VE-G", "Carabobo", "state", ""},
+	{"VE-H", "Cojedes", "state", ""},
+	{"VE-I", "Falcón", "state", ""},
+	{"VE-J", "Guárico", "state", ""},
+	{"VE-K", "Lara", "state", ""},
+	{"VE-L", "Mérida", "state", ""},
+	{"VE-M", "Miranda", "state", ""},
+	{"VE-N", "Monagas", "state", ""},
+	{"VE-O", "Nueva Esparta", "state", ""},
+	{"VE-P", "Portuguesa", "state", ""},
+	{"VE-R", "Sucre", "state", ""},
+	{"VE-S", "Táchira", "state", ""},
+	{"VE-T", "Trujillo", "state", ""},
+	{"VE-U", "Yaracuy", "state", ""},
+	{"VE-V", "Zulia", "state", ""},
+	{"VE-W", "Dependencias Federales", "federal dependency", ""},
+	{"VE-X", "La Guaira", "state", ""},
+	{"VE-Y", "Delta Amacuro", "state", ""},
+	{"VE-Z", "Amazonas", "state", ""},
+	{"VN-01", "Lai Châu", "province", ""},
+	{"VN-02", "Lào Cai", "province", ""},
+	{"VN-03", "Hà Giang", "province", ""},
+	{"VN-04", "Cao Bằng", "province", ""},
+	{"VN-05", "Sơn La", "province", ""},
+	{"VN-06", "Yên Bái", "province", ""},
+	{"VN-07", "Tuyên Quang", "province", ""},
+	{"VN-09", "Lạng Sơn", "province", ""},
+	{"VN-13", "Quảng Ninh", "province", ""},
+	{"VN-14", "Hòa Bình", "province", ""},
+	{"VN-18", "Ninh Bình", "province", ""},
+	{"VN-20", "Thái Bình", "province", ""},
+	{"VN-21", "Thanh Hóa", "province", ""},
+	{"VN-22", "Nghệ An", "province", ""},
+	{"VN-23", "Hà Tĩnh", "province", ""},
+	{"VN-24", "Quảng Bình", "province", ""},
+	{"VN-25", "Quảng Trị", "province", ""},
+	{"VN-26", "Thừa Thiên-Huế", "province", ""},
+	{"VN-27", "Quảng Nam", "province", ""},
+	{"VN-28", "Kon Tum", "province", ""},
+	{"VN-29", "Quảng Ngãi", "province", ""},
+	{"VN-30", "Gia Lai", "province", ""},
+	{"VN-31", "Bình Định", "province", ""},
+	{"VN-32", "Phú Yên", "province", ""},
+	{"VN-33", "Đắk Lắk", "province", ""},
+	{"VN-34", "Khánh Hòa", "province", ""},
+	{"VN-35", "Lâm Đồng", "province", ""},
+	{"VN-36", "Ninh Thuận", "province", ""},
+	{"VN-37", "Tây Ninh", "province", ""},
+	{"VN-39", "Đồng Nai", "province", ""},
+	{"VN-40", "Bình Thuận", "province", ""},
+	{"VN-41", "Long An", "province", ""},
+	{"VN-43", "Bà Rịa - Vũng Tàu", "province", ""},
+	{"VN-44", "An Giang", "province", ""},
+	{"VN-45", "Đồng Tháp", "province", ""},
+	{"VN-46", "Tiền Giang", "province", ""},
+	{"VN-47", "Kiến Giang", "province", ""},
+	{"VN-49", "Vĩnh Long", "province", ""},
+	{"VN-50", "Bến Tre", "province", ""},
+	{"VN-51", "Trà Vinh", "province", ""},
+	{"VN-52", "Sóc Trăng", "province", ""},
+	{"VN-53", "Bắc Kạn", "province", ""},
+	{"VN-54", "Bắc Giang", "province", ""},
+	{"VN-55", "Bạc Liêu", "province", ""},
+	{"VN-56", "Bắc Ninh", "province", ""},
+	{"VN-57", "Bình Dương", "province", ""},
+	{"VN-58", "Bình Phước", "province", ""},
+	{"VN-59", "Cà Mau", "province", ""},
+	{"VN-61", "Hải Dương", "province", ""},
+	{"VN-63", "Hà Nam", "province", ""},
+	{"VN-66", "Hưng Yên", "province", ""},
+	{"VN-67", "Nam Định", "province", ""},
+	{"VN-68", "Phú Thọ", "province", ""},
+	{"VN-69", "Thái Nguyên", "province", ""},
+	{"VN-70", "Vĩnh Phúc", "province", ""},
+	{"VN-71", "Điện Biên", "province", ""},
+	{"VN-72", "Đắk Nông", "province", ""},
+	{"VN-73", "Hậu Giang", "province", ""},
+	{"VN-CT", "Cần Thơ", "municipality", ""},
+	{"VN-DN", "Đà Nẵng", "municipality", ""},
+	{"VN-HN", "Hà Nội", "municipality", ""},
+	{"VN-HP", "Hải Phòng", "municipality", ""},
+	{"VN-SG", "Hồ Chí Minh", "municipality", ""},
+	{"VU-MAP", "Malampa", "province", ""},
+	{"VU-PAM", "Pénama", "province", ""},
+	{"VU-SAM", "Sanma", "province", ""},
+	{"VU-SEE", "Shéfa", "province", ""},
+	{"VU-TAE", "Taféa", "province", ""},
+	{"VU-TOB", "Torba", "province", ""},
+	{"WF-AL", "Alo", "administrative precinct", ""},
+	{"WF-SG", "Sigave", "administrative precinct", ""},
+	{"WF-UV", "Uvea", "administrative precinct", ""},
+	{"WS-AA", "A'ana", "district", ""},
+	{"WS-AL", "Aiga-i-le-Tai", "district", ""},
+	{"WS-AT", "Atua", "district", ""},
+	{"WS-FA", "Fa'asaleleaga", "district", ""},
+	{"WS-GE", "Gaga'emauga", "district", ""},
+	{"WS-GI", "Gagaifomauga", "district", ""},
+	{"WS-PA", "Palauli", "district", ""},
+	{"WS-SA", "Satupa'itea", "district", ""},
+	{"WS-TU", "Tuamasaga", "district", ""},
+	{"WS-VF", "Va'a-o-Fonoti", "district", ""},
+	{"WS-VS", "Vaisigano", "district", ""},
+	{"YE-AB", "Abyan", "governorate", ""},
+	{"YE-AD", "‘Adan", "governorate", ""},
+	{"YE-AM", "‘Amrān", "governorate", ""},
+	{"YE-BA", "Al Bayḑā’", "governorate", ""},
+	{"YE-DA", "Aḑ Ḑāli‘", "governorate", ""},
+	{"YE-DH", "Dhamār", "governorate", ""},
+	{"YE-HD", "Ḩaḑramawt", "governorate", ""},
+	{"YE-HJ", "Ḩajjah", "governorate", ""},
+	{"YE-HU", "Al Ḩudaydah", "governorate", ""},
+	{"YE-IB", "Ibb", "governorate", ""},
+	{"YE-JA", "Al Jawf", "governorate", ""},
+	{"YE-LA", "Laḩij", "governorate", ""},
+	{"YE-MA", "Ma’rib", "governorate", ""},
+	{"YE-MR", "Al Mahrah", "governorate", ""},
+	{"YE-MW", "Al Maḩwīt", "governorate", ""},
+	{"YE-RA", "Raymah", "governorate", ""},
+	{"YE-SA", "Amānat al ‘Āşimah [city]", "municipality", ""},
+	{"YE-SD", "Şāʻdah", "governorate", ""},
+	{"YE-SH", "Shabwah", "governorate", ""},
+	{"YE-SN", "Şanʻā’", "governorate", ""},
+	{"YE-SU", "Arkhabīl Suquţrá", "governorate", ""},
+	{"YE-TA", "Tāʻizz", "governorate", ""},
+	{"ZA-EC", "Eastern Cape", "province", ""},
+	{"ZA-FS", "Free State", "province", ""},
+	{"ZA-GP", "Gauteng", "province", ""},
+	{"ZA-KZN", "Kwazulu-Natal", "province", ""},
+	{"ZA-LP", "Limpopo", "province", ""},
+	{"ZA-MP", "Mpumalanga", "province", ""},
+	{"ZA-NC", "Northern Cape", "province", ""},
+	{"ZA-NW", "North-West", "province", ""},
+	{"ZA-WC", "Western Cape", "province", ""},
+	{"ZM-01", "Western", "province", ""},
+	{"ZM-02", "Central", "province", ""},
+	{"ZM-03", "Eastern", "province", ""},
+	{"ZM-04", "Luapula", "province", ""},
+	{"ZM-05", "Northern", "province", ""},
+	{"ZM-06", "North-Western", "province", ""},
+	{"ZM-07", "Southern", "province", ""},
+	{"ZM-08", "Copperbelt", "province", ""},
+	{"ZM-09", "Lusaka", "province", ""},
+	{"ZM-10", "Muchinga", "province", ""},
+	{"ZW-BU", "Bulawayo", "province", ""},
+	{"ZW-HA", "Harare", "province", ""},
+	{"ZW-MA", "Manicaland", "province", ""},
+	{"ZW-MC", "Mashonaland Central", "province", ""},
+	{"ZW-ME", "Mashonaland East", "province", ""},
+	{"ZW-MI", "Midlands", "province", ""},
+	{"ZW-MN", "Matabeleland North", "province", ""},
+	{"ZW-MS", "Matabeleland South", "province", ""},
+	{"ZW-MV", "Masvingo", "province", ""},
+	{"ZW-MW", "Mashonaland West", "province", ""},
+}
@@ -0,0 +1,94 @@
+package is
+
+import "strings"
+
+// TaxIDKind names the kind of tax identification number.
+type TaxIDKind string
+
+// Kinds of tax identification numbers returned by ParseTaxID
+const (
+	// TaxIDEIN is the US Employer Identification Number.
+	TaxIDEIN TaxIDKind = "EIN"
+	// TaxIDITIN is the US Individual Taxpayer Identification Number.
+	TaxIDITIN TaxIDKind = "ITIN"
+	// TaxIDABN is the Australian Business Number.
+	TaxIDABN TaxIDKind = "ABN"
+	// TaxIDTFN is the Australian Tax File Number.
+	TaxIDTFN TaxIDKind = "TFN"
+	// TaxIDGSTIN is the Indian Goods and Services Tax Identification Number.
+	TaxIDGSTIN TaxIDKind = "GSTIN"
+	// TaxIDPAN is the Indian Permanent Account Number.
+	TaxIDPAN TaxIDKind = "PAN"
+	// TaxIDCNPJ is the Brazilian Cadastro Nacional da Pessoa Jurídica.
+	TaxIDCNPJ TaxIDKind = "CNPJ"
+)
+
+// TaxIDParts holds a parsed tax identification number.
+type TaxIDParts struct {
+	// CountryCode is the ISO 3166-1 alpha-2 code of the issuing country.
+	CountryCode string
+	Kind        TaxIDKind
+	// Number is the identifier without separators.
+	Number string
+}
+
+// taxIDRule checks a kind of tax identification number with separators removed.
+// check returns empty Reason on success.
+type taxIDRule struct {
+	kind  TaxIDKind
+	check func(n string) Reason
+}
+
+// taxIDRules maps ISO 3166-1 alpha-2 codes to rules tried in order.
+// Rules of each country live in taxid_<country>.go files.
+var taxIDRules = map[string][]taxIDRule{
+	"AU": {{TaxIDABN, checkABN}, {TaxIDTFN, checkTFN}},
+	"BR": {{TaxIDCNPJ, checkCNPJ}},
+	"IN": {{TaxIDGSTIN, checkGSTIN}, {TaxIDPAN, checkPAN}},
+	// ITIN ranges are narrower than EIN ones, so it goes first
+	"US": {{TaxIDITIN, checkITIN}, {TaxIDEIN, checkEIN}},
+}
+
+// TaxID check if the string is a tax identification number of the country
+// given by its ISO 3166-1 alpha-2 code. Supported are US EIN and ITIN,
+// Australian ABN and TFN, Indian GSTIN and PAN and Brazilian CNPJ.
+// Spaces, hyphens, dots and slashes are ignored.
+func TaxID(country, s string) bool {
+	_, err := ParseTaxID(country, s)
+	return err == nil
+}
+
+// ParseTaxID validates the string as tax identification number of the country
+// and tells which kind of number it is.
+// On failure the error is a *ValidationError. Unsupported countries are reported as ReasonFormat.
+// If no kind matches, the reason comes from the first kind of matching length.
+func ParseTaxID(country, s string) (TaxIDParts, error) {
+	rules, ok := taxIDRules[country]
+	if !ok || !ISO3166Alpha2(country) {
+		return TaxIDParts{}, validationError("TaxID", ReasonFormat, -1)
+	}
+
+	n := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '.' || r == '/' {
+			return -1
+		}
+		return r
+	}, s)
+
+	if len(n) == 0 {
+		return TaxIDParts{}, validationError("TaxID", ReasonEmpty, -1)
+	}
+
+	reason := ReasonLength
+	for _, r := range rules {
+		res := r.check(n)
+		if res == "" {
+			return TaxIDParts{CountryCode: country, Kind: r.kind, Number: n}, nil
+		}
+		if reason == ReasonLength {
+			reason = res
+		}
+	}
+
+	return TaxIDParts{}, validationError("TaxID", reason, -1)
+}
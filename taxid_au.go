@@ -0,0 +1,44 @@
+package is
+
+// checkABN checks Australian Business Number: 11 digits, which after subtracting 1
+// from the first one have weighted sum divisible by 89.
+// See: https://abr.business.gov.au/Help/AbnFormat
+func checkABN(n string) Reason {
+	switch {
+	case len(n) != 11:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case n[0] == '0':
+		return ReasonFormat
+	}
+
+	sum := weightedSum(n, 10, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19) - 10
+	if sum%89 != 0 {
+		return ReasonChecksum
+	}
+
+	return ""
+}
+
+// checkTFN checks Australian Tax File Number: 8 or 9 digits with weighted sum divisible by 11.
+func checkTFN(n string) Reason {
+	if len(n) != 8 && len(n) != 9 {
+		return ReasonLength
+	}
+
+	if !Numeric(n) {
+		return ReasonCharacter
+	}
+
+	sum := weightedSum(n, 1, 4, 3, 7, 5, 8, 6, 9, 10)
+	if len(n) == 8 {
+		sum = weightedSum(n, 10, 7, 8, 4, 6, 3, 5, 1)
+	}
+
+	if sum%11 != 0 {
+		return ReasonChecksum
+	}
+
+	return ""
+}
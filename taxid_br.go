@@ -0,0 +1,44 @@
+package is
+
+import "strings"
+
+// checkCNPJ checks Brazilian Cadastro Nacional da Pessoa Jurídica: 12 characters and 2 check digits.
+// Since July 2026 the first 12 characters may be upper case letters, each character
+// being worth its ASCII code minus 48 in the check digit computation.
+// See: https://www.gov.br/receitafederal/pt-br/acesso-a-informacao/acoes-e-programas/programas-e-atividades/cnpj-alfanumerico
+func checkCNPJ(n string) Reason {
+	if len(n) != 14 {
+		return ReasonLength
+	}
+
+	for i := 0; i < len(n); i++ {
+		digit := '0' <= n[i] && n[i] <= '9'
+		if !digit && (i >= 12 || n[i] < 'A' || 'Z' < n[i]) {
+			return ReasonCharacter
+		}
+	}
+
+	if n == strings.Repeat(n[:1], len(n)) {
+		return ReasonReserved
+	}
+
+	if cnpjCheckDigit(n[:12]) != n[12] || cnpjCheckDigit(n[:13]) != n[13] {
+		return ReasonChecksum
+	}
+
+	return ""
+}
+
+// cnpjCheckDigit computes the mod 11 check digit with weights 2 to 9 repeated from the right.
+func cnpjCheckDigit(s string) byte {
+	var sum int
+	for i := len(s) - 1; i >= 0; i-- {
+		sum += int(s[i]-'0') * ((len(s)-1-i)%8 + 2)
+	}
+
+	if r := sum % 11; r >= 2 {
+		return byte('0' + 11 - r)
+	}
+
+	return '0'
+}
@@ -0,0 +1,54 @@
+package is
+
+import "strings"
+
+// checkPAN checks Indian Permanent Account Number: 5 letters, 4 digits and a letter,
+// where the fourth letter is the type of the holder, e.g. "P" for individuals.
+func checkPAN(n string) Reason {
+	switch {
+	case len(n) != 10:
+		return ReasonLength
+	case !Alpha(n[:5]) || !Numeric(n[5:9]) || !Alpha(n[9:]) || !UpperCase(n):
+		return ReasonCharacter
+	case strings.IndexByte("ABCFGHJLPT", n[3]) < 0:
+		return ReasonFormat
+	}
+
+	return ""
+}
+
+// checkGSTIN checks Indian Goods and Services Tax Identification Number:
+// 2 digit state code, PAN of the taxpayer, registration number within the state,
+// a letter (usually "Z") and a base 36 check character.
+// See: https://www.gstn.org.in
+func checkGSTIN(n string) Reason {
+	if len(n) != 15 {
+		return ReasonLength
+	}
+
+	if !Numeric(n[:2]) || !Alphanumeric(n[12:]) || !UpperCase(n) {
+		return ReasonCharacter
+	}
+
+	state := n[:2]
+	if ("01" > state || state > "38") && state != "97" && state != "99" {
+		return ReasonReserved
+	}
+
+	if res := checkPAN(n[2:12]); res != "" {
+		return res
+	}
+
+	const chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	var sum int
+	for i := 0; i < 14; i++ {
+		p := strings.IndexByte(chars, n[i]) * (i%2 + 1)
+		sum += p/36 + p%36
+	}
+
+	if chars[(36-sum%36)%36] != n[14] {
+		return ReasonChecksum
+	}
+
+	return ""
+}
@@ -0,0 +1,76 @@
+package is
+
+import "testing"
+
+func TestTaxID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		country  string
+		param    string
+		expected bool
+	}{
+		{"US", "", false},
+		{"US", "12-3456789", true},
+		{"US", "123456789", true},
+		{"US", "07-3456789", false},
+		{"US", "96-3456789", false},
+		{"US", "12-345678", false},
+		{"US", "912-70-1234", true},
+		{"US", "970-93-1234", false},
+		{"US", "12-345678A", false},
+		{"AU", "51 824 753 556", true},
+		{"AU", "51 824 753 557", false},
+		{"AU", "123 456 782", true},
+		{"AU", "123 456 781", false},
+		{"IN", "ABCPE1234F", true},
+		{"IN", "ABCXE1234F", false},
+		{"IN", "abcpe1234f", false},
+		{"IN", "27AAPFU0939F1ZV", true},
+		{"IN", "27AAPFU0939F1ZW", false},
+		{"IN", "40AAPFU0939F1ZV", false},
+		{"BR", "11.222.333/0001-81", true},
+		{"BR", "11222333000181", true},
+		{"BR", "11.222.333/0001-82", false},
+		{"BR", "00.000.000/0000-00", false},
+		{"BR", "12.ABC.345/01DE-35", true},
+		{"BR", "12.ABC.345/01DE-3A", false},
+		{"DE", "12-3456789", false},
+		{"XX", "12-3456789", false},
+	}
+	for _, test := range tests {
+		actual := TaxID(test.country, test.param)
+		if actual != test.expected {
+			t.Errorf("Expected TaxID(%q, %q) to be %v, got %v", test.country, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseTaxID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		country  string
+		param    string
+		expected TaxIDParts
+	}{
+		{"US", "12-3456789", TaxIDParts{"US", TaxIDEIN, "123456789"}},
+		{"US", "912-70-1234", TaxIDParts{"US", TaxIDITIN, "912701234"}},
+		{"AU", "51 824 753 556", TaxIDParts{"AU", TaxIDABN, "51824753556"}},
+		{"AU", "123 456 782", TaxIDParts{"AU", TaxIDTFN, "123456782"}},
+		{"IN", "27AAPFU0939F1ZV", TaxIDParts{"IN", TaxIDGSTIN, "27AAPFU0939F1ZV"}},
+		{"IN", "ABCPE1234F", TaxIDParts{"IN", TaxIDPAN, "ABCPE1234F"}},
+		{"BR", "11.222.333/0001-81", TaxIDParts{"BR", TaxIDCNPJ, "11222333000181"}},
+	}
+	for _, test := range tests {
+		actual, err := ParseTaxID(test.country, test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseTaxID(%q, %q) to be %+v, got %+v, %v", test.country, test.param, test.expected, actual, err)
+		}
+	}
+
+	_, err := ParseTaxID("AU", "51 824 753 557")
+	if verr, ok := err.(*ValidationError); !ok || verr.Reason != ReasonChecksum {
+		t.Errorf("Expected ParseTaxID to fail with %s, got %v", ReasonChecksum, err)
+	}
+}
@@ -0,0 +1,51 @@
+package is
+
+// einPrefixes holds EIN prefixes assigned to IRS campuses and online applications.
+// See: https://www.irs.gov/businesses/small-businesses-self-employed/how-eins-are-assigned-and-valid-ein-prefixes
+var einPrefixes = map[string]bool{
+	"01": true, "02": true, "03": true, "04": true, "05": true, "06": true,
+	"10": true, "11": true, "12": true, "13": true, "14": true, "15": true, "16": true,
+	"20": true, "21": true, "22": true, "23": true, "24": true, "25": true, "26": true, "27": true,
+	"30": true, "31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "37": true, "38": true, "39": true,
+	"40": true, "41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true,
+	"50": true, "51": true, "52": true, "53": true, "54": true, "55": true, "56": true, "57": true, "58": true, "59": true,
+	"60": true, "61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true,
+	"71": true, "72": true, "73": true, "74": true, "75": true, "76": true, "77": true,
+	"80": true, "81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true,
+	"90": true, "91": true, "92": true, "93": true, "94": true, "95": true, "98": true, "99": true,
+}
+
+// checkEIN checks US Employer Identification Number: 9 digits starting with a valid campus prefix.
+func checkEIN(n string) Reason {
+	switch {
+	case len(n) != 9:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case !einPrefixes[n[:2]]:
+		return ReasonReserved
+	}
+
+	return ""
+}
+
+// checkITIN checks US Individual Taxpayer Identification Number: 9 digits starting with 9
+// and having the middle group in 50-65, 70-88, 90-92 or 94-99.
+// See: https://www.irs.gov/individuals/individual-taxpayer-identification-number
+func checkITIN(n string) Reason {
+	switch {
+	case len(n) != 9:
+		return ReasonLength
+	case !Numeric(n):
+		return ReasonCharacter
+	case n[0] != '9':
+		return ReasonFormat
+	}
+
+	g := n[3:5]
+	if ("50" <= g && g <= "65") || ("70" <= g && g <= "88") || ("90" <= g && g <= "92") || "94" <= g {
+		return ""
+	}
+
+	return ReasonReserved
+}
@@ -0,0 +1,589 @@
+package is
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TagName is the struct tag key read by Validate.
+const TagName = "is"
+
+// FieldError describes a single struct field which failed a tag rule.
+type FieldError struct {
+	// Field is the path to the field, e.g. "Users[3].Email" or "Links[\"home\"]".
+	// Map keys are formatted like Go literals, so string keys are quoted.
+	Field string
+	// Rule is the tag keyword which failed, e.g. "email".
+	Rule string
+	// Value is the value of the field.
+	Value interface{}
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": failed " + e.Rule + " rule"
+}
+
+// FieldErrors is returned by Validate and holds every failing field.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	b := bytes.NewBuffer(nil)
+	for i, fe := range e {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(fe.Error())
+	}
+	return b.String()
+}
+
+// validators maps tag keywords to string predicates.
+var validators = map[string]func(string) bool{
+	"email":               Email,
+	"url":                 URL,
+	"requesturl":          RequestURL,
+	"requesturi":          RequestURI,
+	"alpha":               Alpha,
+	"utfletter":           UTFLetter,
+	"alphanumeric":        Alphanumeric,
+	"utfletternumeric":    UTFLetterNumeric,
+	"numeric":             Numeric,
+	"utfnumeric":          UTFNumeric,
+	"utfdigit":            UTFDigit,
+	"hexadecimal":         Hexadecimal,
+	"hexcolor":            Hexcolor,
+	"rgbcolor":            RGBcolor,
+	"lowercase":           LowerCase,
+	"uppercase":           UpperCase,
+	"int":                 Int,
+	"float":               Float,
+	"uuid":                UUID,
+	"uuidv3":              UUIDv3,
+	"uuidv4":              UUIDv4,
+	"uuidv5":              UUIDv5,
+	"json":                JSON,
+	"multibyte":           Multibyte,
+	"ascii":               ASCII,
+	"printableascii":      PrintableASCII,
+	"fullwidth":           FullWidth,
+	"halfwidth":           HalfWidth,
+	"variablewidth":       VariableWidth,
+	"base64":              Base64,
+	"datauri":             DataURI,
+	"iso3166alpha2":       ISO3166Alpha2,
+	"iso3166alpha3":       ISO3166Alpha3,
+	"iso3166numeric":      ISO3166Numeric,
+	"iso3166subdivision":  ISO3166Subdivision,
+	"iso4217":             ISO4217,
+	"iso4217numeric":      ISO4217Numeric,
+	"languagetag":         LanguageTag,
+	"vatid":               VATID,
+	"dnsname":             DNSName,
+	"dialstring":          DialString,
+	"ip":                  IP,
+	"port":                Port,
+	"ipv4":                IPv4,
+	"ipv6":                IPv6,
+	"mac":                 MAC,
+	"mongoid":             MongoID,
+	"latitude":            Latitude,
+	"longitude":           Longitude,
+	"ssn":                 SSN,
+	"semver":              Semver,
+	"isbn10":              ISBN10,
+	"isbn13":              ISBN13,
+	"creditcard":          CreditCard,
+	"visacard":            VisaCard,
+	"mastercard":          MasterCard,
+	"americanexpresscard": AmericanExpressCard,
+	"dinersclubcard":      DinersClubCard,
+	"discovercard":        DiscoverCard,
+	"jcbcard":             JCBCard,
+	"unionpaycard":        UnionPayCard,
+	"maestrocard":         MaestroCard,
+	"mircard":             MirCard,
+	"rupaycard":           RuPayCard,
+	"elocard":             EloCard,
+	"hipercardcard":       HipercardCard,
+	"troycard":            TroyCard,
+	"vervecard":           VerveCard,
+	"iban":                IBAN,
+	"bic":                 BIC,
+	"gtin":                GTIN,
+	"ean8":                EAN8,
+	"ean13":               EAN13,
+	"upca":                UPCA,
+	"upce":                UPCE,
+	"gtin14":              GTIN14,
+	"issn":                ISSN,
+	"ismn":                ISMN,
+}
+
+// paramValidators maps tag keywords which take "|" separated arguments
+// (e.g. "bytelength=1|255") to predicates.
+var paramValidators = map[string]func(v reflect.Value, args []string) (bool, error){
+	"stringlength": func(v reflect.Value, args []string) (bool, error) {
+		min, max, err := intArgs(args)
+		if err != nil {
+			return false, err
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return StringLength(s, min, max), nil
+	},
+	"bytelength": func(v reflect.Value, args []string) (bool, error) {
+		min, max, err := intArgs(args)
+		if err != nil {
+			return false, err
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return ByteLength(s, min, max), nil
+	},
+	"inrange": func(v reflect.Value, args []string) (bool, error) {
+		if len(args) != 2 {
+			return false, errArgsCount
+		}
+		left, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			return false, err
+		}
+		right, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return false, err
+		}
+		return numericRule(v, func(f float64) bool {
+			return InRange(f, left, right)
+		})
+	},
+	"whole": func(v reflect.Value, args []string) (bool, error) {
+		return numericRule(v, Whole)
+	},
+	"natural": func(v reflect.Value, args []string) (bool, error) {
+		return numericRule(v, Natural)
+	},
+	"moneyamount": func(v reflect.Value, args []string) (bool, error) {
+		if len(args) != 1 {
+			return false, errArgsCount
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return MoneyAmount(s, args[0]), nil
+	},
+	"phone": func(v reflect.Value, args []string) (bool, error) {
+		if len(args) > 1 {
+			return false, errArgsCount
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return Phone(s, strings.Join(args, "")), nil
+	},
+	"postalcode": func(v reflect.Value, args []string) (bool, error) {
+		if len(args) != 1 {
+			return false, errArgsCount
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return PostalCode(args[0], s), nil
+	},
+	"taxid": func(v reflect.Value, args []string) (bool, error) {
+		if len(args) != 1 {
+			return false, errArgsCount
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return TaxID(args[0], s), nil
+	},
+	"nationalid": func(v reflect.Value, args []string) (bool, error) {
+		if len(args) != 1 {
+			return false, errArgsCount
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return NationalID(args[0], s), nil
+	},
+	"isbn": func(v reflect.Value, args []string) (bool, error) {
+		version := -1
+		if len(args) > 1 {
+			return false, errArgsCount
+		}
+		if len(args) == 1 {
+			var err error
+			if version, err = strconv.Atoi(args[0]); err != nil {
+				return false, err
+			}
+		}
+		s, ok := stringOf(v)
+		if !ok {
+			return false, errUnsupportedKind
+		}
+		return ISBN(s, version), nil
+	},
+}
+
+var (
+	errUnsupportedKind = errors.New("unsupported field kind")
+	errArgsCount       = errors.New("wrong number of arguments")
+)
+
+// Validate walks the struct v (or pointer to struct) and checks every field
+// against the rules listed in its "is" tag, e.g.
+//
+//	type User struct {
+//		Email string `is:"required,email,bytelength=1|255"`
+//	}
+//
+// Each keyword is the lowercased name of a function of this package.
+// Arguments follow "=" and are separated by "|".
+// The "required" keyword fails on zero values, empty slices and maps, and nil pointers.
+// Empty strings, slices and maps and nil pointers without "required" are not checked
+// against other rules, zero numbers are, e.g. "inrange=1|10" fails on 0.
+// Rules on slices, arrays and maps are applied to their elements.
+// Nested structs are validated recursively, pointers back to a struct
+// which is being validated are skipped.
+//
+// If some fields fail, the returned error is FieldErrors listing all of them.
+// Malformed tags and unknown keywords produce a plain error.
+func Validate(v interface{}) error {
+	vd := &validation{visiting: make(map[visitKey]bool)}
+	rv, _, _ := vd.indirect(reflect.ValueOf(v))
+	switch {
+	case !rv.IsValid(), (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil():
+		return errors.New("is: Validate called with nil value")
+	case rv.Kind() != reflect.Struct:
+		return fmt.Errorf("is: Validate called with non-struct %s", rv.Kind())
+	}
+
+	if err := vd.validateStruct(rv, ""); err != nil {
+		return err
+	}
+	if len(vd.errs) > 0 {
+		return vd.errs
+	}
+	return nil
+}
+
+// validation holds the state of a single Validate call.
+type validation struct {
+	errs FieldErrors
+	// visiting holds pointers followed on the way from the root to the current field.
+	visiting map[visitKey]bool
+}
+
+// visitKey identifies a pointer, the type is needed since a struct
+// and its first field share the address.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// indirect follows pointers and interfaces until a nil or a non-pointer value
+// and marks the pointers as visited. It returns a function unmarking them
+// and false if v leads to a value which is already being validated.
+func (vd *validation) indirect(v reflect.Value) (reflect.Value, func(), bool) {
+	var keys []visitKey
+	leave := func() {
+		for _, k := range keys {
+			delete(vd.visiting, k)
+		}
+	}
+
+	for (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil() {
+		if v.Kind() == reflect.Ptr {
+			k := visitKey{v.Pointer(), v.Type()}
+			if vd.visiting[k] {
+				return v, leave, false
+			}
+			vd.visiting[k] = true
+			keys = append(keys, k)
+		}
+		v = v.Elem()
+	}
+
+	return v, leave, true
+}
+
+type tagRule struct {
+	name string
+	args []string
+}
+
+func parseTag(tag string) []tagRule {
+	var rules []tagRule
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		r := tagRule{name: part}
+		if i := strings.IndexByte(part, '='); i >= 0 {
+			r.name = part[:i]
+			r.args = strings.Split(part[i+1:], "|")
+		}
+		r.name = strings.ToLower(r.name)
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+func (vd *validation) validateStruct(v reflect.Value, path string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		// skip unexported fields
+		if f.PkgPath != "" {
+			continue
+		}
+
+		tag := f.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+
+		name := f.Name
+		if path != "" {
+			name = path + "." + name
+		}
+
+		if err := vd.validateField(v.Field(i), name, parseTag(tag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (vd *validation) validateField(v reflect.Value, path string, rules []tagRule) error {
+	var required bool
+	for _, r := range rules {
+		if r.name == "required" {
+			required = true
+		}
+	}
+
+	v, leave, ok := vd.indirect(v)
+	defer leave()
+	if !ok {
+		return nil
+	}
+
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		if required {
+			vd.errs = append(vd.errs, &FieldError{Field: path, Rule: "required"})
+		}
+		return nil
+	}
+
+	if required && isZero(v) {
+		vd.errs = append(vd.errs, &FieldError{Field: path, Rule: "required", Value: v.Interface()})
+		return nil
+	}
+	if isEmpty(v) {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			// []byte is checked as a whole
+			break
+		}
+		elemRules := withoutRequired(rules)
+		for i := 0; i < v.Len(); i++ {
+			if err := vd.validateElem(v.Index(i), path+"["+strconv.Itoa(i)+"]", elemRules); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		// iterate keys in sorted order to report errors deterministically
+		keys := make(mapKeys, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, mapKey{mapKeyString(k), k})
+		}
+		sort.Stable(keys)
+
+		elemRules := withoutRequired(rules)
+		for _, k := range keys {
+			if err := vd.validateElem(v.MapIndex(k.value), path+"["+k.name+"]", elemRules); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		if err := vd.applyRules(v, path, rules); err != nil {
+			return err
+		}
+		return vd.validateStruct(v, path)
+	}
+
+	return vd.applyRules(v, path, rules)
+}
+
+func (vd *validation) validateElem(v reflect.Value, path string, rules []tagRule) error {
+	v, leave, ok := vd.indirect(v)
+	defer leave()
+	if !ok || (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return nil
+	}
+	if v.Kind() == reflect.Struct {
+		return vd.validateStruct(v, path)
+	}
+	return vd.validateField(v, path, rules)
+}
+
+func (vd *validation) applyRules(v reflect.Value, path string, rules []tagRule) error {
+	for _, r := range rules {
+		if r.name == "required" {
+			continue
+		}
+
+		var ok bool
+		if fn, found := validators[r.name]; found {
+			if len(r.args) != 0 {
+				return fmt.Errorf("is: rule %q on field %s does not take arguments", r.name, path)
+			}
+			s, isString := stringOf(v)
+			if !isString {
+				return fmt.Errorf("is: rule %q on field %s: %v %s", r.name, path, errUnsupportedKind, v.Kind())
+			}
+			ok = fn(s)
+		} else if fn, found := paramValidators[r.name]; found {
+			var err error
+			if ok, err = fn(v, r.args); err != nil {
+				return fmt.Errorf("is: rule %q on field %s: %v", r.name, path, err)
+			}
+		} else {
+			return fmt.Errorf("is: unknown rule %q on field %s", r.name, path)
+		}
+
+		if !ok {
+			vd.errs = append(vd.errs, &FieldError{Field: path, Rule: r.name, Value: v.Interface()})
+		}
+	}
+	return nil
+}
+
+func withoutRequired(rules []tagRule) []tagRule {
+	var res []tagRule
+	for _, r := range rules {
+		if r.name != "required" {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// mapKey is a map key along with its representation in field paths.
+type mapKey struct {
+	name  string
+	value reflect.Value
+}
+
+// mapKeys sorts map keys by their representation.
+type mapKeys []mapKey
+
+func (k mapKeys) Len() int           { return len(k) }
+func (k mapKeys) Less(i, j int) bool { return k[i].name < k[j].name }
+func (k mapKeys) Swap(i, j int)      { k[i], k[j] = k[j], k[i] }
+
+// mapKeyString formats the map key like a Go literal, so that keys of
+// different types (e.g. 1 and "1" in map[interface{}]T) are told apart.
+func mapKeyString(k reflect.Value) string {
+	if k.Kind() == reflect.Interface && !k.IsNil() {
+		k = k.Elem()
+	}
+	if k.Kind() == reflect.String {
+		return strconv.Quote(k.String())
+	}
+	return fmt.Sprintf("%#v", k.Interface())
+}
+
+// isEmpty reports whether v holds nothing to check rules against.
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// isZero reports whether v fails the "required" rule.
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	}
+	return isEmpty(v)
+}
+
+func stringOf(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes()), true
+		}
+	}
+	return "", false
+}
+
+// numericRule applies fn to numeric fields and to strings holding a number.
+// Strings which are not numbers fail the rule.
+func numericRule(v reflect.Value, fn func(float64) bool) (bool, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fn(float64(v.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return fn(float64(v.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		return fn(v.Float()), nil
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return false, nil
+		}
+		return fn(f), nil
+	}
+	return false, errUnsupportedKind
+}
+
+func intArgs(args []string) (int, int, error) {
+	if len(args) != 2 {
+		return 0, 0, errArgsCount
+	}
+	min, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err := strconv.Atoi(args[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return min, max, nil
+}
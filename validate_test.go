@@ -0,0 +1,188 @@
+package is
+
+import "testing"
+
+type validateAddress struct {
+	Country string `is:"required,iso3166alpha2"`
+	Lat     string `is:"latitude"`
+}
+
+type validateUser struct {
+	Email   string            `is:"required,email,bytelength=1|255"`
+	Name    string            `is:"stringlength=2|10"`
+	Age     int               `is:"inrange=0|150"`
+	ID      *string           `is:"required,uuid"`
+	Tags    []string          `is:"alpha"`
+	Links   map[string]string `is:"url"`
+	Address validateAddress
+	Backup  *validateAddress
+	Ignored string `is:"-"`
+	ignored string `is:"email"`
+}
+
+type validateGroup struct {
+	Users []validateUser `is:"required"`
+}
+
+type validateNode struct {
+	Name  string `is:"required,alpha"`
+	Count int    `is:"inrange=1|10"`
+	Next  *validateNode
+	Meta  map[interface{}]string `is:"numeric"`
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	id := "a987fbc9-4bed-3078-cf07-9141ba07c9f3"
+	badID := "foo"
+
+	var tests = []struct {
+		param    interface{}
+		expected []string
+	}{
+		{
+			validateUser{Email: "foo@bar.com", ID: &id, Address: validateAddress{Country: "US"}},
+			nil,
+		},
+		{
+			&validateUser{
+				Email:   "foo@bar.com",
+				Name:    "John",
+				Age:     42,
+				ID:      &id,
+				Tags:    []string{"abc", "def"},
+				Links:   map[string]string{"home": "http://example.com"},
+				Address: validateAddress{Country: "US", Lat: "-12.3"},
+				Backup:  &validateAddress{Country: "DE"},
+				Ignored: "foo",
+				ignored: "foo",
+			},
+			nil,
+		},
+		{
+			validateUser{},
+			[]string{"Email:required", "ID:required", "Address.Country:required"},
+		},
+		{
+			validateUser{
+				Email:   "foo",
+				Name:    "J",
+				Age:     200,
+				ID:      &badID,
+				Tags:    []string{"abc", "d3f"},
+				Links:   map[string]string{"b": "foo", "a": "http://example.com", "c": "bar"},
+				Address: validateAddress{Country: "XX", Lat: "100"},
+				Backup:  &validateAddress{},
+			},
+			[]string{
+				"Email:email",
+				"Name:stringlength",
+				"Age:inrange",
+				"ID:uuid",
+				"Tags[1]:alpha",
+				`Links["b"]:url`,
+				`Links["c"]:url`,
+				"Address.Country:iso3166alpha2",
+				"Address.Lat:latitude",
+				"Backup.Country:required",
+			},
+		},
+		{
+			validateGroup{},
+			[]string{"Users:required"},
+		},
+		{
+			validateGroup{Users: []validateUser{
+				{Email: "foo@bar.com", ID: &id, Address: validateAddress{Country: "US"}},
+				{Email: "foo", ID: &id, Address: validateAddress{Country: "US"}},
+			}},
+			[]string{"Users[1].Email:email"},
+		},
+		{
+			validateNode{Name: "a", Count: 1, Meta: map[interface{}]string{1: "x", "1": "y", 2: "3"}},
+			[]string{`Meta["1"]:numeric`, "Meta[1]:numeric"},
+		},
+		{
+			validateNode{Name: "a"},
+			[]string{"Count:inrange"},
+		},
+		{
+			cyclicNode(),
+			[]string{"Next.Name:alpha"},
+		},
+	}
+	for _, test := range tests {
+		err := Validate(test.param)
+		if len(test.expected) == 0 {
+			if err != nil {
+				t.Errorf("Expected Validate(%+v) to be nil, got %v", test.param, err)
+			}
+			continue
+		}
+
+		errs, ok := err.(FieldErrors)
+		if !ok {
+			t.Errorf("Expected Validate(%+v) to return FieldErrors, got %v", test.param, err)
+			continue
+		}
+
+		var actual []string
+		for _, fe := range errs {
+			actual = append(actual, fe.Field+":"+fe.Rule)
+		}
+		if len(actual) != len(test.expected) {
+			t.Errorf("Expected Validate(%+v) to fail %v, got %v", test.param, test.expected, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != test.expected[i] {
+				t.Errorf("Expected Validate(%+v) to fail %v, got %v", test.param, test.expected, actual)
+				break
+			}
+		}
+	}
+}
+
+func cyclicNode() *validateNode {
+	a := &validateNode{Name: "a", Count: 1}
+	a.Next = &validateNode{Name: "b1", Count: 1, Next: a}
+	return a
+}
+
+func TestValidateMisuse(t *testing.T) {
+	t.Parallel()
+
+	var nilUser *validateUser
+
+	var tests = []interface{}{
+		nil,
+		nilUser,
+		"foo",
+		struct {
+			A string `is:"foobar"`
+		}{"a"},
+		struct {
+			A string `is:"email=1"`
+		}{"a"},
+		struct {
+			A int `is:"email"`
+		}{1},
+		struct {
+			A string `is:"bytelength=1"`
+		}{"a"},
+		struct {
+			A string `is:"inrange=a|b"`
+		}{"1"},
+	}
+	for _, test := range tests {
+		err := Validate(test)
+		if err == nil {
+			t.Errorf("Expected Validate(%#v) to return error", test)
+			continue
+		}
+		if _, ok := err.(FieldErrors); ok {
+			t.Errorf("Expected Validate(%#v) to return plain error, got %v", test, err)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package is
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bbrodriges/is/checkdigit"
+)
+
+// VATIDParts holds components of a VAT identification number.
+type VATIDParts struct {
+	// Prefix is the two-letter prefix of the number, e.g. "EL" for Greece.
+	Prefix string
+	// CountryCode is the ISO 3166-1 alpha-2 code of the issuing country, e.g. "GR".
+	CountryCode string
+	// Number is the national part of the number.
+	Number string
+}
+
+// vatRule describes VAT identification numbers issued under a prefix.
+type vatRule struct {
+	country string
+	lengths []int
+	// check validates the national part which has one of the lengths.
+	check func(n string) bool
+}
+
+// vatRules maps VAT prefixes of EU member states and Northern Ireland to their rules.
+// Prefixes are ISO 3166-1 alpha-2 codes, except for "EL" for Greece and "XI" for Northern Ireland.
+// See: https://ec.europa.eu/taxation_customs/vies/
+var vatRules = map[string]vatRule{
+	"AT": {"AT", []int{9}, vatAT},
+	"BE": {"BE", []int{10}, vatBE},
+	"BG": {"BG", []int{9, 10}, vatBG},
+	"CY": {"CY", []int{9}, vatCY},
+	"CZ": {"CZ", []int{8, 9, 10}, vatCZ},
+	"DE": {"DE", []int{9}, func(n string) bool { return Numeric(n) && n[0] != '0' && checkdigit.ISO7064Mod11Hybrid10.Verify(n) }},
+	"DK": {"DK", []int{8}, func(n string) bool {
+		return Numeric(n) && n[0] != '0' && weightedSum(n, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+	}},
+	"EE": {"EE", []int{9}, vatEE},
+	"EL": {"GR", []int{9}, vatEL},
+	"ES": {"ES", []int{9}, vatES},
+	"FI": {"FI", []int{8}, vatFI},
+	"FR": {"FR", []int{11}, vatFR},
+	"HR": {"HR", []int{11}, func(n string) bool { return Numeric(n) && checkdigit.ISO7064Mod11Hybrid10.Verify(n) }},
+	"HU": {"HU", []int{8}, vatHU},
+	"IE": {"IE", []int{8, 9}, vatIE},
+	"IT": {"IT", []int{11}, func(n string) bool { return Numeric(n) && n[:7] != "0000000" && checkdigit.Luhn.Verify(n) }},
+	"LT": {"LT", []int{9, 12}, vatLT},
+	"LU": {"LU", []int{8}, vatLU},
+	"LV": {"LV", []int{11}, vatLV},
+	"MT": {"MT", []int{8}, vatMT},
+	"NL": {"NL", []int{12}, vatNL},
+	"PL": {"PL", []int{10}, vatPL},
+	"PT": {"PT", []int{9}, vatPT},
+	"RO": {"RO", []int{2, 3, 4, 5, 6, 7, 8, 9, 10}, vatRO},
+	"SE": {"SE", []int{12}, func(n string) bool { return Numeric(n) && n[10:] == "01" && checkdigit.Luhn.Verify(n[:10]) }},
+	"SI": {"SI", []int{8}, vatSI},
+	"SK": {"SK", []int{10}, vatSK},
+	"XI": {"GB", []int{5, 9, 12}, vatXI},
+}
+
+// VATID check if the string is a VAT identification number of an EU member state
+// or Northern Ireland, e.g. "DE136695976". The number must start with its prefix,
+// spaces are ignored.
+func VATID(s string) bool {
+	_, err := ParseVATID(s)
+	return err == nil
+}
+
+// ParseVATID validates the string as VAT identification number and returns its parts.
+// Length, format and check digits are verified according to the rules of the issuing state,
+// whether the number is actually registered can only be checked with VIES.
+// On failure the error is a *ValidationError.
+// Offsets in the error are relative to s with spaces removed.
+func ParseVATID(s string) (VATIDParts, error) {
+	s = strings.Replace(s, " ", "", -1)
+
+	if len(s) == 0 {
+		return VATIDParts{}, validationError("VATID", ReasonEmpty, -1)
+	}
+
+	if len(s) < 3 {
+		return VATIDParts{}, validationError("VATID", ReasonLength, -1)
+	}
+
+	for i := 0; i < len(s); i++ {
+		if ('Z' < s[i] || s[i] < 'A') && ('9' < s[i] || s[i] < '0') && s[i] != '+' && s[i] != '*' {
+			return VATIDParts{}, validationError("VATID", ReasonCharacter, i)
+		}
+	}
+
+	prefix, n := s[:2], s[2:]
+	rule, ok := vatRules[prefix]
+	if !ok || !ISO3166Alpha2(rule.country) {
+		return VATIDParts{}, validationError("VATID", ReasonFormat, 0)
+	}
+
+	ok = false
+	for _, l := range rule.lengths {
+		ok = ok || len(n) == l
+	}
+	if !ok {
+		return VATIDParts{}, validationError("VATID", ReasonLength, -1)
+	}
+
+	if !rule.check(n) {
+		return VATIDParts{}, validationError("VATID", ReasonChecksum, -1)
+	}
+
+	return VATIDParts{Prefix: prefix, CountryCode: rule.country, Number: n}, nil
+}
+
+// atoi converts a string of digits checked beforehand.
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func vatAT(n string) bool {
+	if n[0] != 'U' || !Numeric(n[1:]) {
+		return false
+	}
+
+	d := n[1:]
+	sum := 4
+	for i := 0; i < 7; i++ {
+		v := int(d[i] - '0')
+		if i%2 == 1 {
+			v = v*2/10 + v*2%10
+		}
+		sum += v
+	}
+
+	return (10-sum%10)%10 == int(d[7]-'0')
+}
+
+func vatBE(n string) bool {
+	return Numeric(n) && (n[0] == '0' || n[0] == '1') && 97-atoi(n[:8])%97 == atoi(n[8:])
+}
+
+func vatBG(n string) bool {
+	if !Numeric(n) {
+		return false
+	}
+
+	last := int(n[len(n)-1] - '0')
+	if len(n) == 9 {
+		c := weightedSum(n, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if c == 10 {
+			c = weightedSum(n, 3, 4, 5, 6, 7, 8, 9, 10) % 11 % 10
+		}
+		return c == last
+	}
+
+	// physical persons, foreigners and other entities have different weights
+	return weightedSum(n, 2, 4, 8, 5, 10, 9, 7, 3, 6)%11%10 == last ||
+		weightedSum(n, 21, 19, 17, 13, 11, 9, 7, 3, 1)%10 == last ||
+		(11-weightedSum(n, 4, 3, 2, 7, 6, 5, 4, 3, 2)%11)%11 == last
+}
+
+func vatCY(n string) bool {
+	if !Numeric(n[:8]) || n[:2] == "12" || 'Z' < n[8] || n[8] < 'A' {
+		return false
+	}
+
+	odd := [10]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	var sum int
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			sum += odd[n[i]-'0']
+		} else {
+			sum += int(n[i] - '0')
+		}
+	}
+
+	return byte('A'+sum%26) == n[8]
+}
+
+func vatCZ(n string) bool {
+	if !Numeric(n) {
+		return false
+	}
+
+	switch {
+	case len(n) == 8:
+		// legal entities
+		if n[0] == '9' {
+			return false
+		}
+		c := (11 - weightedSum(n, 8, 7, 6, 5, 4, 3, 2)%11) % 11
+		if c == 0 {
+			c = 1
+		}
+		return c%10 == int(n[7]-'0')
+	case len(n) == 9 && n[0] == '6':
+		// individuals without birth number
+		c := weightedSum(n[1:], 8, 7, 6, 5, 4, 3, 2) % 11
+		return 9-((8-c+11)%11)%10 == int(n[8]-'0')
+	case len(n) == 9:
+		// birth numbers issued before 1954 have no check digit
+		return true
+	}
+
+	// birth numbers
+	r := atoi(n[:9]) % 11
+	return r%10 == int(n[9]-'0')
+}
+
+func vatEE(n string) bool {
+	return Numeric(n) && n[:2] == "10" && (10-weightedSum(n, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 == int(n[8]-'0')
+}
+
+func vatEL(n string) bool {
+	return Numeric(n) && weightedSum(n, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == int(n[8]-'0')
+}
+
+func vatES(n string) bool {
+	switch {
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", n[0]) >= 0:
+		return spanishCIF(n)
+	case strings.IndexByte("KLMXYZ", n[0]) >= 0:
+		return spanishNIE(n)
+	}
+
+	return spanishDNI(n)
+}
+
+// spanishDNI checks Documento Nacional de Identidad: 8 digits and a check letter.
+func spanishDNI(n string) bool {
+	return len(n) == 9 && Numeric(n[:8]) && "TRWAGMYFPDXBNJZSQVHLCKE"[atoi(n[:8])%23] == n[8]
+}
+
+// spanishNIE checks Número de Identidad de Extranjero: "X", "Y" or "Z" followed by 7 digits
+// and a check letter. "K", "L" and "M" numbers issued to Spaniards without DNI are checked the same way.
+func spanishNIE(n string) bool {
+	if len(n) != 9 {
+		return false
+	}
+
+	switch n[0] {
+	case 'X', 'K', 'L', 'M':
+		return spanishDNI("0" + n[1:])
+	case 'Y':
+		return spanishDNI("1" + n[1:])
+	case 'Z':
+		return spanishDNI("2" + n[1:])
+	}
+
+	return false
+}
+
+// spanishCIF checks Código de Identificación Fiscal of legal entities:
+// an entity type letter, 7 digits and a check digit or letter.
+func spanishCIF(n string) bool {
+	if !Numeric(n[1:8]) {
+		return false
+	}
+
+	var sum int
+	for i := 1; i < 8; i++ {
+		v := int(n[i] - '0')
+		if i%2 == 1 {
+			v = v*2/10 + v*2%10
+		}
+		sum += v
+	}
+	c := (10 - sum%10) % 10
+
+	digit, letter := byte('0'+c), "JABCDEFGHI"[c]
+	switch {
+	case strings.IndexByte("NPQRSW", n[0]) >= 0 || n[1:3] == "00":
+		return n[8] == letter
+	case strings.IndexByte("ABEH", n[0]) >= 0:
+		return n[8] == digit
+	}
+
+	return n[8] == digit || n[8] == letter
+}
+
+func vatFI(n string) bool {
+	if !Numeric(n) {
+		return false
+	}
+
+	r := weightedSum(n, 7, 9, 10, 5, 8, 4, 2) % 11
+	return r != 1 && (11-r)%11 == int(n[7]-'0')
+}
+
+func vatFR(n string) bool {
+	if !Numeric(n[2:]) {
+		return false
+	}
+
+	if !Numeric(n[:2]) {
+		// keys of newer numbers contain letters and have no documented check
+		return Alphanumeric(n[:2])
+	}
+
+	return (12+3*(atoi(n[2:])%97))%97 == atoi(n[:2])
+}
+
+func vatHU(n string) bool {
+	return Numeric(n) && (10-weightedSum(n, 9, 7, 3, 1, 9, 7, 3)%10)%10 == int(n[7]-'0')
+}
+
+func vatIE(n string) bool {
+	if len(n) == 8 && Numeric(n[:1]) && !Numeric(n[1:2]) && Numeric(n[2:7]) {
+		// old style numbers "1X34567L" are checked as "0345671L"
+		n = "0" + n[2:7] + n[:1] + n[7:]
+	}
+
+	if !Numeric(n[:7]) || !Alpha(n[7:]) {
+		return false
+	}
+
+	sum := weightedSum(n, 8, 7, 6, 5, 4, 3, 2)
+	if len(n) == 9 {
+		switch {
+		case n[8] == 'W':
+		case 'A' <= n[8] && n[8] <= 'I':
+			sum += int(n[8]-'A'+1) * 9
+		default:
+			return false
+		}
+	}
+
+	return "WABCDEFGHIJKLMNOPQRSTUV"[sum%23] == n[7]
+}
+
+func vatLT(n string) bool {
+	if !Numeric(n) || n[len(n)-2] != '1' {
+		return false
+	}
+
+	c := 0
+	for i := 0; i < len(n)-1; i++ {
+		c += int(n[i]-'0') * (i%9 + 1)
+	}
+	c %= 11
+	if c == 10 {
+		c = 0
+		for i := 0; i < len(n)-1; i++ {
+			c += int(n[i]-'0') * ((i+2)%9 + 1)
+		}
+		c = c % 11 % 10
+	}
+
+	return c == int(n[len(n)-1]-'0')
+}
+
+func vatLU(n string) bool {
+	return Numeric(n) && atoi(n[:6])%89 == atoi(n[6:])
+}
+
+func vatLV(n string) bool {
+	if !Numeric(n) {
+		return false
+	}
+
+	if n[0] <= '3' {
+		// personal codes of natural persons
+		return true
+	}
+
+	r := 3 - weightedSum(n, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6)%11
+	if r < -1 {
+		r += 11
+	}
+
+	return r >= 0 && r == int(n[10]-'0')
+}
+
+func vatMT(n string) bool {
+	return Numeric(n) && n[0] != '0' && 37-weightedSum(n, 3, 4, 6, 7, 8, 9)%37 == atoi(n[6:])
+}
+
+func vatNL(n string) bool {
+	if !Numeric(n[:9]) || n[9] != 'B' || !Numeric(n[10:]) {
+		return false
+	}
+
+	if weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2)%11 == int(n[8]-'0') {
+		return true
+	}
+
+	// numbers of sole proprietors issued since 2020 are checked with MOD 97 including the prefix
+	return ibanCheckDigits("NL"+n[:10]) == n[10:]
+}
+
+func vatPL(n string) bool {
+	return Numeric(n) && weightedSum(n, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == int(n[9]-'0')
+}
+
+func vatPT(n string) bool {
+	return Numeric(n) && n[0] != '0' && (11-weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2)%11)%11%10 == int(n[8]-'0')
+}
+
+func vatRO(n string) bool {
+	if !Numeric(n) || n[0] == '0' {
+		return false
+	}
+
+	n = strings.Repeat("0", 10-len(n)) + n
+	return weightedSum(n, 7, 5, 3, 2, 1, 7, 5, 3, 2)*10%11%10 == int(n[9]-'0')
+}
+
+func vatSI(n string) bool {
+	if !Numeric(n) || n[0] == '0' {
+		return false
+	}
+
+	c := 11 - weightedSum(n, 8, 7, 6, 5, 4, 3, 2)%11
+	return c != 11 && c%10 == int(n[7]-'0')
+}
+
+func vatSK(n string) bool {
+	return Numeric(n) && n[0] != '0' && strings.IndexByte("234789", n[2]) >= 0 && atoi(n)%11 == 0
+}
+
+// vatXI checks UK VAT numbers: 9 digits, optionally followed by a 3 digit branch code,
+// or government departments "GD000"-"GD499" and health authorities "HA500"-"HA999".
+func vatXI(n string) bool {
+	switch {
+	case len(n) == 5 && n[:2] == "GD":
+		return Numeric(n[2:]) && n[2] < '5'
+	case len(n) == 5 && n[:2] == "HA":
+		return Numeric(n[2:]) && n[2] >= '5'
+	case len(n) == 5 || !Numeric(n):
+		return false
+	}
+
+	sum := weightedSum(n, 8, 7, 6, 5, 4, 3, 2) + atoi(n[7:9])
+	return sum%97 == 0 || (sum+55)%97 == 0
+}
@@ -0,0 +1,108 @@
+package is
+
+import "testing"
+
+func TestVATID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"DE", false},
+		{"ATU13585627", true},
+		{"ATU13585626", false},
+		{"BE0403019261", true},
+		{"BE0403019262", false},
+		{"BG175074752", true},
+		{"BG175074753", false},
+		{"CY10259033P", true},
+		{"CY10259033Q", false},
+		{"CZ25123891", true},
+		{"CZ25123892", false},
+		{"DE136695976", true},
+		{"DE 136 695 976", true},
+		{"DE136695977", false},
+		{"DE036695976", false},
+		{"DK13585628", true},
+		{"DK13585627", false},
+		{"EE100931558", true},
+		{"EE100931557", false},
+		{"EL094259216", true},
+		{"EL094259217", false},
+		{"GR094259216", false},
+		{"ESA13585625", true},
+		{"ESA13585624", false},
+		{"ES12345678Z", true},
+		{"ES12345678A", false},
+		{"ESX1234567L", true},
+		{"FI20774740", true},
+		{"FI20774741", false},
+		{"FR40303265045", true},
+		{"FR41303265045", false},
+		{"HU12892312", true},
+		{"HU12892313", false},
+		{"IE6433435F", true},
+		{"IE6433435E", false},
+		{"IE8D79739I", true},
+		{"IE6433435FW", true},
+		{"IE6433435FZ", false},
+		{"LT119511515", true},
+		{"LT119511516", false},
+		{"LU15027442", true},
+		{"LU15027443", false},
+		{"LV40003521600", true},
+		{"LV40003521601", false},
+		{"MT11679112", true},
+		{"MT11679113", false},
+		{"NL004495445B01", true},
+		{"NL004495446B01", false},
+		{"NL000099998B57", true},
+		{"PL8567346215", true},
+		{"PL8567346216", false},
+		{"PT501964843", true},
+		{"PT501964842", false},
+		{"RO18547290", true},
+		{"RO18547291", false},
+		{"SE123456789701", true},
+		{"SE123456789702", false},
+		{"SI50223054", true},
+		{"SI50223055", false},
+		{"SK2022749619", true},
+		{"SK2022749618", false},
+		{"XI980780684", true},
+		{"XI980780685", false},
+		{"XIGD123", true},
+		{"XIGD623", false},
+		{"XIHA623", true},
+		{"GB980780684", false},
+		{"US123456789", false},
+		{"de136695976", false},
+		{"DE13669597-6", false},
+	}
+	for _, test := range tests {
+		actual := VATID(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected VATID(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseVATID(t *testing.T) {
+	t.Parallel()
+
+	p, err := ParseVATID("EL 094 259 216")
+	if err != nil || p != (VATIDParts{"EL", "GR", "094259216"}) {
+		t.Errorf("Expected ParseVATID(%q) to be EL/GR/094259216, got %+v, %v", "EL 094 259 216", p, err)
+	}
+
+	for prefix, rule := range vatRules {
+		if !ISO3166Alpha2(rule.country) {
+			t.Errorf("Expected country %s of VAT prefix %s to be in ISO3166List", rule.country, prefix)
+		}
+		if prefix != "XI" && !EUMember(rule.country) {
+			t.Errorf("Expected country %s of VAT prefix %s to be an EU member", rule.country, prefix)
+		}
+	}
+}